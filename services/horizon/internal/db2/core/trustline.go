@@ -37,6 +37,30 @@ func (q *Q) AssetsForAddress(dest interface{}, addy string) error {
 	return err
 }
 
+// AssetsIssuedBy loads the assets issued by `addy` that are held by at least
+// one trustline.
+func (q *Q) AssetsIssuedBy(dest *[]xdr.Asset, addy string) error {
+	var tls []Trustline
+	sql := sq.Select("DISTINCT tl.assettype", "tl.assetcode", "tl.issuer").
+		From("trustlines tl").
+		Where("tl.issuer = ?", addy)
+
+	err := q.Select(&tls, sql)
+	if err != nil {
+		return err
+	}
+
+	*dest = make([]xdr.Asset, len(tls))
+	for i, tl := range tls {
+		(*dest)[i], err = AssetFromDB(tl.Assettype, tl.Assetcode, tl.Issuer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // TrustlinesByAddress loads all trustlines for `addy`
 func (q *Q) TrustlinesByAddress(dest interface{}, addy string) error {
 	sql := selectTrustline.Where("accountid = ?", addy)
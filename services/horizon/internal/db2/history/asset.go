@@ -1,7 +1,11 @@
 package history
 
 import (
+	"encoding/json"
+	"strings"
+
 	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/xdr"
 )
 
@@ -84,3 +88,69 @@ func (q *Q) GetCreateAssetID(
 
 	return
 }
+
+// ExpandAssetIDs is the inverse of the asset normalization performed when
+// ingesting with NormalizeAssetsInDetails: every `<prefix>asset_id` key of
+// the operation `details`, including those of a payment path, is replaced by
+// the `<prefix>asset_type`, `<prefix>asset_code` and `<prefix>asset_issuer`
+// keys of the referenced row of history_assets.  Details without asset ids
+// are left untouched.
+func (q *Q) ExpandAssetIDs(details map[string]interface{}) error {
+	for key, value := range details {
+		if !strings.HasSuffix(key, "asset_id") {
+			continue
+		}
+
+		id, err := detailsAssetID(value)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s", key)
+		}
+
+		var asset Asset
+		err = q.GetAssetByID(&asset, id)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load asset %d", id)
+		}
+
+		prefix := strings.TrimSuffix(key, "asset_id")
+		delete(details, key)
+		details[prefix+"asset_type"] = asset.Type
+		if asset.Type != "native" {
+			details[prefix+"asset_code"] = asset.Code
+			details[prefix+"asset_issuer"] = asset.Issuer
+		}
+	}
+
+	path, ok := details["path"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, hop := range path {
+		hopDetails, ok := hop.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		err := q.ExpandAssetIDs(hopDetails)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// detailsAssetID converts an asset id decoded from details json to an int64.
+func detailsAssetID(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case float64:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case json.Number:
+		return v.Int64()
+	default:
+		return 0, errors.Errorf("unexpected type %T", value)
+	}
+}
@@ -44,6 +44,26 @@ func (q *Q) LedgersBySequence(dest interface{}, seqs ...int32) error {
 	return q.Select(dest, sql)
 }
 
+// SetImporterVersion sets the importer version of the ledgers `first` through
+// `last`, inclusive, to `version`.  Lowering the version marks the ledgers as
+// outdated so that they are picked up by the version-based reingestion (see
+// OldestOutdatedLedgers), and raising it stops them from being reingested.
+//
+// Only the version tag is changed: the ingested data of the ledgers is left as
+// is, so marking ledgers as current does not make their data current.
+func (q *Q) SetImporterVersion(first, last int32, version int32) error {
+	if first > last {
+		return errors.Errorf("invalid range: %d > %d", first, last)
+	}
+
+	sql := sq.Update("history_ledgers").
+		Set("importer_version", version).
+		Where("sequence >= ? AND sequence <= ?", first, last)
+
+	_, err := q.Exec(sql)
+	return err
+}
+
 // Page specifies the paging constraints for the query being built by `q`.
 func (q *LedgersQ) Page(page db2.PageQuery) *LedgersQ {
 	if q.Err != nil {
@@ -81,4 +101,11 @@ var selectLedger = sq.Select(
 	"hl.base_reserve",
 	"hl.max_tx_set_size",
 	"hl.protocol_version",
+	"hl.partial",
+	"hl.ingested_by",
+	"hl.bucket_list_hash",
+	"hl.tx_set_hash",
+	"hl.tx_set_result_hash",
+	"hl.scp_value",
+	"hl.trustlines_changed",
 ).From("history_ledgers hl")
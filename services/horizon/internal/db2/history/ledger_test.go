@@ -44,3 +44,27 @@ func TestLedgerQueries(t *testing.T) {
 		tt.Assert.Contains(foundSeqs, int32(3))
 	}
 }
+
+func TestSetImporterVersion(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+	q := &Q{tt.HorizonSession()}
+
+	err := q.SetImporterVersion(2, 3, 0)
+	tt.Require.NoError(err)
+
+	var l Ledger
+	tt.Require.NoError(q.LedgerBySequence(&l, 1))
+	tt.Assert.NotEqual(int32(0), l.ImporterVersion)
+
+	for _, seq := range []int32{2, 3} {
+		tt.Require.NoError(q.LedgerBySequence(&l, seq))
+		tt.Assert.Equal(int32(0), l.ImporterVersion)
+	}
+
+	var outdated []int32
+	tt.Require.NoError(q.OldestOutdatedLedgers(&outdated, 1))
+	tt.Assert.Equal([]int32{2, 3}, outdated)
+
+	tt.Assert.Error(q.SetImporterVersion(3, 2, 0))
+}
@@ -152,7 +152,9 @@ type EffectsQ struct {
 // `history_effects` table.
 type EffectType int
 
-// Ledger is a row of data from the `history_ledgers` table
+// Ledger is a row of data from the `history_ledgers` table.  TransactionCount
+// and OperationCount only count the successful transactions of the ledger and
+// their operations.
 type Ledger struct {
 	TotalOrderID
 	Sequence           int32       `db:"sequence"`
@@ -171,6 +173,71 @@ type Ledger struct {
 	MaxTxSetSize       int32       `db:"max_tx_set_size"`
 	ProtocolVersion    int32       `db:"protocol_version"`
 	LedgerHeaderXDR    null.String `db:"ledger_header"`
+	// Partial is true when only some of the ledger's transactions, and their
+	// operations, effects and participants, have been ingested.  The ledger's
+	// transaction and operation counts describe the full ledger.  A partial
+	// ledger is replaced in full by the next regular ingestion of it.
+	Partial bool `db:"partial"`
+	// IngestedBy identifies the ingestion instance that wrote the ledger, when
+	// one was configured.
+	IngestedBy null.String `db:"ingested_by"`
+	// BucketListHash, TxSetHash, TxSetResultHash and ScpValue are the
+	// corresponding fields of the ledger's header, hex encoded hashes and the
+	// base64 xdr of the scp value.  The bucket list hash is always ingested,
+	// though null for ledgers ingested before it was, and the other fields
+	// when ingested with StoreFullHeaderFields.
+	BucketListHash  null.String `db:"bucket_list_hash"`
+	TxSetHash       null.String `db:"tx_set_hash"`
+	TxSetResultHash null.String `db:"tx_set_result_hash"`
+	ScpValue        null.String `db:"scp_value"`
+	// TrustlinesChanged is the number of trustlines created, updated or
+	// removed by the ledger, when ingested with CountTrustlineChanges.
+	TrustlinesChanged null.Int `db:"trustlines_changed"`
+}
+
+// LedgerChange is a row of data from the `history_ledger_changes` table.  Each
+// row describes a single ledger entry that was created, updated or removed by
+// an operation, along with the entry's state before and after the change.
+type LedgerChange struct {
+	HistoryOperationID int64                     `db:"history_operation_id"`
+	Order              int32                     `db:"order"`
+	ChangeType         xdr.LedgerEntryChangeType `db:"change_type"`
+	EntryType          xdr.LedgerEntryType       `db:"entry_type"`
+	Account            string                    `db:"account"`
+	Asset              null.String               `db:"asset"`
+	BalanceBefore      null.Int                  `db:"balance_before"`
+	BalanceAfter       null.Int                  `db:"balance_after"`
+	EntryBefore        null.String               `db:"entry_before"`
+	EntryAfter         null.String               `db:"entry_after"`
+}
+
+// LedgerStats is a row of data from the `history_ledger_stats` table,
+// describing the composition of a ledger's transaction set.  The fees are
+// those bid by the ledger's transactions, and are null for ledgers without
+// any.  Unlike the counts of history_ledgers, the operation count includes the
+// operations of failed transactions.
+type LedgerStats struct {
+	TotalOrderID
+	LedgerSequence         int32    `db:"ledger_sequence"`
+	SourceAccountCount     int32    `db:"source_account_count"`
+	MinFee                 null.Int `db:"min_fee"`
+	MedianFee              null.Int `db:"median_fee"`
+	MaxFee                 null.Int `db:"max_fee"`
+	OperationCount         int32    `db:"operation_count"`
+	FailedTransactionCount int32    `db:"failed_transaction_count"`
+}
+
+// UpgradeProposal is a row of data from the `history_upgrade_proposals` table,
+// holding one of the upgrades carried by the scp value of a ledger's header.
+// Type and Value are null for upgrades whose xdr could not be decoded, which
+// UpgradeXDR holds regardless.
+type UpgradeProposal struct {
+	TotalOrderID
+	LedgerSequence int32    `db:"ledger_sequence"`
+	Order          int32    `db:"order"`
+	Type           null.Int `db:"upgrade_type"`
+	Value          null.Int `db:"value"`
+	UpgradeXDR     string   `db:"upgrade_xdr"`
 }
 
 // LedgerCache is a helper struct to load ledger data related to a batch of
@@ -241,6 +308,10 @@ type Trade struct {
 	BaseIsSeller       bool      `db:"base_is_seller"`
 	PriceN             xdr.Int32 `db:"price_n"`
 	PriceD             xdr.Int32 `db:"price_d"`
+	// OfferRemainingAmount is the amount of the crossed offer left unfilled
+	// after the trade, denominated in the offer's selling asset.  Zero means
+	// the offer was fully filled and null means it was not recorded.
+	OfferRemainingAmount null.Int `db:"offer_remaining_amount"`
 }
 
 // TradesQ is a helper struct to aid in configuring queries that loads
@@ -267,6 +338,7 @@ type Transaction struct {
 	TxMeta           string      `db:"tx_meta"`
 	TxFeeMeta        string      `db:"tx_fee_meta"`
 	SignatureString  string      `db:"signatures"`
+	SignatureCount   int32       `db:"signature_count"`
 	MemoType         string      `db:"memo_type"`
 	Memo             null.String `db:"memo"`
 	ValidAfter       null.Int    `db:"valid_after"`
@@ -288,9 +360,10 @@ func (q *Q) ElderLedger(dest interface{}) error {
 	return q.GetRaw(dest, `SELECT COALESCE(MIN(sequence), 0) FROM history_ledgers`)
 }
 
-// LatestLedger loads the latest known ledger
+// LatestLedger loads the latest known ledger.  Partial ledgers are ignored, so
+// that ingestion resumes from the first ledger not fully ingested.
 func (q *Q) LatestLedger(dest interface{}) error {
-	return q.GetRaw(dest, `SELECT COALESCE(MAX(sequence), 0) FROM history_ledgers`)
+	return q.GetRaw(dest, `SELECT COALESCE(MAX(sequence), 0) FROM history_ledgers WHERE NOT partial`)
 }
 
 // OldestOutdatedLedgers populates a slice of ints with the first million
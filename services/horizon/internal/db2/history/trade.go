@@ -150,6 +150,7 @@ var selectTrade = sq.Select(
 	"htrd.base_is_seller",
 	"htrd.price_n",
 	"htrd.price_d",
+	"htrd.offer_remaining_amount",
 ).From("history_trades htrd")
 
 var selectReverseTrade = sq.Select(
@@ -170,6 +171,7 @@ var selectReverseTrade = sq.Select(
 	"NOT(htrd.base_is_seller) as base_is_seller",
 	"htrd.price_d as price_n",
 	"htrd.price_n as price_d",
+	"htrd.offer_remaining_amount",
 ).From("history_trades htrd")
 
 var tradesInsert = sq.Insert("history_trades").Columns(
@@ -186,37 +188,80 @@ var tradesInsert = sq.Insert("history_trades").Columns(
 	"base_is_seller",
 	"price_n",
 	"price_d",
+	"offer_remaining_amount",
 )
 
-// Trade records a trade into the history_trades table
+// Trade records a trade into the history_trades table.  `remaining` is the
+// amount left in the crossed offer after the trade, and may be nil when it is
+// not known.
 func (q *Q) InsertTrade(
 	opid int64,
 	order int32,
 	buyer xdr.AccountId,
 	trade xdr.ClaimOfferAtom,
 	price xdr.Price,
+	remaining *xdr.Int64,
 	ledgerClosedAt time.Millis,
 ) error {
-	sellerAccountId, err := q.GetCreateAccountID(trade.SellerId)
+	soldAssetId, err := q.GetCreateAssetID(trade.AssetSold)
 	if err != nil {
-		return errors.Wrap(err, "failed to load seller account id")
+		return errors.Wrap(err, "failed to get sold asset id")
 	}
 
-	buyerAccountId, err := q.GetCreateAccountID(buyer)
+	boughtAssetId, err := q.GetCreateAssetID(trade.AssetBought)
 	if err != nil {
-		return errors.Wrap(err, "failed to load buyer account id")
+		return errors.Wrap(err, "failed to get bought asset id")
 	}
 
-	soldAssetId, err := q.GetCreateAssetID(trade.AssetSold)
+	return q.InsertTradeWithAssetIDs(
+		opid, order, buyer, trade, soldAssetId, boughtAssetId, price, remaining, ledgerClosedAt,
+	)
+}
+
+// InsertTradeWithAssetIDs is InsertTrade for callers that have already
+// resolved the ids of the trade's assets, `soldAssetId` and `boughtAssetId`,
+// such as from a cache.
+func (q *Q) InsertTradeWithAssetIDs(
+	opid int64,
+	order int32,
+	buyer xdr.AccountId,
+	trade xdr.ClaimOfferAtom,
+	soldAssetId int64,
+	boughtAssetId int64,
+	price xdr.Price,
+	remaining *xdr.Int64,
+	ledgerClosedAt time.Millis,
+) error {
+	sellerAccountId, err := q.GetCreateAccountID(trade.SellerId)
 	if err != nil {
-		return errors.Wrap(err, "failed to get sold asset id")
+		return errors.Wrap(err, "failed to load seller account id")
 	}
 
-	boughtAssetId, err := q.GetCreateAssetID(trade.AssetBought)
+	buyerAccountId, err := q.GetCreateAccountID(buyer)
 	if err != nil {
-		return errors.Wrap(err, "failed to get bought asset id")
+		return errors.Wrap(err, "failed to load buyer account id")
 	}
 
+	return q.InsertTradeWithIDs(
+		opid, order, sellerAccountId, buyerAccountId, trade, soldAssetId, boughtAssetId, price, remaining, ledgerClosedAt,
+	)
+}
+
+// InsertTradeWithIDs is InsertTradeWithAssetIDs for callers that have also
+// resolved the ids of the trade's seller and buyer accounts,
+// `sellerAccountId` and `buyerAccountId`.
+func (q *Q) InsertTradeWithIDs(
+	opid int64,
+	order int32,
+	sellerAccountId int64,
+	buyerAccountId int64,
+	trade xdr.ClaimOfferAtom,
+	soldAssetId int64,
+	boughtAssetId int64,
+	price xdr.Price,
+	remaining *xdr.Int64,
+	ledgerClosedAt time.Millis,
+) error {
 	orderPreserved, baseAssetId, counterAssetId := getCanonicalAssetOrder(soldAssetId, boughtAssetId)
 
 	var baseAccountId, counterAccountId int64
@@ -249,8 +294,9 @@ func (q *Q) InsertTrade(
 		orderPreserved,
 		price.N,
 		price.D,
+		remaining,
 	)
-	_, err = q.Exec(sql)
+	_, err := q.Exec(sql)
 	if err != nil {
 		return errors.Wrap(err, "failed to exec sql")
 	}
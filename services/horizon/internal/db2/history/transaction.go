@@ -91,16 +91,18 @@ var selectTransaction = sq.Select(
 		"ht.fee_paid, " +
 		"ht.operation_count, " +
 		"ht.tx_envelope, " +
-		"ht.tx_result, " +
-		"ht.tx_meta, " +
-		"ht.tx_fee_meta, " +
+		"COALESCE(htx.tx_result, ht.tx_result) AS tx_result, " +
+		"COALESCE(htx.tx_meta, ht.tx_meta) AS tx_meta, " +
+		"COALESCE(htx.tx_fee_meta, ht.tx_fee_meta) AS tx_fee_meta, " +
 		"ht.created_at, " +
 		"ht.updated_at, " +
 		"array_to_string(ht.signatures, ',') AS signatures, " +
+		"ht.signature_count, " +
 		"ht.memo_type, " +
 		"ht.memo, " +
 		"lower(ht.time_bounds) AS valid_after, " +
 		"upper(ht.time_bounds) AS valid_before, " +
 		"hl.closed_at AS ledger_close_time").
 	From("history_transactions ht").
-	LeftJoin("history_ledgers hl ON ht.ledger_sequence = hl.sequence")
+	LeftJoin("history_ledgers hl ON ht.ledger_sequence = hl.sequence").
+	LeftJoin("history_transaction_xdr htx ON htx.history_transaction_id = ht.id")
@@ -2,8 +2,30 @@
 // sources:
 // latest.sql
 // migrations/10_add_trades_price.sql
+// migrations/11_add_close_time_version.sql
+// migrations/12_add_operation_successful.sql
+// migrations/13_create_ledger_changes_table.sql
+// migrations/14_add_asset_stats_toml_content.sql
+// migrations/15_create_ingestion_outbox_table.sql
+// migrations/16_create_transaction_xdr_table.sql
+// migrations/17_create_account_flags_table.sql
+// migrations/18_add_trades_offer_remaining.sql
+// migrations/19_add_transactions_signature_count.sql
 // migrations/1_initial_schema.sql
+// migrations/20_add_ledgers_partial.sql
+// migrations/21_add_ledgers_ingested_by.sql
+// migrations/22_add_ledgers_header_fields.sql
+// migrations/23_add_ledgers_trustlines_changed.sql
+// migrations/24_add_trade_pair_stats.sql
+// migrations/25_add_transaction_memos.sql
+// migrations/26_add_operation_result_code.sql
+// migrations/27_add_operation_participant_roles.sql
+// migrations/28_add_ingest_checkpoints.sql
+// migrations/29_add_transactions_inclusion_delay.sql
 // migrations/2_index_participants_by_toid.sql
+// migrations/30_add_asset_stats_checkpoints.sql
+// migrations/31_add_ledger_stats.sql
+// migrations/32_add_upgrade_proposals.sql
 // migrations/3_use_sequence_in_history_accounts.sql
 // migrations/4_add_protocol_version.sql
 // migrations/5_create_trades_table.sql
@@ -79,7 +101,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _latestSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5c\xed\x6e\xdb\xb8\xd2\xfe\x9f\xab\x20\x16\x05\xec\x00\x4e\xdf\xd8\x75\x9c\x34\xd9\x16\xf0\x3a\x6a\x6a\xac\x2b\x77\x6d\xf9\xed\x16\x45\x41\xd0\x12\x6d\xf3\x54\x12\x55\x8a\xee\x26\x7b\x70\xee\xfd\x40\x9f\xd6\x07\x29\x4a\xb6\xd2\x3d\xff\x1a\x6b\x34\xf3\x3c\xc3\x19\x0e\x39\xa4\x7a\x71\x71\x76\x71\x01\x3e\x52\x9f\x6f\x19\x5e\xfe\x31\x03\x16\xe2\x68\x8d\x7c\x0c\xac\xbd\xe3\x9d\x5d\x5c\x9c\x05\xcf\xef\xf7\x8e\x87\x2d\xb0\x61\xd4\x39\x08\xfc\xc0\xcc\x27\xd4\x05\xaf\x5f\x8e\x5e\x8e\x32\x52\xeb\x27\xe0\x6d\x61\xf0\x7a\x41\xe4\x6c\xa9\x19\xc0\xe7\x88\x63\x07\xbb\x1c\x72\xe2\x60\xba\xe7\xe0\x0d\xb8\xbc\x0b\x1f\xd9\xd4\xfc\x56\xfe\xd5\xb4\x49\x20\x8d\x5d\x93\x5a\xc4\xdd\x82\x37\xa0\xb3\x32\xde\xdd\x74\xee\x12\x75\xae\x85\x98\x05\x4d\xea\x6e\x28\x73\x88\xbb\x85\x3e\x67\xc4\xdd\xfa\xe0\x0d\xa0\x6e\xac\x63\x87\xcd\x6f\x70\xb3\x77\x4d\x4e\xa8\x0b\xd7\xd4\x22\x38\x78\xbe\x41\xb6\x8f\x73\x66\x1c\xe2\x42\x07\xfb\x3e\xda\x86\x02\x7f\x21\xe6\x12\x77\x7b\x17\x63\xc7\x88\x99\x3b\xe8\x21\xbe\x03\x6f\x80\xb7\x5f\xdb\xc4\xec\x05\x64\x4d\xc4\x91\x4d\x03\xb1\xc8\x9f\x3a\x72\xf0\x2d\xd8\x10\xe6\x73\x88\xb6\xdb\x2e\x72\x9f\xb0\x1d\xb2\xee\x81\xc3\xbf\xcf\xef\x80\xf1\xe4\xe1\x5b\xf0\x6e\xa5\x4f\x8c\xe9\x5c\xbf\x03\x4b\x73\x87\x1d\x74\x1b\xeb\xbe\x03\xf3\xbf\x5c\xcc\x6e\xc1\x45\x38\x10\x93\x85\x36\x36\xb4\x54\x5a\xad\x1f\x2c\x34\x63\xb5\xd0\x97\x99\xdf\xce\x00\x00\x60\x36\xd6\x1f\x56\xe3\x07\x0d\xf8\xdf\x6d\x30\xfd\xf0\x61\x65\x8c\x7f\x9b\x69\x60\x69\x2c\xa6\x13\x23\x94\x18\x2f\xc1\x0b\xf8\x02\x2c\xb5\x99\x36\x31\xc0\x8b\x7e\xf0\xd7\xdd\x59\x9e\x9e\x8d\x9e\x95\x9d\x4a\x7d\x6b\xe4\x06\x22\x72\x0e\x7a\x84\x1e\x23\x26\x0e\x21\xb8\x7b\x07\x33\x62\x7e\xf9\xda\x03\xe9\x3f\x4f\xe5\x57\xc3\x42\x4a\x31\xfd\xe9\x28\x86\xdd\x33\x00\x26\xe3\xa5\x06\x3e\xbd\xd7\x74\xf0\xa2\xff\xa5\xff\xf5\xff\x5e\xf4\xbf\x0c\xbe\xbe\x7d\x31\x08\xff\x3d\xf8\x32\xf8\x0a\x8c\xe8\x21\xd0\x66\x4b\x2d\x70\x8a\xa6\xdf\x9f\x0b\x3d\x43\xdc\xe7\xf6\x8c\xda\xc2\x73\x7b\xe6\xd7\x63\x3c\x13\xe6\x63\x57\x90\x01\xe3\x87\x87\x85\xf6\x30\x36\xb4\x7a\x8e\x48\xc5\xcb\x1a\x43\xc4\x00\x2c\x03\x5f\x05\xf3\x57\x32\x03\xf4\xa2\x9f\x8d\xcf\x1f\x35\xf0\x26\x9b\x11\xe7\xa2\xac\x6d\x15\x63\x51\x61\x01\x62\x92\xc6\xf5\x11\xa6\x89\xd1\x2d\x47\xd4\xd1\x28\x45\x4a\x0b\x48\x73\x09\x99\x87\x7b\x88\xb2\x32\xda\x24\x58\x5b\x45\x2b\x50\x5a\x44\x9b\x4d\x92\x4a\xb4\x41\xe5\xb2\xf0\x06\xed\x6d\x0e\x39\x5a\xdb\xd8\xf7\x90\x89\x83\x3a\xda\xb9\xcb\x3f\xfd\x8b\xf0\x1d\xa4\xc4\xca\x94\xc6\x1c\x57\xe4\xfb\x98\xc3\xa0\x82\xfb\x09\xc5\x30\xc1\xea\xd1\x8b\x72\x31\xa3\x23\x66\x44\x2c\xb0\x26\x5b\xe2\x72\xa0\xcf\x0d\xa0\xaf\x66\xb3\x88\x0e\x72\xe8\xde\xe5\xe2\x67\xee\xde\x81\xc8\x34\x03\x01\x1f\x10\x97\xe3\x2d\x66\x05\x91\x8d\x8d\xb6\x3e\xf0\x1d\x64\xdb\xe5\xf7\x39\x75\x6c\x60\xee\x10\x43\x26\xc7\x0c\xfc\x40\xec\x89\xb8\xdb\xee\x68\x78\x9e\x0a\x96\x87\x7a\x4b\x99\x07\x1d\xb2\x65\x28\x58\x45\x1c\xef\x82\x82\x9e\x83\x1b\x38\x7e\x2c\x39\xc1\xf3\x6c\x82\x2d\x88\x38\x08\x56\x46\x3e\x47\x8e\x07\x82\x71\x0a\xff\x04\x7f\x53\x17\x97\x81\xee\x88\xcf\x29\x7b\x4a\x3d\x04\x89\x05\x7d\xfc\x3d\x01\xbc\xd4\xfe\x58\x69\xfa\xa4\x26\xe6\x44\x5a\xa6\x35\x0e\xbd\xf1\xc2\x00\x9f\xa6\xc6\x7b\xd0\x0f\x7f\x98\xea\x93\x85\xf6\x41\xd3\x0d\xf0\xdb\xe7\xf8\x27\x7d\x0e\x3e\x4c\xf5\xff\x1f\xcf\x56\x5a\xfa\xf7\xf8\xcf\xc3\xdf\x93\xf1\xe4\xbd\x06\xfa\x2a\x32\x47\xbb\xbd\xa8\xa8\x14\x7e\xf7\xda\xbb\xf1\x6a\x66\x00\x17\x3f\xf2\x1f\xc8\xee\x76\x24\x8c\x3b\xb7\xb7\x0c\x6f\x4d\x1b\xf9\xfe\x79\x71\xb8\x2c\x8b\x61\xdf\x17\x87\x56\xc5\x40\x05\x49\xd1\x02\xb3\x50\xcd\x81\x97\x38\x31\xa2\x0c\xe4\x4f\x1e\x56\x64\x40\x56\xdc\xa4\x96\x48\xbc\x3f\x10\x8b\x13\xdf\xdf\x63\x26\x78\xe1\x6a\x54\x95\x61\x79\x22\x2d\x87\x6d\x56\xe7\x4f\x0b\xda\x2a\x22\x60\xfe\x49\xd7\xee\xc1\x6f\x9f\x15\x8c\xc6\x33\x43\x5b\x28\x08\xa5\xba\x0a\x8f\x5f\x12\x4b\x86\x0d\x6f\x36\xd8\x6c\x21\xea\x62\x3d\x71\xd8\x15\x72\x06\xca\x66\xf7\x44\x8e\x7a\x38\x9a\x07\xa5\x92\xbf\x50\x66\x61\xf6\x8b\x24\x9a\xc3\x38\x16\x3f\xb2\x30\x47\xc4\xf6\xc1\xbf\x7c\xea\xae\xe5\xc1\x66\x63\x6b\x8b\xd9\xe9\x7e\x88\xf5\xc4\x7e\xf0\xf1\xf7\x3d\x76\x4d\x19\xb6\x48\x18\xee\x90\xbf\xab\x95\x85\x1e\xc3\x3f\x08\xdd\xfb\x50\xf9\x62\xec\x16\x86\x5c\x1f\x45\xdb\xdd\x70\x20\x52\x1c\xc9\x2c\x77\x59\xb0\x70\x18\x88\x7a\xf2\xa6\x4d\x7d\x51\x61\x0a\x36\xef\x69\x6d\x2a\xbe\xc3\x30\xe2\xca\x97\x22\xd9\xbd\x67\xd5\x96\x4d\x43\x27\xfe\xd3\xf1\x28\xe3\x98\xc1\xa4\xff\x50\xe4\xd2\x2f\x2d\x07\x38\xb2\xa1\x49\x89\xeb\x8b\x63\x70\x83\x31\xf4\x28\xb5\xc5\x4f\xd7\xc8\xc7\x70\x83\x65\x63\x1d\x3e\x66\xd8\xc7\xec\x87\x4c\x24\x58\x7b\xf2\x47\x18\x2e\x8d\xc8\xdf\x32\x29\x8f\x51\x4e\x4d\x6a\x4b\x79\x15\xc7\x28\x09\x16\x8c\x2c\xcc\xc2\xe5\x85\x3c\x0d\x0e\xe3\xef\x21\xc6\x89\x49\x3c\xd4\x46\xb5\x15\xab\x55\xd5\xa8\xfa\xb3\x83\x7a\xbe\x69\x4a\xb9\xdd\xb2\x53\x69\xe3\x67\x95\xa1\x46\x44\x4f\x2c\x4b\x95\xb6\xca\x65\x4a\x2c\x5e\x51\xb6\xd2\x17\x5a\x8c\x4d\xd5\x56\x24\x3b\x9b\x4a\xb7\x2b\xc1\x4a\xdd\x8c\xa8\x84\x15\xeb\xc4\x82\x15\xfd\xe4\xd3\x3d\x0b\xf6\x78\x51\x74\x4b\x4a\x45\x92\xfe\x9d\xce\xed\x6d\x49\xa2\x46\x1e\x70\x86\x2c\x7c\xba\x3b\x23\x35\x85\x75\xc0\xa9\xf5\x3d\x9e\xc2\x8e\xa9\x36\x74\xb3\xc1\x4c\x6a\x36\x9c\x95\x55\xab\x94\x48\x28\x5a\xd2\x56\x8a\x54\xec\x55\x43\x0b\x98\x29\x6d\xa5\x72\x95\xe6\x52\xa9\x0a\x8b\x21\x24\xe2\x43\x1f\xdb\x36\x66\x60\x4d\xa9\x8d\x91\x9b\xd4\x10\x62\x62\xe8\xe6\xea\x65\xf4\x5b\xbe\x86\x4e\xe6\xfa\xd2\x58\x8c\xa7\xba\x51\x18\x5f\x98\x21\x0c\xc3\xc6\x3a\x98\xbc\xd7\x26\xbf\x83\x6e\x37\xeb\x8a\xb7\xe0\xf2\xfc\x5c\xa5\x4a\xf4\x7a\xc2\xfe\xd7\x92\x43\x6a\xe8\xcb\x39\xa7\xa0\xbe\xe0\xb9\x10\x60\x65\x4e\xa4\x29\xdf\x6a\x41\x94\x29\xae\x5b\x12\xeb\xcc\x45\xa7\x14\x45\x19\xbe\x76\xcb\xa2\xc2\xca\xcf\x2a\x8c\x0d\xc9\x9e\x58\x1a\x15\xd6\xca\xc5\x51\xf6\x42\x45\x79\xcc\xbc\xd2\x6a\xac\x26\xf1\x99\x85\x54\x7b\xf7\x12\x4f\xe2\x8a\x3d\x51\xdd\x0a\x5a\x5d\x0c\x85\xb2\x07\xd3\xf2\xe5\x3d\x92\xa6\x9e\x6c\x6b\xf4\x8f\x6c\x6e\xf8\x23\xc4\xee\x0f\x6c\x53\x0f\x8b\x1a\x86\xfc\x31\xd8\x6a\xec\x6d\x2e\x79\xe8\x60\x8e\x24\x8f\x02\x2f\xc8\x1e\xfb\x64\xeb\x22\xbe\x67\x58\xd4\xdb\x7a\x3d\x3a\xff\xf2\xf5\xb0\x0a\xf9\xf7\x7f\x44\xeb\x90\x2f\x5f\x8b\x7b\x1e\xec\x50\x49\x1b\xea\xa0\xcb\xa5\x2e\xae\x5c\xd5\x1c\x74\x95\xd5\xc4\xcc\x88\x83\xe1\x9a\xee\x5d\x2b\x6c\x15\xdf\x30\xe4\x6e\xab\x9a\xa6\x51\x13\x8d\x58\x49\xf6\xc4\x58\x6a\xa5\x7c\x94\x3e\x73\x7d\x56\xec\xc3\x80\xe8\xf9\x64\x3e\x5b\x7d\xd0\x83\x21\x5d\x6a\x46\x45\xc3\x31\xdb\xda\xc9\xb6\x1b\x9b\x2d\xf0\xdb\x23\x21\xd1\xdf\x88\x54\xe5\xc6\xa0\x0e\x49\x69\xe5\x6c\x8d\xa6\xd4\x42\x23\xa2\x8a\x69\x5e\x4c\xf5\x1e\x71\x04\x36\x94\x29\x8e\x5a\xc0\xfd\xd8\x18\x2b\xe8\x49\x54\x56\x1d\x5f\xd4\x51\x3b\xd5\x97\xda\xc2\x00\x53\xdd\x98\x97\x8e\x30\xc2\x82\xbb\x04\xdd\x4e\x1f\x12\x97\x70\x82\x6c\xe8\x87\xba\x5e\xfa\xdf\xed\x4e\x0f\x74\x06\x97\xfd\x9b\x8b\xcb\xc1\x45\xff\x15\xe8\x5f\xdd\x0e\xfb\xb7\x83\xc1\xcb\xc1\xeb\xe1\xf5\xe0\xf5\xc5\xe5\x4d\xe7\xfc\xae\x9e\xf6\x01\x24\xae\x85\x1f\xf3\x5e\x5d\x3f\x41\x4e\x89\x55\x65\xe9\x55\x7f\x38\x18\x0e\x9a\x58\x7a\x05\xf7\x3e\x4e\xab\x06\x24\x2e\x2c\x1e\x06\x54\xda\x1b\x5c\x8e\xfa\xa3\x26\xf6\x86\x10\x59\x16\x2c\x36\x78\x2a\x6d\x8c\x2e\xfb\xa3\x9b\x26\x36\xae\x60\x54\xa2\x92\xd5\x72\x78\x18\x58\x69\xe2\xe6\x7a\x78\x35\x6c\x62\x62\x94\x98\x88\x67\x30\xa5\x89\xe1\xe5\xf5\xf5\x75\x23\x4f\x5d\x43\x87\x5a\x64\xf3\x54\x9b\xc5\x70\x78\x75\x35\x68\x34\xf8\x37\xe1\x60\xa0\xed\x96\xe1\x2d\xe2\x94\x55\x8e\xf5\xf0\x6a\xf0\xfa\xe6\xaa\x99\xfa\xac\x93\xa2\x24\xaf\x41\x63\x74\x73\x39\xbc\x6e\x62\xe7\x75\x48\x23\x6a\xfe\xc1\x47\x8b\x55\x6a\xbf\x1e\x8d\x9a\xe5\x62\xff\x32\x54\x1f\x8f\x42\xb8\x85\xac\x34\x70\x33\xb8\xba\x7a\x15\x1b\x90\xcc\x50\x95\x47\x7d\x4d\x66\xbe\x46\xc7\xa0\xc1\x64\xae\xd0\x1b\x5f\x17\x39\xdc\xf4\x7a\xe9\xe3\xea\x23\xc2\x1e\xe8\xf7\xa2\x33\xf4\x1a\x74\xcb\xa7\x7f\x27\x90\xad\x3c\x71\x6a\x85\x6a\x6e\x71\xd2\x84\xa8\xe8\xc4\xe9\x84\x82\x56\x75\x80\xd3\x82\xda\x1a\x0d\xf1\xe3\x87\xa9\x59\x47\xb6\x8d\x61\xab\x5e\x7e\x35\x19\x46\x49\x07\xb6\x05\x97\x0b\x1a\x91\xed\x68\x55\xb7\x72\x8e\x1f\xca\xa6\x3d\x84\x36\x06\x53\xb5\xc4\x6c\x32\x9c\xd2\x8e\x41\x73\x97\x64\x2f\xf7\x64\x8b\x9b\xf7\x0d\x3f\x25\xaa\x0f\xdd\xbb\xa6\xab\xf4\x8c\xc6\xe8\x2e\xdf\xfd\x7d\xb6\x17\x58\x34\x08\x3e\x2e\xa6\x1f\xc6\x8b\xcf\xe0\x77\xed\x33\xe8\x12\x4b\x75\x9f\xa7\xf8\x77\x4b\xa8\x0b\x5a\x45\xc8\x45\x86\x95\xe8\x0b\xfb\xcb\xc2\xec\x7c\xb8\xb5\x01\x0f\xf7\x3d\x60\xf6\x72\x06\x6c\x85\x5d\xde\xac\x88\xdc\x51\xc0\xc0\x4a\x9f\xfe\xb1\xd2\x40\xf7\x20\xde\xcb\x5c\x5c\xe9\xe5\xae\x99\x34\x74\x4d\x3b\xc3\xda\x98\x78\xa3\x41\x95\xec\xb7\x15\x73\x79\xbb\xcc\xc4\x46\xaa\x98\x56\xc0\xaa\xcd\x5c\xba\x05\x57\x4e\x7d\xed\xb2\x97\x99\xa9\xe2\x5f\x09\x4d\xe9\x81\x28\xa4\xd7\x4f\x61\xb4\x27\x44\xa6\xfa\xbd\xf6\x67\xbd\xd6\x6d\x28\x9a\xd7\x02\xe6\x7a\x31\x19\x56\xcb\xa9\xfe\x00\xd6\x9c\x61\x9c\xcd\x2e\x39\x9a\x28\xc7\x4e\xc7\x13\x5f\x09\xab\x85\x48\x92\xd7\xeb\x74\x9d\x7d\x34\x9c\x83\x8a\x2c\x92\x5c\x9f\x3b\x8f\x27\x12\xee\x95\x1a\xc9\x22\x70\x3b\xe4\xef\x4e\x41\x16\xf6\xd3\x6b\xc1\x2a\x76\xe1\x45\x68\xa2\x65\xf1\x29\x78\x22\x0d\xf5\x10\x15\x5a\xfc\xbd\x72\x37\x5f\x98\xf2\x10\x07\xb1\x11\x3e\x3f\x02\x69\x5c\x25\x22\xc0\x05\x75\x59\xd8\xc9\x15\xb5\x1c\x62\xd1\x09\x75\x2f\x39\x8d\x96\x81\x3d\xb4\x1a\x4f\x84\x49\xac\xda\x00\x0f\xa7\x78\x3d\xe1\xb1\xba\x02\x34\xf5\xa0\xd7\x16\xee\x58\x57\x16\xba\xa4\x54\x1d\xc5\x44\x4c\x80\x3f\xb6\x47\x20\xd6\x25\x89\xe9\x23\x29\xe4\x8f\x64\xcb\x24\xa8\x17\x44\xe5\x8e\x1e\xc5\x21\x06\x7f\xd0\x71\xac\xf3\xab\x1d\x9d\xde\x2c\x0c\xa6\xea\xd3\x7d\x9d\x57\x97\x85\x9c\x5c\x93\xcc\x61\x14\x23\xca\xfa\xb5\x2d\x58\x25\x9d\xf5\xa6\x37\x11\x40\x1e\x0d\x09\x3f\x65\x58\x0f\x3a\x8e\x0f\x49\x55\xf8\x71\x66\x85\xb3\xe2\x66\x73\x42\x39\xc8\x69\x29\x60\xb5\x70\x01\x59\x72\xf3\x46\x8c\x25\xb9\x88\x61\x53\xfa\x6d\xef\x9d\x86\x28\xaf\x4b\x85\xab\x74\xa3\x44\x88\xcf\x43\x84\x85\xdf\xa1\xb6\x82\xb0\xa8\x4d\x85\x31\x77\x0b\xa6\x57\xba\x04\xd3\x2b\xdd\x88\x92\x90\x68\x21\x5b\x62\x3d\x2a\xc4\x0d\x6b\x52\xa0\xb5\x35\xef\x36\x70\xac\xd2\x6f\xd1\xd9\x52\xa9\xa3\x4b\x5d\x18\x7f\xd6\x71\xaa\x43\x95\x06\x72\xab\xe3\xe4\x33\x95\xfc\x7a\x34\x12\x6c\x80\xfd\xf4\x38\xa8\xd2\xad\x46\x2c\xc8\xb2\xbc\xc2\x78\xed\x13\xe8\x0b\xf6\xf6\x47\xc7\x43\xa5\x56\xe5\x62\x2b\x10\x52\x00\x8d\x2b\x57\xa0\x32\x0d\xa2\x96\xd0\x8a\x54\x2b\x8b\x66\xdd\x48\xce\x28\x6f\x3b\x18\x72\xaa\x8f\xa9\xf2\x72\x75\x85\x3b\xfc\xed\x3b\xba\xf4\x95\x80\x12\x7e\xe1\x85\xfa\x64\x32\x1f\x6d\x3c\x9b\xff\xb3\x1f\x86\xa8\x98\x64\x64\xeb\x93\x10\x7d\x82\xf2\x6c\x6c\x84\xdf\xbb\xa8\x68\x89\x5e\xaa\xcf\x2f\xd9\xba\x3e\x1b\xa7\xf4\x0e\x9a\x8a\x87\xb4\xc7\x90\x57\x7d\x38\x87\x79\x8e\xd4\x2e\x6a\x17\x6e\x3b\x9a\x26\x78\x5e\x69\x7e\xe1\xda\x52\x86\x57\x99\xa8\xc3\x41\xb1\x9a\xae\x34\xd6\x5e\xf9\x2a\x2b\xae\x85\x5d\x5d\xc4\xb2\x5b\x9c\xe7\x08\x9b\xb2\xfe\xa3\x37\x58\xe1\x22\x2e\x2d\xe4\x49\x5f\x07\xae\x29\xfd\x76\xb4\x97\x2b\x74\x2a\x97\x08\xdd\x6e\xf2\x81\xc6\xc5\xdb\xb7\xa0\xe3\x53\xdb\xca\x9c\x61\x74\x6e\x6f\x39\x7e\xe4\xe7\xe7\x3d\x20\x17\x34\xa9\x55\x4f\x30\xea\x80\xca\x45\xd7\x74\xbf\xdd\xf1\x5a\xe6\x73\xa2\xd5\x00\x72\xa2\x05\x08\xe7\xe0\xd3\x7b\x6d\xa1\x45\x41\x06\xde\x80\x57\xaf\x24\x3d\xe3\xf2\xf1\x1f\xb1\xe0\x26\xd3\x9c\x7f\xf7\xfb\xcf\x39\x04\x8c\xcd\x82\x77\xf3\x85\x36\x7d\xd0\xd3\xc6\x3b\x58\x68\xef\xb4\x85\xa6\x4f\xb4\x65\xa1\x17\x1d\x3e\x9d\xeb\x60\xf5\xf1\x3e\x08\x99\x85\x16\xfd\xcf\x21\xc1\x4f\xf7\xda\x4c\x33\x34\x30\x19\x2f\x27\xe3\x7b\xad\xfa\x4b\x1a\xf1\x17\x13\x69\xe3\xa8\x3d\x67\xe4\xed\x28\x8e\x26\x64\x48\xf2\xfe\x29\x48\x88\x9d\x15\x2f\xf4\x15\xe7\x38\x52\x4f\xc4\x5b\xd9\x7f\xdc\x0f\x59\x1c\x22\x2f\x24\x5d\x82\xea\x80\x69\xe6\x81\xf2\xd7\x40\xff\xa0\x1b\x24\x60\xf2\xbe\x28\x0b\xb5\x1c\x14\xc5\x16\xc7\xff\x82\x43\xe4\xa1\x51\xea\x21\xd5\x8d\x0e\xd9\x7f\xb2\x06\x4c\xea\x78\x36\xe6\x38\xe4\xf0\xdf\x00\x00\x00\xff\xff\xf1\x4e\x89\x14\x91\x4d\x00\x00")
+var _latestSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x1d\x6b\x6f\xdb\xb6\xf6\x7b\x7f\x05\x31\x14\x48\x02\x38\xbd\xb6\xec\x38\x8f\x6e\x05\xbc\x44\xcd\x82\xa5\x4e\x17\x3b\x77\x2b\x86\x42\x90\x25\xda\xd1\xad\x6c\x69\x92\xdc\x25\x1b\xee\x7f\xbf\x7c\x48\x32\x45\x91\x22\x25\x33\xdd\xdd\x87\x2d\x16\x8f\xce\x8b\xe7\x45\xf2\x50\x3b\x3e\x7e\x75\x7c\x0c\x3e\x46\x69\xb6\x4a\xe0\xec\x97\x5b\xe0\xbb\x99\xbb\x70\x53\x08\xfc\xed\x3a\x46\x63\xaf\xf0\xf8\x15\xfa\x1b\xfa\x60\x99\x44\xeb\x1d\xc0\x57\x98\xa4\x41\xb4\x01\xe7\x6f\xc6\x6f\xc6\x0c\xd4\xe2\x19\xc4\x2b\x07\xbf\xce\x81\xbc\x9a\xd9\x73\x90\x66\x6e\x06\xd7\x70\x93\x39\x59\xb0\x86\xd1\x36\x03\x3f\x80\xfe\x5b\x32\x14\x46\xde\x97\xfa\x53\x2f\x0c\x30\x34\xdc\x78\x91\x1f\x6c\x56\x68\xe0\xe0\x61\xfe\xfe\xec\xe0\x6d\x81\x6e\xe3\xbb\x89\xef\x78\xd1\x66\x19\x25\x6b\x04\xe1\xa4\x59\x82\xfe\x93\x22\xc8\x68\x93\xe3\x78\x84\x08\xf5\x72\xbb\xf1\x32\xc4\x8e\xb3\x40\x98\x20\x1e\x5f\xba\x61\x0a\x2b\x64\x10\x02\x67\x0d\xd3\xd4\x5d\x11\x80\x3f\xdd\x64\x83\x70\xbd\xcd\x79\x87\x6e\xe2\x3d\x3a\xb1\x9b\x3d\xa2\xb1\x78\xbb\x08\x03\xaf\x87\x85\xf5\x90\x4e\xc2\x08\x83\x1d\x13\x7d\x4e\xdd\x35\xbc\x00\xcb\x20\x49\x33\xc7\x5d\xad\x0e\xdd\xcd\x33\x0c\x89\xd4\x3d\xb0\xfb\xfb\xe8\x2d\x98\x3f\xc7\x08\xf0\xfd\xc3\xf4\x72\x7e\x73\x37\x7d\x0b\x66\x88\xd3\xb5\x7b\x91\xe3\x7e\x0b\xee\xfe\xdc\xc0\xe4\x02\x1c\x93\x89\xb8\xbc\xb7\x27\x73\xbb\x84\x56\xe3\x07\xf7\xf6\xfc\xe1\x7e\x3a\x63\x9e\xbd\x02\xe8\x9f\xdb\xc9\xf4\xfa\x61\x72\x6d\x83\xf4\x8f\x10\xdc\x7c\xf8\xf0\x30\x9f\xfc\x78\x6b\x83\xd9\xfc\xfe\xe6\x72\x4e\x20\x26\x33\xf0\xda\x79\x0d\x66\xf6\xad\x7d\x39\x07\xaf\x07\xf8\x17\x92\xae\x22\x5e\xe8\xbe\xa8\x74\x2a\xf4\xc6\x84\xb3\x44\xc2\xad\xdd\x27\x27\x4e\x02\x0f\x12\x16\x36\xdb\x35\x44\x3f\x7e\xff\xdc\x03\xe5\x9f\xfb\xca\xa7\x41\xa1\x14\xb1\x7c\xd4\x49\xc2\x43\xf4\xec\x72\x32\xb3\xc1\xaf\x3f\xd9\x53\x34\x99\xbf\x0f\x3e\xff\x0b\xfd\xdb\xfa\xfc\xee\xb5\x45\xfe\xb6\xd0\xdf\x60\x4e\x07\x81\x7d\x8b\x20\x91\x52\xec\xe9\xd5\x91\x50\x33\xc8\x43\x5e\x58\x33\x6a\x0a\x2f\xad\x99\xef\xbb\x68\x86\xf8\xe3\xa1\xc0\x03\x26\xd7\xd7\xf7\xf6\x35\x92\x51\x4f\x11\x25\x78\x1d\x23\xe1\x18\x80\x19\xd6\x15\x8e\x5f\x45\x04\xe8\xd1\xc7\xf3\x4f\x1f\x6d\xf4\x98\xf1\x88\x23\x91\xd7\x1a\xe5\x91\x47\xc8\xb1\x58\xb8\xb1\x3e\x87\xa5\x63\x1c\xd6\x2d\xaa\x33\x97\x22\xa4\x1c\xa7\x15\x87\xac\xb2\xbb\xb3\xb2\x3a\xb7\x85\xb1\x1a\xe5\x56\x80\x94\xe7\x96\x75\x92\x46\x6e\x71\xe6\xf2\xe1\xd2\xdd\x86\x28\xe7\xba\x8b\x10\xa6\xb1\xeb\x41\x9c\x47\x0f\xde\x56\x47\xff\x0c\xb2\x47\x27\x0a\x7c\x26\x35\x56\x64\x75\xd3\x14\x66\x0e\xce\xe0\x69\x21\x22\x71\x30\x3d\xf1\xa8\x2f\x32\x38\x72\x89\x02\x54\x32\x04\xab\x60\x93\x81\xe9\xdd\x1c\x4c\x1f\x6e\x6f\xa9\x38\xee\x3a\xda\xa2\x87\xc2\x31\x24\xa2\xe3\x7a\x1e\x06\x48\x01\x1a\x86\x2b\x98\x70\x20\xcb\xd0\x45\x35\x40\xba\x76\xc3\xb0\xfe\x7e\x16\xad\x43\x54\x15\xb8\x89\xeb\x65\xe8\xcd\xaf\x6e\xf2\x8c\xd2\xfc\xe1\x78\x74\x24\x00\xc4\xb5\x45\x86\x4c\x15\x64\xf0\x29\x63\x1e\xc3\x24\x89\x12\xb0\x88\xa2\x10\xba\x1b\x70\x65\xbf\x9f\x3c\xdc\xce\xa9\xe2\x4a\x2c\x75\x83\x59\x45\x49\x8c\xca\x8c\x55\xe2\xe2\x5a\xa4\xbb\x22\x39\x3c\x3b\x65\x62\x2e\x79\x55\xc6\x31\x2a\x6f\x7c\xc7\x45\x32\xa0\xfa\x0a\x69\x1f\x15\x67\x78\xb6\xc9\x4f\xf0\x57\xb4\x81\x75\x46\x1f\x83\x34\x8b\x92\xe7\x52\xcf\x4e\xe0\x3b\x29\xfc\xa3\x60\x78\x66\xff\xf2\x60\x4f\x2f\x35\x79\x2e\xa0\x65\x58\x73\x03\x9e\xdc\xcf\xc1\xaf\x37\xf3\x9f\xc0\x80\x3c\xb8\x99\xa2\xd7\x3f\xd8\xd3\x39\xf8\xf1\x53\xfe\x68\x7a\x07\x3e\xdc\x4c\xff\x3d\xb9\x7d\xb0\xcb\xdf\x93\xdf\x76\xbf\x2f\x27\x97\x3f\xd9\x60\xa0\x10\xc6\x21\xd6\xd1\x59\xf7\x42\x6c\xf9\x0c\x14\x63\x51\x0c\xe9\xd4\x38\x32\x03\x0f\xa1\x8f\xcc\x16\x4b\xbf\x45\xd5\x2d\x94\xd8\x71\x4e\x43\xcb\x5a\x09\x1f\xce\x02\xa2\x4a\x58\x86\x8e\x82\xb8\x4b\x8c\x88\x87\x50\xdb\x80\x29\x8d\xd5\x7d\xbf\x70\x9f\x0d\xb2\xde\xaf\x6e\x78\x78\x20\x31\x94\x83\x8b\x8b\x04\xae\x3c\x94\x56\x52\x5e\x7a\xd7\xf7\x13\x54\xba\x8b\x35\xd5\x20\xdb\x2e\x22\x39\x64\xa1\x10\x47\x81\x11\x51\xc5\x78\x73\xc9\xe9\xa8\xcc\x34\xb6\x31\x5a\x66\x89\x1c\x16\x2f\x8d\x4a\x9f\xd5\x99\x38\x4c\xc6\x90\x2c\xcc\xa4\x49\x4c\x95\xc8\x94\x21\x52\x5a\xd6\x4a\xc1\xd1\xb2\x4e\x04\x3e\xb0\xc4\xe0\x41\x9a\x6e\x11\x58\xfd\x85\x93\xf1\x91\xb6\x3e\x0c\x87\x32\x16\xe7\x37\x0b\x64\x4d\x82\x80\xbb\x5f\xa7\xf6\x15\xa2\xa5\x90\x68\x72\x3b\xb7\xef\x15\x02\x95\xb8\xb8\xe1\x37\x81\x2f\xe3\x0d\x2e\x97\xd0\x33\x60\x75\x39\x1e\x2e\xb0\x16\x41\x57\xe6\x3b\xfa\x01\xf8\xbb\x28\xf1\x61\xf2\x9d\xc4\x9a\x89\x1d\x8b\x87\x7c\x98\xb9\x41\x98\x82\xff\xa4\xd1\x66\x21\x37\xb6\x3c\xc0\x23\x5b\xdd\xac\xe0\xfe\xea\xa8\xa2\x6b\x9d\x6e\x9a\xa5\xa5\x58\x9d\x06\xa1\x51\x05\x84\xe8\x34\x00\xb4\xc9\x54\xc4\x86\x84\x6e\x7f\x76\x44\x21\x16\x6e\xe8\xa2\xac\x58\x64\x33\x2a\x52\x75\x88\x66\x31\x76\x84\xf2\x98\xbf\xb2\x2b\xd7\xe8\x63\x0a\x8e\x9f\xca\xa7\x2c\xc0\xaa\xcd\x8c\xe6\x81\x3a\xca\x7c\xea\xe8\xea\x8d\xce\xaa\x44\xa5\x64\xf5\xd4\x0c\xd1\x34\x68\x2c\x8d\x14\xa5\xca\x5e\xa5\xbf\x08\x99\x62\x0d\xa0\x57\x22\xa5\xd1\x36\xc1\xe6\x90\x07\x06\x6a\x85\x62\x50\xbc\x6c\x5a\xc2\x12\x51\xfe\x10\xfa\x81\x2b\x7a\x8e\x56\x84\xb5\x87\x3b\x47\x6b\xa2\xb3\x44\xf1\x01\x29\x3e\x4b\xdc\x4d\xea\x7a\x0d\xe0\x2a\x9d\x9b\x52\x77\xa1\x69\x85\x2a\x73\x85\x3f\xba\xe9\xa3\x96\x1f\xc7\x09\xfc\x1a\x44\xdb\xd4\x51\xbe\x98\x07\x55\xa9\x42\x8a\x02\xb0\xcf\x51\x90\xe9\x5b\x06\xef\x85\x51\xaa\x6f\xf2\xf9\x3b\x09\xd4\xf0\x93\x36\x3e\xd5\xab\x9a\x75\xfe\x73\x1d\x47\x09\x52\x8b\x53\xec\x8b\xf3\xb2\x0c\x6a\xab\xcf\xcc\xc5\xcb\xcf\x00\xad\xef\x84\xfe\x81\xac\xd3\x89\xd1\x02\x54\x3c\x8a\xb7\xe9\x59\x03\x16\x0d\xa3\x8a\x19\x26\x5f\x65\x20\xd8\x03\xb2\x27\x87\x14\xb2\xc1\x5f\x32\xa8\x38\x89\xb2\xc8\x8b\x42\xa9\x5c\xfc\x1c\x15\xc6\x02\x5d\x3f\x8f\xc8\xcc\xdc\x91\x23\x00\x1e\x55\x4e\xc8\x4d\xb2\xc0\x0d\x15\x6b\xee\x5c\xd9\x24\xec\xa2\x89\x5a\x3c\xd7\x0d\x32\x57\xc0\xd6\xfb\x82\x24\x0b\x91\xa3\xa8\x0d\x97\x6a\x41\x13\x0c\x69\x15\x6f\xa8\xa8\xa0\x53\x2f\x76\xd0\x62\x67\xcb\xe6\xaa\x2c\xd9\xa6\x59\x18\x6c\x60\x9a\x67\xfa\xb2\xda\x96\x87\x8a\x9d\x8f\x10\x0d\x79\x41\xec\x9a\xc8\x5c\x62\xb4\xaa\x55\x80\x7e\x45\xa2\x5b\xd1\x25\x68\xb6\x05\x6a\x1c\x5a\x0d\xab\x3a\x31\xef\x66\x0b\xff\x46\x1a\xdf\x6a\x21\xd0\x4a\xd0\x3d\x17\x06\x8d\xb4\xea\x0b\x05\x31\x78\xc3\xc2\xa1\x7c\xc1\xa0\xed\xaa\x4a\x0c\x36\x23\x49\xb7\x22\xf1\xfe\x99\x47\x45\x21\x55\xf4\x9e\x4b\x06\x51\xd9\x22\x49\xb7\x45\x88\x3b\x38\xb8\xb8\xa8\x41\xf0\x75\xd0\xd6\xf3\x60\x9a\x2e\xb7\x65\x84\xe4\x53\x68\x1e\x97\xc8\x9a\x5b\x19\x55\x90\x66\x7c\x94\x5e\xdc\xc0\x54\xe1\xc7\x23\xcc\x67\x86\xe4\xa1\xe6\xfd\x10\xa2\x21\x94\x31\x9a\xa1\x28\x7e\x8f\xdd\x36\x96\x65\x20\x42\xf3\x6b\x14\x6e\x51\xbe\xce\xf7\xcb\xe5\x15\x45\x4e\x5c\x09\xae\x50\xa5\x21\x05\x9a\x5e\xf9\x15\xcb\xca\x0e\x75\x53\x84\x16\xe8\x89\x94\x2c\x9d\x57\x45\x6c\xd7\x98\x7c\x0a\xd2\x70\x1a\x50\x5a\x87\x82\x96\x9e\x15\x95\x50\x0d\x14\x09\x4b\x41\x8a\xc2\x5e\x18\xc2\xa4\xea\x6d\xf4\x54\x66\x53\xa9\xfc\xe8\xb3\x6a\x35\x48\x95\x97\x20\x13\x08\x70\x8f\x41\x95\x1e\x05\xb9\xbc\x9b\xce\xe6\xf7\x93\x1b\x94\x2e\xaa\x26\xe0\x30\x3a\xa1\x2b\x4b\x80\x92\xc4\xe5\xcf\xe0\xf0\x90\xd5\xd6\x3b\xd0\x3f\x3a\x52\xa1\x12\xbd\x5e\x28\xe8\xfb\x9a\xce\x34\xf0\x55\xf4\xc7\xa1\xe7\x94\x4b\x18\x6c\x74\x9b\x32\x36\xaf\xe1\x3a\x32\xe2\x41\x55\x8c\x9c\x33\xe9\x64\x03\xfc\x9e\x64\x97\x53\x00\xd9\x00\xa4\x27\xb8\xd1\x92\x4e\x86\x58\xb7\xa8\xd3\xd1\x8f\xba\xac\x6b\x2f\xb8\xd9\xc2\x4d\x41\xe5\x5b\x95\x6e\x2d\x85\xdd\xb3\x78\x53\x50\xab\x97\x6f\xb2\x17\x1a\x0a\x38\xf6\x95\x27\x3f\x31\x6a\xae\x08\x5f\x07\x67\x45\x0b\x32\x5a\xf4\x88\xce\x45\xd1\xe0\x1a\xd5\x65\x92\x21\xbc\xb8\xae\x0f\x6b\xd9\xae\x51\x47\x2d\x9c\x93\x15\x57\x7b\x83\x46\xf3\x90\x51\xb3\xc0\x6d\xb5\xc5\x9b\xbb\x7f\x49\x5a\xbe\x83\xe1\x4a\xe3\x8e\xde\x6e\xdb\x37\xda\xbf\x41\x36\x01\x37\x5f\x61\x88\x98\x92\x98\x8c\x59\x53\xcb\xab\xfa\x60\xb5\x71\xb3\x2d\x42\x2d\x50\xfb\xf9\xf8\xe8\xf7\xcf\xbb\x45\xc2\xdf\xff\x15\x2d\x13\x10\x84\x7e\x06\x2b\x71\x6d\x90\x1a\x34\x16\x1d\xe2\x1c\x97\x4b\x86\x77\x72\x16\x68\xe2\x7c\xd2\xa5\x71\x96\xe0\xfd\x0c\x4e\xaa\xea\xc4\x16\x7b\x37\x5e\xb8\xc5\xdb\x3f\x8e\x0f\x43\xf7\x39\x9f\x04\xb9\xe7\x6d\xe3\x15\x5d\x5a\x24\x51\x1c\xa5\x6e\xb8\xbf\xfb\xd5\x30\x1a\xd9\xa8\x6e\x2e\xc4\x0b\x9a\xec\x2a\x92\x8e\xd0\x3d\x22\xd6\x14\x0b\x58\x1c\x14\x15\xe1\x89\x6e\x84\x11\xcf\xde\x66\x8b\xe8\xa9\xb3\x6e\x78\x44\x7a\x2a\x91\x0d\xc7\xee\x73\x18\xb9\xb8\x5d\x38\x83\x6e\x27\x7f\x6e\x21\xb3\x99\xf2\x41\x82\xf5\xa5\xcb\x05\x4d\x61\x3a\x96\x07\x12\xec\xbb\x72\x80\x07\x68\x48\xff\x79\x9b\x00\x02\xc8\x79\xcb\x83\x89\x16\x47\xd4\xc8\xee\xa6\xb7\xfc\x49\x33\xa0\xe3\x97\x77\xb7\x0f\x1f\xa6\xd8\xdc\x70\xcf\x9a\xbc\x5f\x84\x3d\xbc\x66\xbb\x45\xda\x6d\xa0\x99\x13\x42\x82\xbf\x95\x50\x8d\x1b\x6f\x3a\x42\x4a\xeb\x7e\x63\x62\x4a\x29\xb4\x12\x54\x51\xa4\x36\x89\x5a\x0b\x4f\x7b\x8b\x56\xc3\xa8\x25\x8a\xc4\xa1\xc4\xac\x5f\xb9\x28\xe9\x2f\xa3\x44\xd1\x61\x09\xae\x26\xf3\x89\x82\x7d\x09\xca\xa6\x7e\x43\x1d\xb4\x37\xd3\x99\x8d\x22\x1b\x5a\xe8\xdf\xd5\x7a\x0e\x49\xe8\x9a\x81\xc3\x83\x81\x13\x6c\x02\x7c\x34\xe3\xa4\x04\xd7\x9b\xf4\x8f\xf0\xa0\x07\x0e\xac\xfe\xe0\xec\xb8\x6f\x1d\x0f\x86\x60\x70\x72\x31\x1a\x5c\x58\xd6\x1b\xeb\x7c\x74\x6a\x9d\x1f\xf7\xcf\x0e\x90\x1e\xb4\xb0\x5b\x08\xbb\x0f\x9f\xaa\x06\xb1\x40\xc6\x12\x05\x7e\x13\xa5\xe1\x60\x64\x8d\xac\x36\x94\x86\xce\x36\x85\x65\x16\x47\x64\x1d\xbe\x0d\xad\x91\x9e\xd5\x1f\x0f\xc6\x6d\xe8\x8d\x1c\xd7\xf7\x1d\xfe\xfc\xac\x91\xc6\xb8\x3f\x18\x9f\xb5\xa1\x71\xe2\xd0\x74\x5a\xec\xcf\x90\x1e\xe0\x46\x12\x67\xa7\xa3\x93\x51\x1b\x12\xe3\x82\x44\x1e\x7c\x95\x24\x46\xfd\xd3\xd3\xd3\x56\x9a\x3a\x75\xd6\x91\x1f\x2c\x9f\xb5\xa5\x18\x8d\x4e\x4e\xac\x56\x93\x7f\x46\x26\xc3\x5d\xad\x90\x9f\xba\x68\xd2\x1b\xe7\x7a\x74\x62\x9d\x9f\x9d\xb4\x43\xcf\x2a\x29\x6f\x0c\x54\x8b\x31\x3e\xeb\x8f\x4e\xdb\xd0\x39\x27\x62\xd0\xb3\x55\x5c\x1f\x36\x62\x3f\x1d\x8f\xdb\xf9\xe2\xa0\x4f\xd0\xe7\xb3\x40\xf6\x35\x1b\x09\x9c\x59\x27\x27\xc3\x56\x04\x06\x84\x40\xfd\x28\xb8\x4a\x06\xe1\x1c\x80\x41\xff\x62\x30\xb8\xe8\xf7\xdf\xf4\xc9\x3f\xad\xc8\x58\x84\xcc\x2e\xb1\xee\x0e\x4f\x24\x84\xac\x8e\x84\x86\xc5\xbc\x57\xfb\xb7\x44\x53\x5f\xd2\x1a\x76\xa4\x45\xe3\x49\xc5\xc0\x98\x06\x76\x09\xb1\x51\x47\x62\x65\x60\xa9\x65\xbc\x26\xd1\x4e\x3a\x52\x1b\x33\x61\x8c\xdd\x13\x6a\x24\x36\xee\x48\xec\xb4\xf4\x55\xb6\xc3\xbb\x91\xd4\x69\x47\x52\x67\xac\x3f\x71\x67\x02\x12\x52\x67\x1d\x49\x9d\x17\xa4\xca\x9d\x25\x87\x5b\x86\x4b\x08\x9e\x77\x23\x68\xd1\x58\x91\x37\x20\x39\x79\xf7\x86\x98\x86\xd5\xef\x48\x63\x50\xa1\xc1\x74\x7d\x48\xe8\x74\x8c\x17\x96\x55\xa1\x93\x87\xd7\x65\x00\x43\x3f\x95\x50\xea\x18\x30\xac\x61\x85\x52\xbd\x1f\x44\x42\xae\x63\xcc\xb0\x46\x3b\x03\x64\xce\x66\x25\x44\x3a\xc6\x0a\xeb\x84\x37\x3d\x7a\xfa\x22\xa1\xd2\x31\x46\x58\x63\x2e\xa6\x33\xc7\xdd\x12\x4a\x1d\x03\x84\x75\xca\x51\x62\x4a\x53\x07\xb7\xab\xc8\x24\xeb\x18\x25\x2c\x1a\x25\xea\xad\xa4\x12\x32\x1d\x23\x84\x25\x88\x10\xdc\x5e\x9c\x84\x60\xc7\x08\x31\xec\xd7\x12\x96\x52\xb8\x61\xc7\x48\x31\x64\x23\x45\x93\x91\x0f\x3b\x86\x88\x21\x0d\x11\xb5\xad\x43\x09\x95\x7a\x78\x90\xac\xe0\x1a\x2f\xe1\xb4\x59\x19\xb6\xba\xd7\x85\x17\xb7\x0a\xbc\xf9\x2d\xda\xdd\x05\xf8\x37\x68\x1a\x1b\x2f\xef\xf4\xc0\xa0\x47\xbb\xf5\x34\xc4\xad\x5f\x5d\xd9\x43\xd8\xc6\xeb\x12\x46\x44\xad\xec\x3b\xb5\x11\x54\x74\x5d\x62\x8f\x05\x7f\x53\xff\xb0\x01\xb4\x1a\xbd\x86\xdd\xa7\xa9\x5d\x33\x9b\x89\x69\x6b\xde\x59\x6b\x33\x8d\x92\xe6\x35\x03\x2a\x17\x74\x0f\x99\xc1\xaa\xee\x31\xe8\x3e\x95\x6d\x0f\xb7\x4d\x4c\xa6\x6a\xf7\xb0\xcd\x74\x4a\x4f\x73\xf7\x50\x7d\xe3\x51\x4c\x7b\x55\xeb\x1e\x0c\xec\xa3\x5a\xd9\x6e\xa6\x50\x95\xb5\x4d\x4c\xf6\x6f\x27\xfe\x02\x9f\x0b\xde\x76\xed\x43\x6d\x37\x65\x19\x8c\xe4\xd0\x64\x72\x75\xc5\x36\x23\xf1\x04\xc1\xc7\xfb\x9b\x0f\x93\xfb\x4f\xe0\x67\xfb\x13\x38\x0c\x7c\xd5\x7d\x6c\xfe\xb7\x21\xae\x39\xac\x22\xce\x45\x84\x95\xdc\x73\x27\x25\x5c\x32\xda\xdd\xb0\x74\x76\x77\x33\x8b\x56\x2e\x72\x91\xd2\x31\x22\x5d\x95\xac\x48\xb8\x4e\x8c\x81\x87\xe9\x0d\x32\x61\x70\xb8\x03\xef\x31\x97\x4c\x7b\x95\x2b\xa1\x2d\x55\x63\x66\x5a\x5b\x0b\xde\x6a\x52\x25\x27\x47\x8a\xd4\x65\x56\x32\x31\x91\x26\x49\x1b\xd8\xd2\x96\x5c\x7a\x98\xa4\x8c\xf4\x66\xa5\x97\x91\x69\x92\xbf\x91\xb5\x4e\x1a\xc0\x87\xfc\x92\xe7\x2f\x28\x2f\xc2\xae\x2b\x66\xc1\x48\x55\x3a\x71\x9b\x96\xc6\xb9\x1d\x9f\x72\xcc\xc8\xc8\xa3\x15\x09\x27\x24\xad\x9c\x33\x1a\x86\x16\xcf\x24\x42\x15\x8c\xde\x4c\xaf\xec\xdf\xf4\x1a\x0c\x08\x68\x15\x0b\x62\x99\x0f\x60\x0f\xb3\x9b\xe9\x35\x58\x64\x09\x84\x6c\x44\x94\x73\x43\xe3\xe2\xfe\xfc\xe4\x57\xee\xb5\x38\x92\xc4\xe2\x45\xb9\x14\xec\xcc\xce\x0e\x05\xcb\x49\xa5\x4d\xae\xca\x0f\x05\xee\xd5\xfa\xd0\x44\xcc\xe1\x76\xba\x7d\x38\x23\xed\x78\x5a\x6c\xf1\x4d\x7c\x22\x6e\xe8\xca\x6d\x1f\x7e\xf2\x5b\xc1\x5a\x1c\x71\xad\x4b\xbd\x7a\x33\xa0\x20\x48\x79\xd8\x30\x48\x3b\x57\x07\x36\xf3\xb4\x4e\xb9\x65\x71\xb1\x0c\x0b\xae\x4d\x57\xd8\x66\x6f\x4f\xf7\xd8\x8b\xd2\xc2\x90\xea\xb8\x4b\xc7\x80\x11\xd6\x51\x55\xdc\xa2\xf2\x39\x18\xb1\x35\x8a\x6e\x6d\x34\x71\x1c\xc5\xfb\x2b\x98\x41\xa6\xc9\xae\x3e\x97\x90\xe0\xc5\x56\x62\x84\xcf\x1d\x3a\x96\xd3\xe2\x43\x10\x4a\x1e\x7b\x45\x8b\x9d\x8c\xd9\x5d\x4f\xc8\x9e\x6c\x06\xbe\x36\x83\xbb\x3e\x78\xf1\xf4\x2b\x98\x0e\x3d\x63\x96\x5b\x41\xc5\xf2\xcf\x7d\x5a\x62\x5f\xd3\xa5\x74\xcc\x59\x05\x83\x4f\x97\xeb\x96\x8a\x0e\x49\x43\x89\x01\xeb\x28\x10\x09\xf8\xa4\x2b\xe2\x0a\x97\x22\x05\x66\x31\x41\x81\x8f\x62\xf6\xe6\x86\xc1\xc5\x25\x83\xea\x45\xbc\x0a\x53\x95\x2b\x40\xbd\xfa\x0d\x20\xe1\x94\x47\xb1\x13\x9b\xf2\xae\x1c\x17\xcb\xb1\x64\x29\xd4\xc9\xdf\xc4\x02\x64\x4f\xe6\x04\xc8\x71\x49\xf2\x6f\x47\x11\x14\x65\xf4\x23\xd2\x1a\xae\x44\xa2\x4e\x32\xe4\xcc\xef\x70\x74\x55\x7e\xb3\xa2\xcb\xef\x44\x98\x71\xb6\x2a\xba\xba\xcb\x69\x78\x5b\x5a\xd1\xab\x29\xb6\x6a\x38\xf5\x4a\x31\x61\x38\x58\x93\x29\xc9\x0c\xf0\xb5\x43\x25\xb3\x4c\x7a\x25\x4e\x38\xb1\x2a\xf3\xa3\xc8\x31\x82\xee\xe6\xb7\xc3\xd1\x82\xc1\xf2\x32\x43\x8f\xdc\x45\x10\x05\xd4\x3d\x34\x58\x06\x52\x95\xea\xd4\xae\xa1\xd4\x60\xe2\x93\x34\x87\x7b\x40\xf6\xe0\x94\xc1\x52\x8b\xf9\x1c\x67\xc5\xfd\x5d\x31\x2f\x45\xe0\x0f\xa3\xe8\xcb\xb6\x4b\x19\xca\x70\x54\xc5\xa5\xe2\x4b\x9d\x72\x30\x4e\x92\xbf\x48\x87\x98\x09\x0e\x79\x6c\x2a\x1e\x15\x59\xb2\x57\xbb\x57\x2d\x11\xc2\x84\x5f\x53\x3c\x2a\x8e\x5b\x96\x44\x18\xab\x31\xed\xb6\x50\xac\x86\xde\x9e\x48\x50\xad\x36\x26\xec\xc1\x9f\x08\x9d\x66\xc0\xae\xbe\x74\x84\x3f\x24\x7d\x6f\xd7\x9e\x83\x9b\x59\x79\x59\xa5\x2e\xce\x36\x36\x57\x2d\x33\xb8\x58\x09\xea\xd7\x99\xb8\xbc\x23\xb7\x03\xda\x7a\x5d\x3b\xd0\x47\xa2\xe5\xdf\xdb\xdc\x97\x67\x25\x01\xc1\x9a\x95\x5f\xa2\x50\xc0\x16\xbc\xef\xef\x75\x4d\xb8\xd5\x1c\x0b\xf7\x41\x59\x84\xf9\x8a\x12\xe3\xc3\xb9\xad\xb3\x75\x37\x62\x55\x2e\x61\x31\x90\x82\xd1\xa2\x4f\x0d\x5f\xd1\x2c\x5c\xd6\x10\xb7\x22\xd4\xca\x22\x4f\x1e\x37\xa4\xc8\x4d\x1b\x43\x05\x75\x97\xaa\x54\x8e\x8e\xfb\x82\x98\x79\x45\xd7\xbe\x51\xa6\x64\x9f\x7b\x41\x5f\x18\xe6\x93\x71\x2f\xa6\x7f\xf6\xb3\x74\x2a\x49\x18\x58\x7d\x21\x44\x1f\xc0\x7b\x31\x69\x84\x5f\xdb\x53\x89\x25\x7a\x49\x5f\xbe\x62\x5b\xf8\xc5\x64\x2a\xaf\xcc\xaa\xe4\x90\xee\xdf\x57\x51\xef\xda\x70\x5e\xc2\xb5\x79\xec\xc2\x65\x72\x5b\x07\xaf\x22\xad\x2e\x13\x0c\x79\x78\x13\x09\x1d\x19\x94\x67\x78\x0d\xc4\xcc\xa5\xaf\x3a\x62\x2d\xde\xd5\x49\xac\xd2\xf1\xfa\x02\x66\x53\xc7\xdf\x79\x43\x80\xee\xdd\x15\x89\xbc\x28\xf6\x9c\x05\xaa\xad\x3b\x6b\xb9\x01\xa7\xb2\x44\x38\x3c\x2c\x3e\x6d\x76\xfc\xee\x1d\x38\x48\xa3\xd0\x67\x7a\x3a\x0e\x2e\x2e\xf0\xf5\xf4\xa3\xa3\x1e\x90\x03\xe2\x63\x4c\x2d\x40\x7a\xba\x28\x07\x5d\x44\xdb\xd5\x63\xa6\x45\xbe\x02\xda\xcc\x40\x05\x94\x63\xa1\xac\xf8\x89\x31\xfe\x00\x86\x43\xed\x76\xa8\xc0\x77\x96\xcc\xc1\xf6\xfb\x9f\xbf\x4d\x53\x54\x4e\x16\xbc\xbf\xbb\xb7\x6f\xae\xa7\xe5\xa1\x36\xb8\xb7\xdf\x23\x49\xa6\x97\xf6\x8c\x3b\xe7\x25\xa3\xc8\x0c\x1e\x3e\x5e\x61\x93\xb9\xb7\xe9\xff\x4f\x07\x3f\xba\xb2\x6f\x6d\xf4\xe8\x72\x32\xbb\x9c\x5c\xd9\xcd\x5f\x3f\xe3\xbf\x13\xc5\x7d\x1e\xcc\x9c\x32\xaa\x74\x14\x3d\x0c\x32\x4e\xaa\xfa\xe1\x20\xc4\xca\xca\x0b\x7d\x45\x57\x87\x54\x13\xf9\xc6\xc1\x3f\xae\x07\x96\x0f\x91\x16\x8a\x3d\x99\x66\x83\x69\xa7\x81\xfa\x17\xdc\xfe\x41\x35\x48\x98\xa9\xea\xa2\x0e\x64\xd8\x28\xf8\x0d\xa5\xff\x07\x85\xc8\x4d\xa3\xb6\x63\xa7\x6b\x1d\xb2\xff\xf5\x20\xf0\xa2\x75\x1c\xc2\x0c\x12\x19\xfe\x07\xed\x11\xb3\xe0\xa7\x70\x00\x00")
 
 func latestSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -94,7 +116,7 @@ func latestSql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "latest.sql", size: 19857, mode: os.FileMode(420), modTime: time.Unix(1518565282, 0)}
+	info := bindataFileInfo{name: "latest.sql", size: 28839, mode: os.FileMode(420), modTime: time.Unix(1792042818, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -119,6 +141,186 @@ func migrations10_add_trades_priceSql() (*asset, error) {
 	return a, nil
 }
 
+var _migrations11_add_close_time_versionSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd3\xd5\x55\xd0\xce\xcd\x4c\x2f\x4a\x2c\x49\x55\x08\x2d\xe0\x72\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\xf0\xf7\xf3\x89\x54\xc8\xc8\x2c\x2e\xc9\x2f\xaa\x8c\xcf\x49\x4d\x49\x4f\x2d\x2a\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x48\xce\xc9\x2f\x4e\x8d\x2f\xc9\xcc\x4d\x8d\x2f\x03\x4a\x65\xe6\xe7\x29\x64\xe6\x95\xa4\x02\x95\x59\x73\x71\xe9\x22\x99\xeb\x92\x5f\x9e\x47\xd8\x64\x97\x20\xff\x00\xdc\x46\x5b\x73\x01\x00\x67\x52\x61\xc6\xaa\x00\x00\x00")
+
+func migrations11_add_close_time_versionSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations11_add_close_time_versionSql,
+		"migrations/11_add_close_time_version.sql",
+	)
+}
+
+func migrations11_add_close_time_versionSql() (*asset, error) {
+	bytes, err := migrations11_add_close_time_versionSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/11_add_close_time_version.sql", size: 170, mode: os.FileMode(420), modTime: time.Unix(1792036236, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations12_add_operation_successfulSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd3\xd5\x55\xd0\xce\xcd\x4c\x2f\x4a\x2c\x49\x55\x08\x2d\xe0\x72\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\xf0\xf7\xf3\x89\x54\xc8\xc8\x2c\x2e\xc9\x2f\xaa\x8c\xcf\x2f\x48\x05\xaa\xc9\xcc\xcf\x2b\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x28\x2e\x4d\x4e\x4e\x2d\x2e\x4e\x2b\xcd\x51\x48\xca\xcf\xcf\x49\x4d\xcc\xb3\xe6\xe2\xd2\x45\x32\xd2\x25\xbf\x3c\x8f\x28\x43\x5d\x82\xfc\x03\x30\x4d\xb5\xe6\x02\x00\xf0\xf5\xdb\x62\xa0\x00\x00\x00")
+
+func migrations12_add_operation_successfulSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations12_add_operation_successfulSql,
+		"migrations/12_add_operation_successful.sql",
+	)
+}
+
+func migrations12_add_operation_successfulSql() (*asset, error) {
+	bytes, err := migrations12_add_operation_successfulSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/12_add_operation_successful.sql", size: 160, mode: os.FileMode(420), modTime: time.Unix(1792036823, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations13_create_ledger_changes_tableSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x92\x4f\x6b\x84\x30\x10\xc5\xef\xf9\x14\xc3\x9e\x94\xea\xa1\xa5\x94\xc2\x9e\xb6\x55\x8a\x20\xda\x6e\x15\x7a\x0b\x31\xce\xba\x01\x9b\x48\x4c\xff\xf8\xed\x1b\x57\x05\x77\xd7\x5d\x9a\xe3\xbc\xdf\xcc\x4b\xde\xc4\xf7\xe1\xe6\x53\x54\x9a\x19\x84\xbc\x21\xcf\xdb\x70\x93\x85\x90\x6d\x9e\xe2\x10\xf6\xa2\x35\x4a\x77\xb4\xc6\xb2\x42\x4d\xf9\x9e\xc9\x0a\x5b\x70\x08\xd8\x33\x89\xaa\x41\xdb\x2c\x94\xa4\xa2\x84\x42\x54\x42\x1a\x48\xd2\x0c\x92\x3c\x8e\xbd\x03\xb9\x52\xba\x44\xbd\x02\xab\xa0\x9d\x73\xa2\x0e\x53\xa9\xe9\x1a\xbc\x40\xa0\x34\xd6\xe7\x0a\xc0\x38\x57\x5f\xd6\xd6\x8e\xd2\x8c\x1b\xab\x7f\x33\xdd\x09\x59\x39\x0f\xf7\xee\x29\xdb\xb6\xb8\x44\xde\xde\x3d\xba\x03\x51\xb0\x9a\x49\x8e\xb4\xc0\x9d\xd2\x38\x3e\xe9\x58\x62\xbb\xbe\x75\xae\x0c\x77\x1c\x5b\x0c\xfe\x1e\x95\x07\xbc\xaf\x12\x77\x4d\xa6\x8c\xf3\x24\x7a\xcb\x43\x88\x92\x20\xfc\x38\xa4\x49\x6b\x4e\x0b\x1b\x68\x9f\x16\xa4\xc9\xa5\xf8\xf3\xf7\x28\x79\x81\xc2\x68\x44\x70\x96\xb6\xe0\x4d\x89\xcf\xcc\xce\x5c\xa6\xcc\xfe\xe9\x33\xe2\xde\xe2\xda\x7b\x1f\x7f\xf6\x8f\x02\xf5\x23\x49\xb0\x4d\x5f\xaf\xff\x23\xce\x5a\xce\x4a\x5c\x93\x3f\x13\x71\x34\x77\x84\x02\x00\x00")
+
+func migrations13_create_ledger_changes_tableSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations13_create_ledger_changes_tableSql,
+		"migrations/13_create_ledger_changes_table.sql",
+	)
+}
+
+func migrations13_create_ledger_changes_tableSql() (*asset, error) {
+	bytes, err := migrations13_create_ledger_changes_tableSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/13_create_ledger_changes_table.sql", size: 644, mode: os.FileMode(420), modTime: time.Unix(1792037107, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations14_add_asset_stats_toml_contentSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x8f\x31\x0a\x02\x31\x14\x05\xfb\x9c\xe2\xf5\x92\x13\xa4\x8a\x66\xad\xbe\x89\x2c\x49\x61\xb5\x44\xf9\x8a\xb0\x9b\x48\xf2\x41\x8f\x6f\x63\x21\x08\xc2\x1e\x60\x98\x19\xad\xb1\x59\xee\xb7\x96\x85\x91\x1e\xca\x52\x1c\x46\x44\xbb\xa5\x01\xc1\xd3\x09\xb9\x77\x96\xa9\x4b\x96\x0e\xeb\x1c\x76\x81\xd2\xc1\x43\xea\x32\x4f\x97\x5a\x84\x8b\x40\xf8\x25\x66\x1d\xca\xad\xd5\x86\x73\xad\x33\xe7\x02\x37\xec\x6d\xa2\x88\x6b\x9e\x3b\xc3\x87\x08\x9f\x88\x8c\x52\xfa\xab\xce\xd5\x67\xf9\x2f\x71\x63\x38\xfe\x5a\xcc\x4a\xe8\x73\x65\xd4\x1b\x70\xde\xf8\x38\x1b\x01\x00\x00")
+
+func migrations14_add_asset_stats_toml_contentSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations14_add_asset_stats_toml_contentSql,
+		"migrations/14_add_asset_stats_toml_content.sql",
+	)
+}
+
+func migrations14_add_asset_stats_toml_contentSql() (*asset, error) {
+	bytes, err := migrations14_add_asset_stats_toml_contentSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/14_add_asset_stats_toml_content.sql", size: 283, mode: os.FileMode(420), modTime: time.Unix(1792037248, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations15_create_ingestion_outbox_tableSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x52\xc1\x52\xc2\x30\x10\xbd\xf7\x2b\xf6\x06\x8c\xe2\x0c\x57\x38\x85\x76\x1d\x3a\xa6\x29\xa6\x29\xc8\xa9\x13\xda\x4c\xcd\x58\xda\xda\x46\x01\xbf\xde\x50\x14\x1d\x18\x46\x73\xdb\xf7\x76\xdf\x7b\xd9\xd9\xe1\x10\x6e\x36\x3a\x6f\xa4\x51\x10\xd7\x8e\xcb\x91\x08\x04\x41\xa6\x14\x41\x97\xb9\x6a\x8d\xae\xca\xa4\x7a\x33\xeb\x6a\x07\x7d\x07\xec\xd3\x19\xac\x75\xae\x4b\x03\x2c\x14\xc0\x62\x4a\x6f\x3b\xbc\x50\x59\xae\x9a\xe4\x1a\x5d\xcb\x7d\x51\x49\x4b\xee\x8d\x92\x67\x5c\xda\x28\x1b\x20\x4b\xa4\x01\xa3\x37\xd6\x54\x6e\x6a\xd8\x6a\xf3\x6c\x8d\x3b\x04\x3e\xaa\x52\x9d\x86\x9c\xc1\xc4\xf9\x8e\x1a\xe1\x63\x8c\xcc\xbd\x4c\x6b\x83\x24\xad\x7a\xed\xf4\x23\x41\xb8\x80\xa5\x2f\x66\x30\xea\x00\x9f\xd9\xf1\x00\x99\x80\xe9\xea\x0b\x62\x21\x04\x3e\x5b\x10\x1a\xe3\xa9\x26\x4f\x3f\xb5\x4b\xdc\x19\xc2\xc8\x3a\x13\x2a\x90\xff\x69\x0c\xe1\x92\xa1\x77\xd0\x3f\x6f\xb8\xd3\xd9\x49\xe5\xb8\xe9\x90\xd1\xcb\x36\x38\x76\xb8\x21\x8d\x03\x76\xd8\x7a\x84\x02\x3c\xbc\x27\x31\x15\x50\xaa\x9d\x79\x97\x45\xbf\x77\xc5\xbd\x37\x1e\x37\x2a\x4f\x0b\xd9\xb6\x83\xff\x98\x75\x5f\x24\x9e\x67\xed\x58\x24\x38\xf1\xed\x6a\x2e\xa4\xeb\x17\xb5\x87\x39\xf7\x03\xc2\x57\xf0\x80\x2b\xe8\xeb\xec\xa0\x3e\xfc\x75\x44\x5e\xb5\x2d\x1d\x8f\x87\xf3\x6b\x47\x94\xca\x36\x95\x99\x9a\x38\x9f\xa0\xa1\x3e\xa5\x7b\x02\x00\x00")
+
+func migrations15_create_ingestion_outbox_tableSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations15_create_ingestion_outbox_tableSql,
+		"migrations/15_create_ingestion_outbox_table.sql",
+	)
+}
+
+func migrations15_create_ingestion_outbox_tableSql() (*asset, error) {
+	bytes, err := migrations15_create_ingestion_outbox_tableSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/15_create_ingestion_outbox_table.sql", size: 635, mode: os.FileMode(420), modTime: time.Unix(1792037669, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations16_create_transaction_xdr_tableSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x90\xcd\x0e\x82\x30\x10\x84\xef\x7d\x8a\x3d\x62\x94\x27\xe0\x54\xa1\x07\x62\x2d\xa4\x96\x03\x27\x52\xa1\x62\xa3\xfc\xa4\xac\x11\xde\x5e\x25\x1e\x3c\x40\x9c\xeb\x37\xd9\x9d\x19\xdf\x87\x6d\x63\x6b\xa7\xd1\x40\xd6\x93\x50\x32\xaa\x18\x28\xba\xe7\x0c\xae\x76\xc0\xce\x4d\x05\x3a\xdd\x0e\xba\x44\xdb\xb5\xc5\x58\x39\xf0\x08\xbc\xb5\x44\x6d\x05\x67\x5b\xdb\x16\x41\x24\x0a\x44\xc6\xf9\x6e\xf6\xe2\x58\x38\x33\x3c\xee\x08\x68\xc6\x05\xd8\x18\xd4\x2b\xe8\x62\xcc\x02\x26\x9b\x80\x10\xca\x15\x93\xdf\xac\x89\xe0\xf9\x5a\xe0\xf9\x14\x8d\x22\x08\x13\x71\x52\x92\xc6\x42\xad\x59\x8b\xfe\x66\x26\x48\x65\x7c\xa4\x32\x87\x03\xcb\xc1\x5b\xee\xf9\xf9\xef\xff\x6c\x17\x75\xcf\x96\x44\x32\x49\xff\x6c\x57\xea\xa1\xd4\x95\x09\xc8\x0b\xe8\x25\x5a\x92\x79\x01\x00\x00")
+
+func migrations16_create_transaction_xdr_tableSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations16_create_transaction_xdr_tableSql,
+		"migrations/16_create_transaction_xdr_table.sql",
+	)
+}
+
+func migrations16_create_transaction_xdr_tableSql() (*asset, error) {
+	bytes, err := migrations16_create_transaction_xdr_tableSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/16_create_transaction_xdr_table.sql", size: 377, mode: os.FileMode(420), modTime: time.Unix(1792037985, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations17_create_account_flags_tableSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x91\x31\x6f\xc2\x30\x10\x85\x77\xff\x8a\x1b\x83\x20\x5b\xc5\xc2\x44\x1b\xab\x8a\x14\x39\x2d\x8d\x25\x36\xcb\x71\x2e\xc6\x12\xb5\xc1\x31\x54\xfc\xfb\x9a\x40\x24\x94\x36\x55\x3d\xfa\x7d\xf7\xee\xde\x5d\x9a\xc2\xfc\xd3\x68\x2f\x03\x02\x3f\x90\x97\x0d\x5d\x57\x14\xaa\xf5\x73\x41\x61\x67\xba\xe0\xfc\x45\x48\xa5\xdc\xc9\x06\xd1\xee\xa5\xee\x20\x21\x10\xdf\xa0\xb9\x03\xc6\x5a\xe3\xac\x30\x0d\xd4\x46\x1b\x1b\x80\x95\x15\x30\x5e\x14\x8b\x9e\xdc\x63\xa3\xd1\x8b\x0e\x8f\x27\xb4\x0a\x21\x12\x18\x3f\x46\xd4\xbd\x07\xa8\x9d\xf4\x52\x85\xa8\x9f\xa5\xbf\x18\xab\x93\xe5\xd3\x6c\xc4\xf6\x73\x88\x1a\x5b\xe7\xa7\xec\x6e\x88\x6c\xaf\x46\x63\x82\xcc\x56\x64\x08\xca\x59\xfe\xce\x29\xe4\x2c\xa3\xdb\x3e\x53\xac\x11\xf5\x35\x16\x94\x6c\x62\x01\xfc\x23\x67\xaf\x50\x07\x8f\x08\xc9\x6f\x7b\x78\xf0\xff\x61\x3c\xe4\xfc\x9f\xfb\x5d\x5a\xc0\x54\x9b\xf4\xe1\x7c\x99\xfb\xb2\x24\xdb\x94\x6f\x7f\x9e\x4f\xc9\x4e\xc9\x06\x57\xe4\x1b\x95\x27\xe8\x2d\xfa\x01\x00\x00")
+
+func migrations17_create_account_flags_tableSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations17_create_account_flags_tableSql,
+		"migrations/17_create_account_flags_table.sql",
+	)
+}
+
+func migrations17_create_account_flags_tableSql() (*asset, error) {
+	bytes, err := migrations17_create_account_flags_tableSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/17_create_account_flags_table.sql", size: 506, mode: os.FileMode(420), modTime: time.Unix(1792038240, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations18_add_trades_offer_remainingSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x8e\x41\x0a\x83\x30\x14\x05\xf7\x39\xc5\xdb\xb7\x39\x81\xab\x48\xa4\x08\xd2\x8a\xd8\xb5\x84\xfa\xd5\x50\xfd\x29\x49\x44\x7a\xfb\x5a\x05\x77\xa5\xcb\x81\x61\xde\x93\x12\xa7\xc9\xf6\xde\x44\xc2\xfd\x25\x84\x94\x50\x93\x9b\x39\xc2\x75\x88\x03\xe1\xe1\x5d\x08\xd4\xae\xd8\x91\x87\xa7\xc9\x58\xb6\xdc\xc3\x74\x71\xe5\xaf\x11\xbd\x69\xe9\x0c\x9e\xc7\x11\xcb\x40\x8c\x99\x9f\xec\x16\x16\xaa\xa8\xb3\x0a\xb5\x4a\x8b\x0c\x83\x0d\xd1\xf9\x77\xb3\xc9\x01\x4a\xeb\xbd\xd8\x1c\xc5\xc6\xec\xbb\x69\x7e\xc9\xaf\x75\xb2\x5d\x39\xae\xe9\x3f\x3d\x5d\xdd\xca\x1f\xc1\x44\x7c\x00\x84\x66\x45\x4d\xe4\x00\x00\x00")
+
+func migrations18_add_trades_offer_remainingSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations18_add_trades_offer_remainingSql,
+		"migrations/18_add_trades_offer_remaining.sql",
+	)
+}
+
+func migrations18_add_trades_offer_remainingSql() (*asset, error) {
+	bytes, err := migrations18_add_trades_offer_remainingSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/18_add_trades_offer_remaining.sql", size: 228, mode: os.FileMode(420), modTime: time.Unix(1792038483, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations19_add_transactions_signature_countSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x8e\xb1\x0a\x83\x30\x14\x00\xf7\x7c\xc5\x1b\x95\x2a\xb4\xb3\x74\x48\x4d\xb6\x80\xa2\x71\x96\x20\x21\x06\xda\x97\xf2\xf2\xa4\xf8\xf7\xdd\x4a\x69\x29\xdd\xef\xb8\xab\x6b\x38\xdc\x62\x20\xc7\x1e\xa6\xbb\x10\xd2\x58\x3d\x80\x95\x17\xa3\x61\x8d\x99\x13\xed\x33\x93\xc3\xec\x16\x8e\x09\x33\x48\xa5\x20\xc7\x80\x8e\x37\xf2\xf3\x92\x36\x64\x88\xc8\x3e\x78\x6a\xc4\xd4\x2b\x69\x7f\x88\xa3\xb6\x5f\xe2\x19\xda\x4e\x1a\x3d\xb6\xba\x70\x44\x6e\x9f\xaf\x1e\x03\xaf\xc5\x8b\xcb\x15\x9c\xca\x0a\x8e\x65\x23\x44\xfd\xb6\xaa\xd2\x03\xff\xbf\xaa\xa1\xeb\x3f\x9b\x8d\x78\x02\x70\xb3\x32\x29\xf3\x00\x00\x00")
+
+func migrations19_add_transactions_signature_countSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations19_add_transactions_signature_countSql,
+		"migrations/19_add_transactions_signature_count.sql",
+	)
+}
+
+func migrations19_add_transactions_signature_countSql() (*asset, error) {
+	bytes, err := migrations19_add_transactions_signature_countSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/19_add_transactions_signature_count.sql", size: 243, mode: os.FileMode(420), modTime: time.Unix(1792038668, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _migrations1_initial_schemaSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x5a\x5f\x6f\xdb\xc8\x11\x7f\xf7\xa7\x18\xdc\x8b\x6c\xd4\x6a\x2f\xb8\xe2\x70\x95\xe1\x03\x14\x99\x69\x84\xca\x54\x22\x51\x4d\x82\xc3\x61\xb1\x22\x47\xd4\xd6\xe4\x2e\xb3\xbb\x74\xa4\x2b\xfa\xdd\x0b\x52\x24\xc5\xff\xa4\x1c\xc9\xf7\x28\xee\xec\xcc\xfc\x66\x66\x7f\x33\x5c\x6a\x38\x84\xbf\xf8\xcc\x95\x54\x23\xac\x82\xab\xe1\xf0\x6a\x38\x84\x0f\x42\x69\x57\xe2\xf2\xe3\x0c\x1c\xaa\xe9\x9a\x2a\x04\x27\xf4\xe3\xe5\xab\xa5\x61\x81\xd2\x54\xa3\x8f\x5c\x13\xcd\x7c\x14\xa1\x86\x7b\xf8\xf1\x2e\x5e\xf2\x84\xfd\x54\x7d\x6a\x7b\x2c\x92\x46\x6e\x0b\x87\x71\x17\xee\x61\xb0\xb2\xde\xfd\x32\xb8\x4b\xd5\x71\x87\x4a\x87\xd8\x82\x6f\x84\xf4\x19\x77\x89\xd2\x92\x71\x57\xc1\x3d\x08\x9e\xe8\xd8\xa2\xfd\x44\x36\x21\xb7\x35\x13\x9c\xac\x85\xc3\x30\x5a\xdf\x50\x4f\x61\xc1\x8c\xcf\x38\xf1\x51\x29\xea\xc6\x02\xdf\xa8\xe4\x8c\xbb\x77\x57\x09\x3c\x93\xfa\x38\x82\xc0\x0b\x5c\xf5\xd5\xbb\x03\x6b\x1f\xe0\x08\x8c\xcf\x96\x61\x2e\xa7\x73\xf3\x0e\x96\xf6\x16\x7d\x3a\x82\xe1\x1d\xcc\xbf\x71\x94\x23\x18\xc6\xc8\x27\x0b\x63\x6c\x19\x47\x49\x98\xbe\x03\x73\x6e\x81\xf1\x79\xba\xb4\x96\xa9\x42\xf8\x34\xb5\xde\xc3\x72\xf2\xde\x78\x1c\x43\xe0\x12\x9b\x6a\xea\x89\xc8\x7a\xc1\xfc\x51\x4b\xc9\x91\xc9\xfc\xf1\xd1\x30\xad\x16\x37\x0e\x02\x30\x37\xab\x4a\x60\xba\x84\xc1\x87\xd9\xdf\x02\x37\x4a\x5e\x20\x85\x8d\x4e\x28\xa9\x07\x1e\xe5\x6e\x48\x5d\x1c\x94\xfd\xd8\x2a\x2d\x24\x9e\x2f\x0a\x07\x7d\xc5\x20\x84\x6b\x8f\xd9\xcd\x01\x28\xba\xf0\x32\xfc\x89\xd9\x08\x7e\x54\xb2\xa0\xf7\x01\xc2\x46\x48\x88\x9e\x47\x15\xa7\x50\x2b\x10\x1b\xb8\x7e\xc2\xfd\x2d\x3c\x53\x2f\xc4\x1b\x08\x28\x93\x2a\x0e\x49\x5c\x86\x48\xa5\xbd\x25\x01\xd5\x5b\xb8\x4f\xbc\xbe\x2d\xa6\x30\x12\x73\x70\x43\x43\x4f\x13\x4d\xd7\x1e\xaa\x80\xda\x18\x95\xf3\xa0\xb4\xfa\x8d\xe9\x2d\x11\xcc\xc9\x55\x68\x31\xee\x2c\xf2\x6c\x4f\xa8\x6d\x8b\x90\x6b\x95\xc2\xb7\xc6\x6f\x67\xc6\x11\x7c\x12\xbb\x2c\x02\x77\x60\x65\x66\x47\xf9\x7c\xc4\xfb\x2a\x5a\xe1\xfa\x0a\x00\x80\x39\xb0\x66\x2e\xe3\x3a\xce\x94\xb9\x9a\xcd\x6e\xe3\xe7\xd4\x71\x24\x2a\x05\xf6\x96\x4a\x6a\x6b\x94\xf0\x4c\xe5\x9e\x71\xf7\xfa\xe7\xbf\xdf\x5c\xdd\x54\x6a\x25\xd1\x8e\x9b\x0d\xda\xe7\x76\x39\x51\x9a\x78\x5c\x02\x42\x9a\x10\xa4\x72\x22\x40\x49\x63\x5e\x68\x92\xfc\x41\x48\x07\xe5\x0f\xc0\xb8\x46\x17\x65\x69\x35\xae\x97\xfa\x25\x07\x35\x65\x9e\x82\xff\x28\xc1\xd7\xcd\x41\xf1\xd0\x71\x51\x9e\x39\x28\x89\xd2\x24\x28\x0a\xbf\x86\xc8\xed\x26\x47\x0f\xc2\x64\x4b\xd5\xb6\x3e\xa3\x25\xf9\x40\xe2\x33\x13\xa1\x22\x9d\x1b\x93\x18\x49\xca\x15\x3d\xb0\x6f\x9c\x95\xcc\x8f\x07\xe3\xdd\x78\x35\xb3\xe0\xc7\x92\x85\x63\x56\xfa\xc9\xdb\x9e\x50\xe8\x10\xaa\x21\xea\x20\x4a\x53\x3f\x80\xe8\x20\x45\xbd\x24\x7a\x02\x7f\x08\x8e\xe5\x3d\x12\xa9\xee\xdc\x74\x90\x0d\x03\xa7\xb7\x6c\x56\x47\xc9\x4f\x3f\x10\x52\xa3\x24\xcf\x28\x15\x13\xbc\x82\xe5\x4d\xb9\xa2\x84\xa6\x1e\xb1\x05\xe3\xaa\xbe\x20\x37\x88\x24\x10\xc2\xab\x5f\x8d\x9a\x2e\xd9\x60\x53\xae\xe3\x65\x89\x0a\xe5\x73\x93\x88\x4f\x77\x44\xef\x88\x42\x4d\x14\xfb\xa3\x2a\xd5\x5c\xca\xc7\xb4\x05\x54\x6a\x66\xb3\x80\x9e\x9d\xa1\xea\x6d\x1c\xf9\xaa\x1e\x53\xff\xe3\xde\x4d\x20\xa7\xe2\x27\xcc\x21\x0a\xbf\xa6\x61\x58\x1a\x1f\x57\x86\x39\x69\x89\x44\x1e\x7c\x2a\xdd\xcf\x46\x8c\x60\x69\x8d\x17\xd6\xa1\x91\xbe\x89\x1f\x4c\xcd\xc9\xc2\x88\x5b\xdf\xdb\x2f\xc9\x23\x73\x0e\x8f\x53\xf3\xdf\xe3\xd9\xca\xc8\x7e\x8f\x3f\x1f\x7f\x4f\xc6\x93\xf7\x06\xbc\x39\x0b\x50\x98\x7f\x32\x8d\x07\x78\xfb\xa5\x03\xf1\x78\x66\x19\x8b\x13\x01\x67\xba\x3b\xc4\xff\xca\x9c\x4e\x2c\x97\x2a\xd4\xae\x66\x9a\xa7\xc7\xc6\x86\x1b\x04\x1e\xb3\x0f\xb8\xe2\x7e\xf4\x9d\xed\xe8\xf0\x48\x89\x50\xda\x98\x96\x7a\x03\xf7\xa7\x3c\x35\x18\x8c\x46\x15\x89\x1e\x87\x22\x0f\xef\x72\xb4\xd0\x64\x25\x8e\x7d\x03\x2d\xd4\xed\xad\x4f\xc0\xf7\x90\x42\x93\x67\xe7\xa5\x85\x0e\x2b\xaf\x45\x0c\x27\x82\xfd\x4e\x6a\xe8\xb0\x56\x25\x87\xa6\x0d\x2d\xf4\x90\xdb\x72\xb9\x92\x4d\x29\x22\xef\x5f\xef\x71\x2c\x99\xc2\x3a\x86\xbc\xbe\x0c\xd2\x4e\x06\xb5\xb2\x47\xd3\xcd\xf3\x0a\x6d\x6c\xcd\x4d\xb3\xde\x9f\x32\xad\xe9\x1d\x41\xfe\x8c\x9e\x08\x10\x34\xee\x2a\x54\xbd\x8b\x66\xa7\xd0\xd3\x0d\x8b\x3e\x46\xaf\x90\xb5\x4b\x51\x14\x9a\x96\x15\x73\x39\xd5\xa1\xc4\xba\x37\xaa\x7f\xfc\x7c\xf3\xdb\xef\x47\x16\xfe\xef\xff\xea\x78\xf8\xb7\xdf\xcb\x43\x1c\xfa\x82\xc4\xdd\xa0\xca\xd9\x99\x2e\x2e\x38\xb6\xb2\xfa\x51\x57\x55\x4d\x82\x8c\xf9\x48\xd6\x22\xe4\x8e\x8a\x32\xf7\x8b\xa4\xdc\xc5\x98\x0c\xf3\x87\x89\x39\xe9\xd1\x49\x6c\xf7\x3a\xef\x87\xe3\x32\x37\x67\x5d\xdd\x1d\x0e\xf2\x93\xf9\x6c\xf5\x68\x46\x29\x8d\x5e\xa8\x53\x94\x1c\x77\xfa\x99\x7a\xd7\x83\x5e\x03\xc5\x60\x34\x92\xe8\xda\x1e\x55\xaa\xc2\xe8\x67\x43\xd1\xd8\xac\x4e\xc2\xd1\xc1\x7e\x6d\x48\x3a\x42\x11\x3c\xe1\xfe\x78\xad\x62\x2e\xad\xc5\x78\x6a\xb6\xa0\xad\x12\xde\x89\x09\x8c\x4b\x69\xfc\xf0\x90\xb3\xd6\xc7\x47\xf8\xb0\x98\x3e\x8e\x17\x5f\xe0\x5f\xc6\x17\xb8\x66\xce\xe9\x3d\xf8\x82\x48\x9b\x6c\xb6\x61\x6d\xf5\xb3\x13\xed\x3a\x1b\x50\x52\x48\x53\xf3\xc1\xf8\xfc\x82\x46\x15\xef\xcb\xe9\x83\xb9\x59\xdf\xb6\x56\xcb\xa9\xf9\x4f\x58\x6b\x89\x08\xd7\x89\xf0\x6d\xa5\x2f\xd4\x79\x1a\xb5\xb7\xb3\xb9\x19\xf7\xca\x5e\x3e\x96\x3b\x6c\x9d\x6b\x87\x86\x7a\x36\xe7\x0e\xea\xfa\xb9\x57\xea\xe5\xb7\xd5\xb6\x5d\x5b\xe3\x04\xc9\x7a\x7f\x58\xff\x5e\xb7\x57\xe6\xf4\xe3\x2a\xf5\xbe\xa4\x3b\x8f\x21\xbd\x76\x2b\xb8\x5f\xf7\x9a\x7d\x9b\xde\xa0\x35\x79\x7e\xa4\xd5\x73\xfa\xcc\x9c\xde\xde\x1e\xa7\xfa\xdb\xda\x8b\x82\x0e\x04\x22\x20\xc1\x45\x40\x24\x8a\xf3\x38\x1a\xfa\xdf\x8b\x60\x55\xd1\x64\x37\x7a\xeb\xfd\xd9\x01\x15\x75\xe7\x31\xa5\x77\x95\x05\x10\xf5\xee\xe5\x4f\xef\x45\x7c\xac\x18\xe8\x77\x6c\x6b\xbc\x65\xdc\xc1\x1d\x29\xdf\xab\x13\xc1\x49\x72\x79\x7e\x56\xd7\x3b\xad\xe5\x71\x64\x97\xfc\x45\xf6\x3e\x08\x9e\x00\xe4\xcc\xe1\x6f\x33\xd4\xed\x7e\x67\x0a\x12\x0a\x88\xf4\x45\x73\xf1\x79\xe8\xbd\xd5\x44\x27\x01\x45\x42\x1d\x5e\x27\x87\x23\x52\x99\x5d\x72\x5f\xc2\xf5\x3a\x3b\x9d\x87\x34\x93\xec\x0f\xe2\xa2\x35\x53\xb0\xf3\x12\x8a\x69\x56\x57\xba\xc5\xbf\x70\x0a\x2a\x1f\x0d\x3a\xb1\x94\x36\xf4\x47\x96\xfb\x86\xf3\x3a\x99\xc9\x7f\x34\xea\x82\x95\x93\xed\x8f\xa8\xee\xf3\xd4\xeb\x40\xab\xfd\x30\xd6\x85\xb1\x6e\x53\x7f\xb0\xe9\xa4\xf8\x3a\x00\xb3\x8b\x9e\x2e\x50\x8d\x93\x7f\x51\xf5\xf1\x8e\xfc\xe2\xdc\x50\x36\x55\x3b\x55\x9d\xca\x10\x45\xa5\xc5\x7b\xe4\x4b\x50\x44\x9b\xbd\x3e\x80\x8a\x3b\x4e\x03\x77\xa1\x9e\x59\xb5\xd2\x0b\x48\x5d\xe7\x8c\x87\x66\xbd\xbb\xd0\x34\x9e\x28\x6e\x18\x08\x5f\x38\x8f\x57\x13\xd2\x9c\x8f\xfc\xf8\x79\xf1\xe3\x52\x35\xf6\xe2\x49\x58\x4b\xea\x60\x36\x1b\xa5\xef\x92\x64\x2d\xc4\xd3\x79\x0a\xaa\xc5\x40\xe7\x08\x76\x7d\x9d\x7e\x17\x1b\xfe\xfa\x2b\x0c\x94\xf0\x1c\x42\x95\x42\x1d\x97\xe2\x60\x34\xd2\xb8\xd3\x37\x37\xb7\xd0\x2c\x68\x0b\xa7\x9f\x20\x53\x2a\x44\xd9\x2c\xba\x16\xa1\xbb\xd5\xbd\xcc\x17\x44\xdb\x1d\x28\x88\x96\x5c\xb8\x81\x4f\xef\x8d\x85\x71\x38\x4f\x70\x0f\x3f\xfd\x94\xcb\x5e\xd3\xbf\xf9\xc0\x16\x7e\xe0\xa1\xc6\x38\x13\xf9\x3f\x02\x3e\x88\x6f\xfc\xca\x91\x22\x80\xf8\x3f\x4e\xf5\xe5\x62\x53\x65\x53\x07\xef\x3a\x04\x8b\x07\xaa\x6d\x53\x8e\x23\x7a\x89\xf5\xd7\x9c\xb6\xb6\x36\x99\xb4\xaa\xda\x64\xb2\x37\x96\x4c\xe8\xff\x01\x00\x00\xff\xff\x5d\xb2\x1f\x7d\x3f\x29\x00\x00")
 
 func migrations1_initial_schemaSqlBytes() ([]byte, error) {
@@ -139,6 +341,206 @@ func migrations1_initial_schemaSql() (*asset, error) {
 	return a, nil
 }
 
+var _migrations20_add_ledgers_partialSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x8e\x41\x0a\xc2\x30\x14\x05\xf7\x3d\xc5\xdb\x6b\xbc\x40\x57\x91\x54\x10\x42\x15\x4d\xd7\x12\xed\x6f\x1b\x48\x13\xc9\x0f\x8a\xb7\x57\x5b\x75\xe9\xfa\x0d\xf3\x46\x08\x2c\x46\xd7\x27\x9b\x09\xcd\xb5\x28\x84\x80\xa6\xb6\xa7\xc4\x88\x1d\xee\x83\xbb\x0c\x88\xc1\x3f\xc0\x71\x24\xe4\x64\x03\xdb\x4b\x76\x31\x30\x06\x7b\x23\x9c\x89\x02\x5c\xe8\x89\x33\xb5\x4b\x30\xd1\x5b\x71\x24\xe6\x17\xb3\xda\x4e\xc3\xde\xa6\xec\xac\x9f\xbd\x85\xd4\xa6\x3a\xc0\xc8\xb5\xae\x30\x38\xce\x31\x3d\x4e\xfe\x73\x29\x95\xc2\x75\xa6\x71\x8e\xd1\x93\x0d\x50\xd5\x46\x36\xda\xa0\xb3\x9e\x09\xf5\xce\xa0\x6e\xb4\x2e\xa7\xd4\x5f\xba\x8a\xf7\xf0\xd7\xac\x0e\xbb\xfd\x57\x5d\x16\x4f\x2c\xcf\x72\x58\xf6\x00\x00\x00")
+
+func migrations20_add_ledgers_partialSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations20_add_ledgers_partialSql,
+		"migrations/20_add_ledgers_partial.sql",
+	)
+}
+
+func migrations20_add_ledgers_partialSql() (*asset, error) {
+	bytes, err := migrations20_add_ledgers_partialSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/20_add_ledgers_partial.sql", size: 246, mode: os.FileMode(420), modTime: time.Unix(1792038828, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations21_add_ledgers_ingested_bySql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x8e\xc1\x0a\xc2\x30\x10\x44\xef\xf9\x8a\xbd\x6b\xbe\xa0\xa7\x4a\x7a\x10\x0a\x8a\xd4\x73\x89\xc9\x26\x5d\xd0\x44\x36\xab\xa5\x7f\x6f\xa8\x22\x9e\xbc\x0d\x33\xc3\x9b\xd1\x1a\x36\x37\x8a\x6c\x05\xe1\x7c\x57\x4a\x6b\xd8\x7b\x4c\x42\x81\x90\x21\x07\x90\x09\x81\x52\xc4\x22\x94\x53\x55\x45\x6c\x72\x58\x6d\x2b\x30\x73\x16\x5c\x1b\x57\xf4\x11\x79\x0b\x14\xc0\xe5\x14\x28\x3e\x18\xbd\x6a\xfb\xa1\x3b\xc1\xd0\xee\xfa\x0e\x26\x2a\x92\x79\x19\xdf\xcd\x02\xad\x31\x1f\x2e\xfa\xf1\xb2\x80\x9b\x2c\x5b\x27\x75\xf4\x69\x79\xa9\x49\xb3\x9e\xf9\x9e\x33\x79\x4e\x7f\x81\xe6\x74\x38\xfe\x12\x1b\xf5\x02\xb3\x64\x3f\x39\xdc\x00\x00\x00")
+
+func migrations21_add_ledgers_ingested_bySqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations21_add_ledgers_ingested_bySql,
+		"migrations/21_add_ledgers_ingested_by.sql",
+	)
+}
+
+func migrations21_add_ledgers_ingested_bySql() (*asset, error) {
+	bytes, err := migrations21_add_ledgers_ingested_bySqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/21_add_ledgers_ingested_by.sql", size: 220, mode: os.FileMode(420), modTime: time.Unix(1792038993, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations22_add_ledgers_header_fieldsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x91\x31\x4f\xc3\x30\x10\x85\xf7\xfc\x8a\xdb\x00\xd1\x6c\x55\x07\x32\x05\xa5\x15\x43\x25\x50\x29\xb3\xe5\xc6\xd7\xd8\xc2\x38\x95\xef\xd2\xa4\xff\x1e\xdb\x41\x55\xa5\x48\xb4\x12\x2c\x1e\xee\xee\x3d\xbf\xef\x2e\xcf\xe1\xf1\xcb\x34\x5e\x32\xc2\xc7\x21\xcb\xf2\x1c\x5e\x50\x2a\xf4\xb0\x37\x68\x15\x01\x71\xeb\x51\x41\xaf\xd1\x81\x71\x0d\x12\x87\x17\x7a\xc3\x1a\xde\x63\x6b\xd5\x59\x3b\x2a\x56\x49\xf0\x04\xac\x11\xb4\x24\x8d\x14\xdd\xda\x7d\x2a\x58\x54\x0d\xfa\x3b\x82\x5d\x57\x7f\x22\x83\x35\xc4\x33\x60\x2f\x1d\xc9\x9a\x4d\xeb\x80\x42\x55\x3a\x35\xa9\x79\xa4\xce\x32\xcd\xa2\x59\xea\x07\xb7\x9d\x24\x5c\xcc\x61\x50\x7e\xe2\x4f\xf5\x01\x8e\xd2\x76\x98\x95\xeb\xed\x72\x03\xdb\xf2\x79\xbd\x04\x6d\x22\xc7\x49\x8c\x63\x04\x65\x55\xfd\x24\x11\x31\x89\x88\x79\xa1\xd6\xd2\x87\x8f\x03\xfb\x51\xfa\x53\xc0\xbc\x5f\xcc\x1f\x8a\xab\x3e\x3c\x88\x90\xf3\x3f\x2c\x46\xd4\x3f\x39\x05\x7c\x91\xf0\x81\x71\xe0\x22\x1d\xf4\x7c\xe0\xaa\xed\xdd\xaf\x0e\xd5\xe6\xf5\x6d\xb2\x97\xe2\xba\xe4\x62\x05\xb7\x4f\x5f\xd0\xde\x20\x3a\x83\x15\xd9\x37\x8f\x43\x38\x77\xb4\x02\x00\x00")
+
+func migrations22_add_ledgers_header_fieldsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations22_add_ledgers_header_fieldsSql,
+		"migrations/22_add_ledgers_header_fields.sql",
+	)
+}
+
+func migrations22_add_ledgers_header_fieldsSql() (*asset, error) {
+	bytes, err := migrations22_add_ledgers_header_fieldsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/22_add_ledgers_header_fields.sql", size: 692, mode: os.FileMode(420), modTime: time.Unix(1792040274, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations23_add_ledgers_trustlines_changedSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x8f\xb1\x0e\xc2\x30\x0c\x44\xf7\x7e\xc5\x6d\x0c\xd0\x2f\x60\x2a\x94\x0d\x01\x42\x65\x46\x25\x35\x4d\xa4\x36\xa9\x6c\x87\x8a\xbf\x27\x05\x01\x0b\x62\xb1\x4e\x3a\xfb\x9d\x2f\xcf\x31\xef\x5d\xcb\xb5\x12\x4e\x43\x96\xe5\x39\x76\xb1\xbf\x10\x23\x5c\xa1\x1c\x45\x3b\xe7\x09\xe4\x95\x1d\x09\x0c\x53\xda\x6c\x16\x88\x43\x33\x09\x04\x06\x53\x1f\x6e\x49\x5e\xee\x50\x4b\xe8\xa8\x69\x89\x67\x32\xa1\x24\x1a\x43\x22\xd7\xd8\x25\x56\xed\xa5\x36\xea\x82\x97\x05\x46\x4b\x1e\xce\xb7\x24\x9a\x26\x46\xa7\x16\xeb\x10\xbd\x56\xef\xc8\xb5\xad\x27\x3b\x2b\xb6\xd5\xe6\x88\xaa\x58\x6d\x37\xb0\x4e\x34\xf0\xfd\xfc\x8a\x10\x14\x65\xf9\xfd\x51\xce\xe6\x79\xd2\x24\xae\x52\xf2\x97\xcf\x36\x9f\x76\x65\x18\xfd\x5f\x5a\x79\xdc\x1f\x7e\xe0\x96\xd9\x03\xda\xfb\x5f\x35\x24\x01\x00\x00")
+
+func migrations23_add_ledgers_trustlines_changedSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations23_add_ledgers_trustlines_changedSql,
+		"migrations/23_add_ledgers_trustlines_changed.sql",
+	)
+}
+
+func migrations23_add_ledgers_trustlines_changedSql() (*asset, error) {
+	bytes, err := migrations23_add_ledgers_trustlines_changedSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/23_add_ledgers_trustlines_changed.sql", size: 292, mode: os.FileMode(420), modTime: time.Unix(1792040355, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations24_add_trade_pair_statsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x91\x4f\x6b\x83\x40\x10\xc5\xef\xfb\x29\xde\x31\xa5\x0a\xbd\xe7\x94\x46\x5b\x04\xd1\x34\x51\xe8\x4d\x56\x9d\xea\xd2\xb8\x2b\xbb\xa3\x21\xdf\xbe\x6a\x1a\xe8\x1f\x42\xe6\x30\xa7\x1f\xef\xcd\x7b\xe3\xfb\x78\xec\x54\x63\x25\x13\xf2\x5e\x08\xdf\xc7\x76\xe8\x86\xa3\x64\x35\x12\xd8\xca\x9a\x50\x99\x41\x33\xa4\xae\x31\x9a\xe3\xd0\x91\x07\xa5\xe1\xd8\x1a\xd3\x3b\x0f\xe6\x03\x34\x92\x3d\x43\x3a\x47\x8c\x5e\x2a\xeb\xe1\xd4\x92\x9e\xb5\x94\x6e\xc8\xf1\xb4\x71\x52\xdc\x22\xb3\xb2\xfa\xcc\x66\xd1\xdd\x84\x1d\x58\xb2\x13\xdb\x7d\xb8\xc9\x42\x64\x9b\xe7\x38\x44\xab\x1c\x1b\x7b\x2e\x16\xe3\x62\xd6\x2a\xdc\x4c\x61\x25\x30\x4d\x29\x1d\x15\x8b\x4f\xa1\x6a\x94\xaa\x51\xd3\x61\x49\x9a\x21\xc9\xe3\xd8\x5b\x90\xe5\x58\xb2\x77\xa8\x8b\xfe\x25\xd8\x37\x10\x84\x2f\x9b\x3c\xce\xf0\xf4\x07\x5d\x3c\x2f\xc1\xa1\xa7\x65\x55\x75\x93\xbd\x9a\xdf\xc5\xc5\xc3\x5a\x5c\x93\xe7\x49\xf4\x96\x87\x88\x92\x20\x7c\x47\xcb\xbd\x2b\xca\xf3\x12\x1d\x69\x72\xbb\x90\xfc\x10\x25\xaf\x28\xd9\x12\x61\xf5\xab\x17\xef\x5f\x07\xb3\x99\xff\xe3\xd1\x81\x39\x69\x11\xec\xd3\xdd\x9d\xd6\xd7\xe2\x0b\x10\x05\x43\xad\x1f\x02\x00\x00")
+
+func migrations24_add_trade_pair_statsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations24_add_trade_pair_statsSql,
+		"migrations/24_add_trade_pair_stats.sql",
+	)
+}
+
+func migrations24_add_trade_pair_statsSql() (*asset, error) {
+	bytes, err := migrations24_add_trade_pair_statsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/24_add_trade_pair_stats.sql", size: 543, mode: os.FileMode(420), modTime: time.Unix(1792040466, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations25_add_transaction_memosSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x91\xcb\x6e\x83\x30\x10\x45\xf7\xfe\x8a\xbb\x4c\x55\xf8\x82\xac\xd2\x82\x2a\x24\x6a\xda\x14\xa4\xee\x90\x49\x1c\x6c\xb5\xd8\xc8\x9e\x94\xf0\xf7\x35\xf4\xa1\xa8\x45\x69\xbd\xf0\xc6\x67\xee\x9c\x19\xc7\x31\xae\x3b\xdd\x3a\x41\x12\x55\xcf\x58\x1c\xa3\x54\x12\x9d\xec\xac\x87\x3d\x80\x9c\x30\x5e\xec\x48\x5b\xe3\x23\x0c\x4a\x1a\x68\xd3\x4a\x4f\xe1\xc6\xa0\x49\x21\x33\x7b\x79\xba\x9f\xf8\x08\x07\xeb\x20\x4f\x01\x47\x27\x68\xa7\xa6\xb4\x57\x6b\x5f\x8e\xbd\x9f\x59\x7b\xa4\x50\x1d\xf0\xa9\x58\x69\x4f\xd6\x8d\xf5\x79\x07\x76\xbb\x4d\x37\x65\x8a\x72\x73\x93\xa7\x4b\x44\xfd\x21\xb6\x62\x08\x67\xe9\x5d\xef\xd1\xe8\x56\x1b\x02\x2f\x4a\xf0\x2a\xcf\xa3\x99\x9d\xea\x6a\x1a\x7b\x89\x9d\x12\x2e\xc0\xd2\xe1\x4d\xb8\x71\x32\xf9\x4d\x5e\x80\xd8\xd5\x9a\x7d\x69\x66\x3c\x49\x9f\xa1\xa8\xab\x9b\x71\x36\x43\xc1\x2f\x58\x57\x4f\x19\xbf\x43\x43\x4e\x4a\xac\xbe\x85\xa2\xb9\x63\x48\xfd\x0c\xad\x78\xf6\x58\xfd\xc8\x56\x14\xe6\xfa\x7f\xf6\xf2\x62\x26\xf1\xf8\xec\xbb\x13\x3b\x18\x96\x6c\x8b\x87\xbf\xd6\xbd\x66\xef\xfc\xfd\xbd\x2a\x26\x02\x00\x00")
+
+func migrations25_add_transaction_memosSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations25_add_transaction_memosSql,
+		"migrations/25_add_transaction_memos.sql",
+	)
+}
+
+func migrations25_add_transaction_memosSql() (*asset, error) {
+	bytes, err := migrations25_add_transaction_memosSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/25_add_transaction_memos.sql", size: 550, mode: os.FileMode(420), modTime: time.Unix(1792040729, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations26_add_operation_result_codeSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd3\xd5\x55\xd0\xce\xcd\x4c\x2f\x4a\x2c\x49\x55\x08\x2d\xe0\x72\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\xf0\xf7\xf3\x89\x54\xc8\xc8\x2c\x2e\xc9\x2f\xaa\x8c\xcf\x2f\x48\x05\xaa\xc9\xcc\xcf\x2b\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x80\x8b\xc6\x17\xa5\x16\x97\xe6\x94\xc4\x27\xe7\xa7\xa4\x2a\x64\xe6\x95\xa4\xa6\xa7\x16\x59\x73\x71\xe9\x22\x99\xee\x92\x5f\x9e\x47\x94\xf9\x2e\x41\xfe\x01\x78\x2d\xb0\xe6\x02\x00\x99\x9f\x2c\x1f\xb6\x00\x00\x00")
+
+func migrations26_add_operation_result_codeSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations26_add_operation_result_codeSql,
+		"migrations/26_add_operation_result_code.sql",
+	)
+}
+
+func migrations26_add_operation_result_codeSql() (*asset, error) {
+	bytes, err := migrations26_add_operation_result_codeSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/26_add_operation_result_code.sql", size: 182, mode: os.FileMode(420), modTime: time.Unix(1792040988, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations27_add_operation_participant_rolesSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\xcd\xb1\x0a\x83\x30\x10\x00\xd0\x3d\x5f\x71\x63\x4b\xc9\xd2\x8e\x4e\x69\xe3\x96\x9a\x22\x3a\x74\x92\x23\x04\x3d\xa8\x49\x38\x8f\x16\xff\x5e\x47\xd7\xee\x0f\x9e\xd6\x70\x99\x69\x64\x94\x08\x7d\x51\xc6\x75\x75\x0b\x9d\xb9\xbb\x1a\x7c\xe3\xde\x30\xd1\x22\x99\xd7\x21\x97\xb8\x1b\xca\x69\x28\xc8\x42\x81\x0a\x26\x59\xc0\x58\x0b\x0f\xef\xfa\x67\x03\x9c\x3f\x11\xc2\x84\x8c\x41\x22\xc3\x17\x79\xa5\x34\x9e\x6e\xd7\x73\xa5\x94\x3e\x34\x36\xff\xd2\xdf\x91\x6d\xfd\xeb\x38\x55\x6a\x03\xa5\xc2\x1e\xa0\xba\x00\x00\x00")
+
+func migrations27_add_operation_participant_rolesSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations27_add_operation_participant_rolesSql,
+		"migrations/27_add_operation_participant_roles.sql",
+	)
+}
+
+func migrations27_add_operation_participant_rolesSql() (*asset, error) {
+	bytes, err := migrations27_add_operation_participant_rolesSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/27_add_operation_participant_roles.sql", size: 186, mode: os.FileMode(420), modTime: time.Unix(1792041143, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations28_add_ingest_checkpointsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x91\xc1\x6e\x83\x30\x0c\x86\xef\x79\x0a\x1f\x3b\x0d\x9e\xa0\xa7\xae\x44\x13\x12\x0a\x5b\x07\xd2\x6e\x28\x80\x0b\x51\x1b\x82\x12\xb3\x8a\x3d\xfd\x92\xd2\x55\x9c\xda\x1c\x12\xe5\x8f\xfd\xdb\x9f\x13\xc7\xf0\xaa\x55\x67\x25\x21\x94\x23\x63\x71\x0c\x45\x8f\x70\x96\x8e\xe0\x8c\x6d\x87\x16\x1a\xa3\xb5\x22\xc2\x16\xea\x19\x1c\x3a\xa7\xcc\xe0\xc0\xa2\x1a\x3a\x74\xe4\x77\x90\x60\xa5\xbf\xc0\x45\x51\x1f\x1c\xf6\x3d\x36\xa7\xd1\xa8\x81\xf8\x0f\xda\x90\x44\x11\x38\x03\xd4\x4b\x0a\xc1\x3e\x4d\xda\x60\x78\x73\x0b\xca\xa4\xd1\x81\x3c\x92\x2f\xa8\x88\xed\x0f\x7c\x57\x70\x28\x76\x6f\x19\x87\x5e\x39\x32\x76\xae\x96\x82\x55\x73\x77\x77\xb0\x61\xe0\xd7\x51\x59\xaf\xdf\xda\xf5\x3a\x86\x53\xe4\x05\x88\x32\xcb\xa2\x6b\x48\x00\x7a\x12\xf1\xe8\x71\x1a\x5b\x3f\xa1\xb6\xf2\xfd\x93\xd2\xa1\x7f\x3d\x5e\x71\xcd\xb4\x28\xf0\x6b\x06\xbc\x27\xb1\x97\x2d\xfb\x67\x28\x45\xfa\x59\x72\x48\x45\xc2\xbf\x3d\x4a\x53\xd5\x73\xb5\x8c\x2b\x17\x8f\xd0\xca\xaf\x54\xbc\x43\x4d\x16\x11\x36\x6b\xc2\x68\x0d\x13\x0a\xc5\xab\x3f\x4c\xcc\x65\x60\xc9\x21\xff\x78\x3a\xbb\x2d\xfb\x03\xd8\xbf\x51\x62\xfc\x01\x00\x00")
+
+func migrations28_add_ingest_checkpointsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations28_add_ingest_checkpointsSql,
+		"migrations/28_add_ingest_checkpoints.sql",
+	)
+}
+
+func migrations28_add_ingest_checkpointsSql() (*asset, error) {
+	bytes, err := migrations28_add_ingest_checkpointsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/28_add_ingest_checkpoints.sql", size: 508, mode: os.FileMode(420), modTime: time.Unix(1792041236, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations29_add_transactions_inclusion_delaySql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x90\xc1\x4e\x84\x30\x10\x86\xef\x7d\x8a\xff\xe8\x46\x79\x02\x4e\xb8\x34\x4a\x82\xc5\xb0\x10\xf5\x44\x0a\xcc\x42\x13\x68\x0d\x9d\xcd\xba\x6f\x6f\x59\x13\x63\xd6\x8d\xf1\xd4\xe9\x3f\x33\xdf\x3f\x33\x51\x84\xdb\xd9\x0c\x8b\x66\x42\xfd\x2e\x44\x14\xa1\x1a\x09\x9e\x3a\x67\x7b\x8f\x96\xf8\x48\x64\xc1\x41\x9b\x4d\x78\xcd\x4c\x68\xdd\xc1\xf6\x70\x7b\x68\xf0\xa2\xad\xd7\x1d\x1b\x67\xa1\x83\xb8\xd6\x75\x93\xf3\x14\xd2\x2b\x6b\xfd\x4f\xd4\x0f\xb4\x84\x50\x33\x8c\xed\xa6\x43\x4f\x3d\x0c\xdf\xc1\xb3\x5b\x42\x78\x1c\x83\x41\x66\x07\xf2\x9c\xad\x69\x1f\x60\x29\x4d\xfa\x04\xe3\xc3\x20\x2c\x92\xbc\x92\x25\xaa\xe4\x3e\x97\x28\x54\xfe\x86\xd1\xac\xad\xa7\xe6\x87\xbb\x47\x92\xa6\xd8\x16\x79\xfd\xa4\xbe\x5c\x56\x4c\xd3\x9f\x39\xad\x19\x8c\xe5\x58\x88\x6d\x29\x93\x4a\x22\x53\xa9\x7c\xc5\xc8\x1f\x4d\x7b\x6a\x2e\x8b\x0b\x75\x9d\x5f\xef\x32\xf5\x80\x96\x17\x22\xdc\x5c\x34\x6d\xf0\xf2\x28\x4b\xf9\x4b\x47\xb6\x83\x2a\x2a\xa8\x3a\xcf\x37\xf1\xf9\xba\xdf\xd7\x4e\xdd\xd1\x8a\xb4\x2c\x9e\xff\x1c\x27\xfe\xe7\xf6\x67\xd0\xf5\xf5\x63\xf1\x09\xc0\xe9\x55\xcd\xe5\x01\x00\x00")
+
+func migrations29_add_transactions_inclusion_delaySqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations29_add_transactions_inclusion_delaySql,
+		"migrations/29_add_transactions_inclusion_delay.sql",
+	)
+}
+
+func migrations29_add_transactions_inclusion_delaySql() (*asset, error) {
+	bytes, err := migrations29_add_transactions_inclusion_delaySqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/29_add_transactions_inclusion_delay.sql", size: 485, mode: os.FileMode(420), modTime: time.Unix(1792041779, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _migrations2_index_participants_by_toidSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8f\xb1\xca\xc2\x50\x0c\x46\xf7\x3c\x45\xc6\xff\x47\xfa\x04\x9d\xc4\x16\xe9\xd2\x4a\xb5\xe0\x76\x49\xdb\x8b\xcd\xe0\xcd\x25\x37\x20\x7d\x7b\x41\x07\x5b\xbb\xb8\x86\x8f\x73\x72\xb2\x0c\x77\x77\xbe\x29\x99\xc7\x2e\x02\x1c\xda\x72\x7f\x29\xb1\xaa\x8b\xf2\x8a\x93\x44\xd7\xcf\x6e\x12\x1e\xb1\xa9\x71\xe2\x64\xa2\xb3\x93\xe8\x95\x8c\x25\xb8\x48\x6a\x3c\x70\xa4\x60\x09\xbb\x73\x55\x1f\xb1\x37\xf5\x1e\xff\xb6\x5b\x1e\xff\xf3\x2f\xbc\xbd\xf1\xb6\xc6\x9b\x52\x48\x34\xfc\x28\x58\xae\x5f\x0a\x58\x26\x15\xf2\x08\x00\x45\xdb\x9c\xb6\x49\xf9\xea\xfe\xf9\x25\x87\x67\x00\x00\x00\xff\xff\x33\xec\x54\x7a\x15\x01\x00\x00")
 
 func migrations2_index_participants_by_toidSqlBytes() ([]byte, error) {
@@ -159,6 +561,66 @@ func migrations2_index_participants_by_toidSql() (*asset, error) {
 	return a, nil
 }
 
+var _migrations30_add_asset_stats_checkpointsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x90\xc1\x6e\x83\x30\x10\x44\xef\xfe\x8a\x39\xb6\x6a\xf8\x82\x9c\x48\xc3\x0d\x25\x15\x25\x67\xe4\xc0\x12\x5b\x29\xb6\x65\x2f\x42\xc9\xd7\x77\x4d\x54\xce\xdd\xd3\x6a\x46\x33\x7a\xbb\x45\x81\x8f\xc9\xde\xa2\x66\xc2\x25\x28\x55\x14\x68\x0d\xc1\x0e\xf0\x23\x58\xb6\x1f\x9d\x18\x3a\x25\x62\x2c\xc6\x27\x42\x62\xcd\x09\x0b\x45\x42\xa4\xde\x4f\x61\x66\x1a\x70\x7d\x40\x3b\xcc\x6e\xb4\xce\x26\x43\x43\x2e\x6a\xfe\xec\x32\xc7\xbf\x73\x6e\x87\xe4\xa5\x56\x4b\xa5\xa4\xa5\x2a\xe6\xf0\xd6\x93\xb5\x79\xa2\x04\x3d\x32\x45\x58\x56\x9f\x4d\x55\xb6\x15\xda\xf2\x50\x57\x30\x36\xb1\x8f\x8f\x6e\xc5\xe9\x56\x90\xae\x37\xd4\xdf\x83\xb7\x4e\xa0\xde\x14\x64\x5e\xae\x5c\x70\xb5\x37\x91\x71\x3a\xb7\x38\x5d\xea\x7a\xb7\xba\x73\x18\xe4\xd6\xa1\x13\x04\xb6\x53\x46\x98\x02\x16\xcb\xc6\xcf\x2f\x05\x4f\xef\x68\x0b\xa9\xf7\xfd\xfa\x94\xed\x49\x47\xbf\x38\x75\x6c\xce\x5f\xff\x63\xda\xab\x5f\xdf\x57\x2b\xad\x62\x01\x00\x00")
+
+func migrations30_add_asset_stats_checkpointsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations30_add_asset_stats_checkpointsSql,
+		"migrations/30_add_asset_stats_checkpoints.sql",
+	)
+}
+
+func migrations30_add_asset_stats_checkpointsSql() (*asset, error) {
+	bytes, err := migrations30_add_asset_stats_checkpointsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/30_add_asset_stats_checkpoints.sql", size: 354, mode: os.FileMode(420), modTime: time.Unix(1792042578, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations31_add_ledger_statsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x91\x41\x6e\x83\x30\x10\x45\xf7\x3e\xc5\x2c\x53\x35\x9c\x20\xab\xb4\x41\x15\x12\x82\x36\x01\xa9\x3b\xcb\x98\x01\x8f\x14\x6c\x8a\x07\xd1\xdc\xbe\x86\x36\x55\xd4\x26\xf1\xc2\x8b\xa7\xaf\x3f\xa3\x37\x51\x04\x8f\x1d\xb5\x83\x62\x84\xb2\x17\x22\x8a\xa0\x30\x08\xda\x75\xbd\xf3\xc4\xe4\x2c\xb8\x06\x38\x20\x1e\x94\xf5\x4a\x2f\xc8\x23\xcf\x18\x95\x36\x70\xc4\xba\xc5\x61\x0d\x93\x41\x0b\x64\x5b\xf4\x8c\x35\x4c\xc4\x66\x2e\x4b\x16\x90\x2e\x99\x03\x2b\xf6\xe2\x79\x1f\x6f\x8b\x18\x8a\xed\x53\x1a\x83\x21\xcf\x6e\x38\xc9\xef\x12\xe9\xe7\x04\xac\x04\x84\x47\x35\x54\xd4\x92\x65\xc8\xf2\x02\xb2\x32\x4d\xd7\x0b\x3f\x47\xf1\x63\x44\xab\x31\x8c\x64\x0c\xe0\x4f\xca\xbb\x71\xd0\x28\x95\xd6\x6e\xb4\x2c\x97\xff\x46\xb4\x23\x2b\x1b\xfc\x2d\xfa\x81\x58\x93\xba\xc6\xd5\xe7\x7f\xe8\x7a\x0c\x02\x83\x98\xbb\x73\x1a\x45\x61\x77\x79\xe1\xf1\x46\x5c\x3c\x6c\xc4\x59\x53\x99\x25\x6f\x65\x0c\x49\xb6\x8b\xdf\xc1\x1c\xbd\xac\x4e\x32\x98\xc9\xb3\xeb\xea\xca\x43\x92\xbd\x40\xc5\x43\x58\x71\x45\xf5\x5c\x14\x5d\x5c\x78\xe7\x26\x2b\x76\xfb\xfc\xf5\x8e\xfe\x8d\xf8\x02\xa0\x69\xbc\xc7\x14\x02\x00\x00")
+
+func migrations31_add_ledger_statsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations31_add_ledger_statsSql,
+		"migrations/31_add_ledger_stats.sql",
+	)
+}
+
+func migrations31_add_ledger_statsSql() (*asset, error) {
+	bytes, err := migrations31_add_ledger_statsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/31_add_ledger_stats.sql", size: 532, mode: os.FileMode(420), modTime: time.Unix(1792042759, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations32_add_upgrade_proposalsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x51\xcb\x4e\x84\x30\x14\xdd\xf7\x2b\x4e\x66\xe3\x18\xe1\x0b\x66\x35\x0a\x31\x24\xa4\x8c\x23\x24\xee\x08\x8f\x2b\x6d\x82\x50\xdb\x22\xc3\xdf\xdb\x01\xf1\x95\x38\x76\xd7\x73\xcf\x3d\x8f\xd6\xf7\x71\xf3\x22\x1b\x5d\x58\x42\xa6\x18\xf3\x7d\xa4\x82\x30\x28\x07\xd5\x64\x50\x15\x5a\x4b\xaa\x51\x4e\xb0\x0e\x37\x95\xc2\x5b\xd1\x0e\x84\xfe\x19\x54\x54\x02\x2d\xd5\x0d\xe9\x2b\x03\x41\x6e\x41\x7b\x18\x05\x75\x67\x19\xd9\x35\x64\xac\x5b\x1d\xa5\x15\x88\xe6\x5b\xb6\xc8\x1e\x74\xaf\x7a\x53\xb4\x86\xdd\x1d\xc3\x7d\x1a\x22\xdd\xdf\xc6\x21\x84\x34\xb6\xd7\x53\xfe\x61\x9e\xab\x95\x86\x2d\x83\x3b\xd2\xc5\x90\x8d\xec\x2c\x78\x92\x82\x67\x71\xec\xcd\xf8\x12\x21\x37\xf4\x3a\x50\x57\x91\x73\xb6\xe4\x80\x5f\xac\x4d\xaf\x5d\xbe\xcd\x1f\xd3\xd5\xd3\x4e\xea\x53\x60\x99\x2c\x75\x17\xe3\x9f\xdc\x53\xad\x61\xe9\xf4\x15\x87\x5d\xef\xd8\x5a\x29\xe3\xd1\x43\x16\x22\xe2\x41\xf8\x04\x31\xa8\xbc\x9c\xf2\x39\x01\x12\x7e\xa1\x69\xf6\x18\xf1\x7b\x94\x56\x13\x61\x2b\x6b\x6f\x8d\x7d\x56\xf6\xbf\xfd\x55\xd0\x8f\x1d\x0b\x8e\xc9\xe1\xbf\xb7\xdb\xb1\x77\x09\xe9\x66\x36\xe3\x01\x00\x00")
+
+func migrations32_add_upgrade_proposalsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations32_add_upgrade_proposalsSql,
+		"migrations/32_add_upgrade_proposals.sql",
+	)
+}
+
+func migrations32_add_upgrade_proposalsSql() (*asset, error) {
+	bytes, err := migrations32_add_upgrade_proposalsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/32_add_upgrade_proposals.sql", size: 483, mode: os.FileMode(420), modTime: time.Unix(1792042818, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _migrations3_use_sequence_in_history_accountsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x91\x4d\x6b\xb3\x40\x14\x85\xf7\xf3\x2b\xce\x2e\xca\xfb\x66\x91\x6d\x5c\x4d\xc6\x1b\x22\x8c\x63\x3b\x5e\xdb\x64\x25\xa2\x43\x3a\x90\x6a\xeb\xd8\xaf\x7f\x5f\x48\xd3\x0f\x08\x6d\xa1\xcb\x73\x78\xe0\x39\xdc\x3b\x9f\xe3\xdf\xad\xdf\x8f\xcd\xe4\x50\xdd\x09\x65\x49\x32\xa1\xa4\xcb\x8a\x8c\x22\xdc\xf8\x30\x0d\xe3\x4b\xdd\xb4\xed\xf0\xd0\x4f\xa1\xf6\x5d\x1d\xdc\xbd\x00\x80\x92\xa5\x65\x5c\x67\xbc\xc1\xe2\x58\x64\x46\x59\xca\xc9\x30\x56\xbb\x53\x65\x0a\xe4\x99\xb9\x92\xba\xa2\x8f\x2c\xb7\x9f\x59\x49\xb5\x21\x2c\x12\x51\x92\x26\xc5\x08\x6e\x7a\x6c\x0e\xd1\xec\x1b\xef\xec\x3f\xa2\x13\x99\xcb\x6d\xe4\xbb\x18\x6b\x5b\xe4\x67\x33\xe3\x38\x11\x52\x33\x59\xb0\x5c\x69\x42\x61\xf4\xee\x0c\xc2\x1b\xa1\x0a\x5d\xe5\x06\xbe\x43\x49\x8c\x94\xd6\xb2\xd2\x8c\xde\x3d\xff\xbc\x64\xb9\x1c\xdd\xbe\x3d\x34\x21\xc4\x89\x10\x5f\xcf\x98\x0e\x4f\xfd\x1f\xec\xa9\x2d\x2e\xde\xf5\x89\x38\xa6\xdf\xde\x90\x88\xd7\x00\x00\x00\xff\xff\x55\xe2\xdd\x2c\xbf\x01\x00\x00")
 
 func migrations3_use_sequence_in_history_accountsSqlBytes() ([]byte, error) {
@@ -373,8 +835,30 @@ func AssetNames() []string {
 var _bindata = map[string]func() (*asset, error){
 	"latest.sql": latestSql,
 	"migrations/10_add_trades_price.sql": migrations10_add_trades_priceSql,
+	"migrations/11_add_close_time_version.sql": migrations11_add_close_time_versionSql,
+	"migrations/12_add_operation_successful.sql": migrations12_add_operation_successfulSql,
+	"migrations/13_create_ledger_changes_table.sql": migrations13_create_ledger_changes_tableSql,
+	"migrations/14_add_asset_stats_toml_content.sql": migrations14_add_asset_stats_toml_contentSql,
+	"migrations/15_create_ingestion_outbox_table.sql": migrations15_create_ingestion_outbox_tableSql,
+	"migrations/16_create_transaction_xdr_table.sql": migrations16_create_transaction_xdr_tableSql,
+	"migrations/17_create_account_flags_table.sql": migrations17_create_account_flags_tableSql,
+	"migrations/18_add_trades_offer_remaining.sql": migrations18_add_trades_offer_remainingSql,
+	"migrations/19_add_transactions_signature_count.sql": migrations19_add_transactions_signature_countSql,
 	"migrations/1_initial_schema.sql": migrations1_initial_schemaSql,
+	"migrations/20_add_ledgers_partial.sql": migrations20_add_ledgers_partialSql,
+	"migrations/21_add_ledgers_ingested_by.sql": migrations21_add_ledgers_ingested_bySql,
+	"migrations/22_add_ledgers_header_fields.sql": migrations22_add_ledgers_header_fieldsSql,
+	"migrations/23_add_ledgers_trustlines_changed.sql": migrations23_add_ledgers_trustlines_changedSql,
+	"migrations/24_add_trade_pair_stats.sql": migrations24_add_trade_pair_statsSql,
+	"migrations/25_add_transaction_memos.sql": migrations25_add_transaction_memosSql,
+	"migrations/26_add_operation_result_code.sql": migrations26_add_operation_result_codeSql,
+	"migrations/27_add_operation_participant_roles.sql": migrations27_add_operation_participant_rolesSql,
+	"migrations/28_add_ingest_checkpoints.sql": migrations28_add_ingest_checkpointsSql,
+	"migrations/29_add_transactions_inclusion_delay.sql": migrations29_add_transactions_inclusion_delaySql,
 	"migrations/2_index_participants_by_toid.sql": migrations2_index_participants_by_toidSql,
+	"migrations/30_add_asset_stats_checkpoints.sql": migrations30_add_asset_stats_checkpointsSql,
+	"migrations/31_add_ledger_stats.sql": migrations31_add_ledger_statsSql,
+	"migrations/32_add_upgrade_proposals.sql": migrations32_add_upgrade_proposalsSql,
 	"migrations/3_use_sequence_in_history_accounts.sql": migrations3_use_sequence_in_history_accountsSql,
 	"migrations/4_add_protocol_version.sql": migrations4_add_protocol_versionSql,
 	"migrations/5_create_trades_table.sql": migrations5_create_trades_tableSql,
@@ -428,8 +912,30 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"latest.sql": &bintree{latestSql, map[string]*bintree{}},
 	"migrations": &bintree{nil, map[string]*bintree{
 		"10_add_trades_price.sql": &bintree{migrations10_add_trades_priceSql, map[string]*bintree{}},
+		"11_add_close_time_version.sql": &bintree{migrations11_add_close_time_versionSql, map[string]*bintree{}},
+		"12_add_operation_successful.sql": &bintree{migrations12_add_operation_successfulSql, map[string]*bintree{}},
+		"13_create_ledger_changes_table.sql": &bintree{migrations13_create_ledger_changes_tableSql, map[string]*bintree{}},
+		"14_add_asset_stats_toml_content.sql": &bintree{migrations14_add_asset_stats_toml_contentSql, map[string]*bintree{}},
+		"15_create_ingestion_outbox_table.sql": &bintree{migrations15_create_ingestion_outbox_tableSql, map[string]*bintree{}},
+		"16_create_transaction_xdr_table.sql": &bintree{migrations16_create_transaction_xdr_tableSql, map[string]*bintree{}},
+		"17_create_account_flags_table.sql": &bintree{migrations17_create_account_flags_tableSql, map[string]*bintree{}},
+		"18_add_trades_offer_remaining.sql": &bintree{migrations18_add_trades_offer_remainingSql, map[string]*bintree{}},
+		"19_add_transactions_signature_count.sql": &bintree{migrations19_add_transactions_signature_countSql, map[string]*bintree{}},
 		"1_initial_schema.sql": &bintree{migrations1_initial_schemaSql, map[string]*bintree{}},
+		"20_add_ledgers_partial.sql": &bintree{migrations20_add_ledgers_partialSql, map[string]*bintree{}},
+		"21_add_ledgers_ingested_by.sql": &bintree{migrations21_add_ledgers_ingested_bySql, map[string]*bintree{}},
+		"22_add_ledgers_header_fields.sql": &bintree{migrations22_add_ledgers_header_fieldsSql, map[string]*bintree{}},
+		"23_add_ledgers_trustlines_changed.sql": &bintree{migrations23_add_ledgers_trustlines_changedSql, map[string]*bintree{}},
+		"24_add_trade_pair_stats.sql": &bintree{migrations24_add_trade_pair_statsSql, map[string]*bintree{}},
+		"25_add_transaction_memos.sql": &bintree{migrations25_add_transaction_memosSql, map[string]*bintree{}},
+		"26_add_operation_result_code.sql": &bintree{migrations26_add_operation_result_codeSql, map[string]*bintree{}},
+		"27_add_operation_participant_roles.sql": &bintree{migrations27_add_operation_participant_rolesSql, map[string]*bintree{}},
+		"28_add_ingest_checkpoints.sql": &bintree{migrations28_add_ingest_checkpointsSql, map[string]*bintree{}},
+		"29_add_transactions_inclusion_delay.sql": &bintree{migrations29_add_transactions_inclusion_delaySql, map[string]*bintree{}},
 		"2_index_participants_by_toid.sql": &bintree{migrations2_index_participants_by_toidSql, map[string]*bintree{}},
+		"30_add_asset_stats_checkpoints.sql": &bintree{migrations30_add_asset_stats_checkpointsSql, map[string]*bintree{}},
+		"31_add_ledger_stats.sql": &bintree{migrations31_add_ledger_statsSql, map[string]*bintree{}},
+		"32_add_upgrade_proposals.sql": &bintree{migrations32_add_upgrade_proposalsSql, map[string]*bintree{}},
 		"3_use_sequence_in_history_accounts.sql": &bintree{migrations3_use_sequence_in_history_accountsSql, map[string]*bintree{}},
 		"4_add_protocol_version.sql": &bintree{migrations4_add_protocol_versionSql, map[string]*bintree{}},
 		"5_create_trades_table.sql": &bintree{migrations5_create_trades_tableSql, map[string]*bintree{}},
@@ -105,7 +105,9 @@ CREATE TABLE asset_stats (
     amount bigint NOT NULL,
     num_accounts integer NOT NULL,
     flags smallint NOT NULL,
-    toml character varying(64) NOT NULL
+    toml character varying(64) NOT NULL,
+    toml_content text,
+    toml_error boolean DEFAULT false NOT NULL
 );
 
 
@@ -131,6 +133,19 @@ CREATE SEQUENCE history_accounts_id_seq
     CACHE 1;
 
 
+--
+-- Name: history_account_flags; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_account_flags (
+    history_operation_id bigint NOT NULL,
+    ledger_sequence integer NOT NULL,
+    account character varying(64) NOT NULL,
+    flags_before integer NOT NULL,
+    flags_after integer NOT NULL
+);
+
+
 --
 -- Name: history_accounts; Type: TABLE; Schema: public; Owner: -
 --
@@ -141,6 +156,16 @@ CREATE TABLE history_accounts (
 );
 
 
+--
+-- Name: history_asset_stats_checkpoints; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_asset_stats_checkpoints (
+    asset_id bigint NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: history_assets; Type: TABLE; Schema: public; Owner: -
 --
@@ -185,6 +210,52 @@ CREATE TABLE history_effects (
 );
 
 
+--
+-- Name: history_ledger_changes; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_ledger_changes (
+    history_operation_id bigint NOT NULL,
+    "order" integer NOT NULL,
+    change_type integer NOT NULL,
+    entry_type integer NOT NULL,
+    account character varying(64) NOT NULL,
+    asset character varying(128),
+    balance_before bigint,
+    balance_after bigint,
+    entry_before text,
+    entry_after text
+);
+
+
+--
+-- Name: history_ingest_checkpoints; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_ingest_checkpoints (
+    first_ledger integer NOT NULL,
+    last_ledger integer NOT NULL,
+    ledger integer NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: history_ledger_stats; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_ledger_stats (
+    id bigint NOT NULL,
+    ledger_sequence integer NOT NULL,
+    source_account_count integer NOT NULL,
+    min_fee integer,
+    median_fee integer,
+    max_fee integer,
+    operation_count integer NOT NULL,
+    failed_transaction_count integer NOT NULL
+);
+
+
 --
 -- Name: history_ledgers; Type: TABLE; Schema: public; Owner: -
 --
@@ -206,7 +277,15 @@ CREATE TABLE history_ledgers (
     base_reserve integer NOT NULL,
     max_tx_set_size integer NOT NULL,
     protocol_version integer DEFAULT 0 NOT NULL,
-    ledger_header text
+    ledger_header text,
+    close_time_version integer,
+    partial boolean DEFAULT false NOT NULL,
+    ingested_by character varying,
+    bucket_list_hash character varying(64),
+    tx_set_hash character varying(64),
+    tx_set_result_hash character varying(64),
+    scp_value text,
+    trustlines_changed integer
 );
 
 
@@ -217,7 +296,8 @@ CREATE TABLE history_ledgers (
 CREATE TABLE history_operation_participants (
     id integer NOT NULL,
     history_operation_id bigint NOT NULL,
-    history_account_id bigint NOT NULL
+    history_account_id bigint NOT NULL,
+    role character varying(32)
 );
 
 
@@ -250,7 +330,22 @@ CREATE TABLE history_operations (
     application_order integer NOT NULL,
     type integer NOT NULL,
     details jsonb,
-    source_account character varying(64) DEFAULT ''::character varying NOT NULL
+    source_account character varying(64) DEFAULT ''::character varying NOT NULL,
+    successful boolean,
+    operation_result_code integer
+);
+
+
+--
+-- Name: history_trade_pair_stats; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_trade_pair_stats (
+    base_asset_id bigint NOT NULL,
+    counter_asset_id bigint NOT NULL,
+    trade_count bigint DEFAULT 0 NOT NULL,
+    base_volume numeric DEFAULT 0 NOT NULL,
+    counter_volume numeric DEFAULT 0 NOT NULL
 );
 
 
@@ -272,12 +367,24 @@ CREATE TABLE history_trades (
     base_is_seller boolean,
     price_n bigint,
     price_d bigint,
+    offer_remaining_amount bigint,
     CONSTRAINT history_trades_base_amount_check CHECK ((base_amount > 0)),
     CONSTRAINT history_trades_check CHECK ((base_asset_id < counter_asset_id)),
     CONSTRAINT history_trades_counter_amount_check CHECK ((counter_amount > 0))
 );
 
 
+--
+-- Name: history_transaction_memos; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_transaction_memos (
+    history_transaction_id bigint NOT NULL,
+    memo_type character varying NOT NULL,
+    memo character varying NOT NULL
+);
+
+
 --
 -- Name: history_transaction_participants; Type: TABLE; Schema: public; Owner: -
 --
@@ -308,6 +415,18 @@ CREATE SEQUENCE history_transaction_participants_id_seq
 ALTER SEQUENCE history_transaction_participants_id_seq OWNED BY history_transaction_participants.id;
 
 
+--
+-- Name: history_transaction_xdr; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_transaction_xdr (
+    history_transaction_id bigint NOT NULL,
+    tx_result text NOT NULL,
+    tx_meta text NOT NULL,
+    tx_fee_meta text NOT NULL
+);
+
+
 --
 -- Name: history_transactions; Type: TABLE; Schema: public; Owner: -
 --
@@ -330,10 +449,57 @@ CREATE TABLE history_transactions (
     signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
     memo_type character varying DEFAULT 'none'::character varying NOT NULL,
     memo character varying,
-    time_bounds int8range
+    time_bounds int8range,
+    signature_count integer,
+    inclusion_delay bigint
+);
+
+
+--
+-- Name: history_upgrade_proposals; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_upgrade_proposals (
+    id bigint NOT NULL,
+    ledger_sequence integer NOT NULL,
+    "order" integer NOT NULL,
+    upgrade_type integer,
+    value bigint,
+    upgrade_xdr text NOT NULL
+);
+
+
+--
+-- Name: ingestion_outbox; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE ingestion_outbox (
+    id bigint NOT NULL,
+    ledger_id bigint NOT NULL,
+    payload bytea NOT NULL,
+    created_at timestamp without time zone NOT NULL
 );
 
 
+--
+-- Name: ingestion_outbox_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE ingestion_outbox_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: ingestion_outbox_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE ingestion_outbox_id_seq OWNED BY ingestion_outbox.id;
+
+
 --
 -- Name: history_assets id; Type: DEFAULT; Schema: public; Owner: -
 --
@@ -355,6 +521,13 @@ ALTER TABLE ONLY history_operation_participants ALTER COLUMN id SET DEFAULT next
 ALTER TABLE ONLY history_transaction_participants ALTER COLUMN id SET DEFAULT nextval('history_transaction_participants_id_seq'::regclass);
 
 
+--
+-- Name: ingestion_outbox id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY ingestion_outbox ALTER COLUMN id SET DEFAULT nextval('ingestion_outbox_id_seq'::regclass);
+
+
 --
 -- Data for Name: asset_stats; Type: TABLE DATA; Schema: public; Owner: -
 --
@@ -376,6 +549,28 @@ INSERT INTO gorp_migrations VALUES ('8_add_aggregators.sql', '2018-02-13 15:41:2
 INSERT INTO gorp_migrations VALUES ('8_create_asset_stats_table.sql', '2018-02-13 15:41:22.468047-08');
 INSERT INTO gorp_migrations VALUES ('9_add_header_xdr.sql', '2018-02-13 15:41:22.476629-08');
 INSERT INTO gorp_migrations VALUES ('10_add_trades_price.sql', '2018-02-13 15:41:22.482553-08');
+INSERT INTO gorp_migrations VALUES ('11_add_close_time_version.sql', '2018-03-01 10:11:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('12_add_operation_successful.sql', '2018-03-01 10:12:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('13_create_ledger_changes_table.sql', '2018-03-01 10:13:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('14_add_asset_stats_toml_content.sql', '2018-03-01 10:14:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('15_create_ingestion_outbox_table.sql', '2018-03-01 10:15:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('16_create_transaction_xdr_table.sql', '2018-03-01 10:16:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('17_create_account_flags_table.sql', '2018-03-01 10:17:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('18_add_trades_offer_remaining.sql', '2018-03-01 10:18:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('19_add_transactions_signature_count.sql', '2018-03-01 10:19:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('20_add_ledgers_partial.sql', '2018-03-01 10:20:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('21_add_ledgers_ingested_by.sql', '2018-03-01 10:21:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('22_add_ledgers_header_fields.sql', '2018-03-01 10:22:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('23_add_ledgers_trustlines_changed.sql', '2018-03-01 10:23:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('24_add_trade_pair_stats.sql', '2018-03-01 10:24:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('25_add_transaction_memos.sql', '2018-03-01 10:25:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('26_add_operation_result_code.sql', '2018-03-01 10:26:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('27_add_operation_participant_roles.sql', '2018-03-01 10:27:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('28_add_ingest_checkpoints.sql', '2018-03-01 10:28:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('29_add_transactions_inclusion_delay.sql', '2018-03-01 10:29:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('30_add_asset_stats_checkpoints.sql', '2018-03-01 10:30:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('31_add_ledger_stats.sql', '2018-03-01 10:31:00.000000-08');
+INSERT INTO gorp_migrations VALUES ('32_add_upgrade_proposals.sql', '2018-03-01 10:32:00.000000-08');
 
 
 --
@@ -460,6 +655,19 @@ SELECT pg_catalog.setval('history_transaction_participants_id_seq', 1, false);
 
 
 
+--
+-- Data for Name: ingestion_outbox; Type: TABLE DATA; Schema: public; Owner: -
+--
+
+
+
+--
+-- Name: ingestion_outbox_id_seq; Type: SEQUENCE SET; Schema: public; Owner: -
+--
+
+SELECT pg_catalog.setval('ingestion_outbox_id_seq', 1, false);
+
+
 --
 -- Name: asset_stats asset_stats_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -508,6 +716,22 @@ ALTER TABLE ONLY history_transaction_participants
     ADD CONSTRAINT history_transaction_participants_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: history_transaction_xdr history_transaction_xdr_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY history_transaction_xdr
+    ADD CONSTRAINT history_transaction_xdr_pkey PRIMARY KEY (history_transaction_id);
+
+
+--
+-- Name: ingestion_outbox ingestion_outbox_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY ingestion_outbox
+    ADD CONSTRAINT ingestion_outbox_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: asset_by_code; Type: INDEX; Schema: public; Owner: -
 --
@@ -543,6 +767,27 @@ CREATE INDEX by_hash ON history_transactions USING btree (transaction_hash);
 CREATE INDEX by_ledger ON history_transactions USING btree (ledger_sequence, application_order);
 
 
+--
+-- Name: hic_by_range; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hic_by_range ON history_ingest_checkpoints USING btree (first_ledger, last_ledger);
+
+
+--
+-- Name: hist_af_by_account; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX hist_af_by_account ON history_account_flags USING btree (account, history_operation_id);
+
+
+--
+-- Name: hist_af_by_op; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hist_af_by_op ON history_account_flags USING btree (history_operation_id);
+
+
 --
 -- Name: hist_e_by_order; Type: INDEX; Schema: public; Owner: -
 --
@@ -557,6 +802,34 @@ CREATE UNIQUE INDEX hist_e_by_order ON history_effects USING btree (history_oper
 CREATE UNIQUE INDEX hist_e_id ON history_effects USING btree (history_account_id, history_operation_id, "order");
 
 
+--
+-- Name: hist_lc_by_account; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX hist_lc_by_account ON history_ledger_changes USING btree (account, history_operation_id);
+
+
+--
+-- Name: hist_lc_by_order; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hist_lc_by_order ON history_ledger_changes USING btree (history_operation_id, "order");
+
+
+--
+-- Name: hls_by_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hls_by_id ON history_ledger_stats USING btree (id);
+
+
+--
+-- Name: htps_by_pair; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX htps_by_pair ON history_trade_pair_stats USING btree (base_asset_id, counter_asset_id);
+
+
 --
 -- Name: hist_op_p_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -592,6 +865,20 @@ CREATE UNIQUE INDEX hs_ledger_by_id ON history_ledgers USING btree (id);
 CREATE UNIQUE INDEX hs_transaction_by_id ON history_transactions USING btree (id);
 
 
+--
+-- Name: htm_by_htid; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX htm_by_htid ON history_transaction_memos USING btree (history_transaction_id);
+
+
+--
+-- Name: htm_by_memo; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX htm_by_memo ON history_transaction_memos USING btree (memo_type, memo);
+
+
 --
 -- Name: htp_by_htid; Type: INDEX; Schema: public; Owner: -
 --
@@ -634,6 +921,20 @@ CREATE UNIQUE INDEX htrd_pid ON history_trades USING btree (history_operation_id
 CREATE INDEX htrd_time_lookup ON history_trades USING btree (ledger_closed_at);
 
 
+--
+-- Name: htx_by_inclusion_delay; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX htx_by_inclusion_delay ON history_transactions USING btree (inclusion_delay) WHERE (inclusion_delay IS NOT NULL);
+
+
+--
+-- Name: hup_by_order; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX hup_by_order ON history_upgrade_proposals USING btree (id, "order");
+
+
 --
 -- Name: index_history_accounts_on_address; Type: INDEX; Schema: public; Owner: -
 --
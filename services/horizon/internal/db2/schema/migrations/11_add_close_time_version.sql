@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE ONLY history_ledgers ADD COLUMN close_time_version integer;
+
+-- +migrate Down
+ALTER TABLE ONLY history_ledgers DROP COLUMN close_time_version;
@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE ONLY history_operations ADD COLUMN successful boolean;
+
+-- +migrate Down
+ALTER TABLE ONLY history_operations DROP COLUMN successful;
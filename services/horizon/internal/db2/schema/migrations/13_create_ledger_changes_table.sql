@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE TABLE history_ledger_changes (
+    history_operation_id bigint NOT NULL,
+    "order" integer NOT NULL,
+    change_type integer NOT NULL,
+    entry_type integer NOT NULL,
+    account character varying(64) NOT NULL,
+    asset character varying(128),
+    balance_before bigint,
+    balance_after bigint,
+    entry_before text,
+    entry_after text
+);
+
+CREATE UNIQUE INDEX hist_lc_by_order ON history_ledger_changes USING btree (history_operation_id, "order");
+
+CREATE INDEX hist_lc_by_account ON history_ledger_changes USING btree (account, history_operation_id);
+
+-- +migrate Down
+DROP TABLE history_ledger_changes cascade;
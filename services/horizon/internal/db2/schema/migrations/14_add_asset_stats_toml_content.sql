@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE ONLY asset_stats ADD COLUMN toml_content text;
+ALTER TABLE ONLY asset_stats ADD COLUMN toml_error boolean DEFAULT false NOT NULL;
+
+-- +migrate Down
+ALTER TABLE ONLY asset_stats DROP COLUMN toml_error;
+ALTER TABLE ONLY asset_stats DROP COLUMN toml_content;
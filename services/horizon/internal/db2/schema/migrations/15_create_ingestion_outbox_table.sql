@@ -0,0 +1,24 @@
+-- +migrate Up
+CREATE TABLE ingestion_outbox (
+    id bigint NOT NULL,
+    ledger_id bigint NOT NULL,
+    payload bytea NOT NULL,
+    created_at timestamp without time zone NOT NULL
+);
+
+CREATE SEQUENCE ingestion_outbox_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE ingestion_outbox_id_seq OWNED BY ingestion_outbox.id;
+
+ALTER TABLE ONLY ingestion_outbox ALTER COLUMN id SET DEFAULT nextval('ingestion_outbox_id_seq'::regclass);
+
+ALTER TABLE ONLY ingestion_outbox
+    ADD CONSTRAINT ingestion_outbox_pkey PRIMARY KEY (id);
+
+-- +migrate Down
+DROP TABLE ingestion_outbox cascade;
@@ -0,0 +1,13 @@
+-- +migrate Up
+CREATE TABLE history_transaction_xdr (
+    history_transaction_id bigint NOT NULL,
+    tx_result text NOT NULL,
+    tx_meta text NOT NULL,
+    tx_fee_meta text NOT NULL
+);
+
+ALTER TABLE ONLY history_transaction_xdr
+    ADD CONSTRAINT history_transaction_xdr_pkey PRIMARY KEY (history_transaction_id);
+
+-- +migrate Down
+DROP TABLE history_transaction_xdr cascade;
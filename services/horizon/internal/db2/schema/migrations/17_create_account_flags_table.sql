@@ -0,0 +1,15 @@
+-- +migrate Up
+CREATE TABLE history_account_flags (
+    history_operation_id bigint NOT NULL,
+    ledger_sequence integer NOT NULL,
+    account character varying(64) NOT NULL,
+    flags_before integer NOT NULL,
+    flags_after integer NOT NULL
+);
+
+CREATE UNIQUE INDEX hist_af_by_op ON history_account_flags USING btree (history_operation_id);
+
+CREATE INDEX hist_af_by_account ON history_account_flags USING btree (account, history_operation_id);
+
+-- +migrate Down
+DROP TABLE history_account_flags cascade;
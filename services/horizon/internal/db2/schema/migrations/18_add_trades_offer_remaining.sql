@@ -0,0 +1,7 @@
+-- +migrate Up
+
+-- Amount of the crossed offer remaining after the trade, null when unknown
+ALTER TABLE history_trades ADD offer_remaining_amount BIGINT;
+
+-- +migrate Down
+ALTER TABLE history_trades DROP offer_remaining_amount;
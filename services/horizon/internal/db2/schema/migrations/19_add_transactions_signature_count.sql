@@ -0,0 +1,7 @@
+-- +migrate Up
+
+ALTER TABLE history_transactions ADD signature_count integer;
+UPDATE history_transactions SET signature_count = COALESCE(array_length(signatures, 1), 0);
+
+-- +migrate Down
+ALTER TABLE history_transactions DROP signature_count;
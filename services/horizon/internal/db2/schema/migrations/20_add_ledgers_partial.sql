@@ -0,0 +1,8 @@
+-- +migrate Up
+
+-- Ledgers of which only some transactions have been ingested, see
+-- Session.IngestPartialLedger
+ALTER TABLE history_ledgers ADD partial boolean DEFAULT false NOT NULL;
+
+-- +migrate Down
+ALTER TABLE history_ledgers DROP partial;
@@ -0,0 +1,7 @@
+-- +migrate Up
+
+-- Identifier of the ingestion instance that wrote the ledger, if configured
+ALTER TABLE history_ledgers ADD ingested_by character varying;
+
+-- +migrate Down
+ALTER TABLE history_ledgers DROP ingested_by;
@@ -0,0 +1,15 @@
+-- +migrate Up
+
+-- Header fields stored when ingesting with StoreFullHeaderFields: the hashes
+-- of the ledger's bucket list, transaction set and transaction set results,
+-- and the base64 xdr of the ledger's scp value
+ALTER TABLE history_ledgers ADD bucket_list_hash character varying(64);
+ALTER TABLE history_ledgers ADD tx_set_hash character varying(64);
+ALTER TABLE history_ledgers ADD tx_set_result_hash character varying(64);
+ALTER TABLE history_ledgers ADD scp_value text;
+
+-- +migrate Down
+ALTER TABLE history_ledgers DROP bucket_list_hash;
+ALTER TABLE history_ledgers DROP tx_set_hash;
+ALTER TABLE history_ledgers DROP tx_set_result_hash;
+ALTER TABLE history_ledgers DROP scp_value;
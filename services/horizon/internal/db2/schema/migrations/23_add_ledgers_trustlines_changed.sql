@@ -0,0 +1,8 @@
+-- +migrate Up
+
+-- Number of trustline entries created, updated or removed by the ledger's
+-- successful transactions, when ingesting with CountTrustlineChanges
+ALTER TABLE history_ledgers ADD trustlines_changed integer;
+
+-- +migrate Down
+ALTER TABLE history_ledgers DROP trustlines_changed;
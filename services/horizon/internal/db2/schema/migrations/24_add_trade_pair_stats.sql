@@ -0,0 +1,16 @@
+-- +migrate Up
+
+-- Cumulative trade count and volume, in stroops, of every asset pair, when
+-- ingesting with TrackTradePairStats
+CREATE TABLE history_trade_pair_stats (
+    base_asset_id bigint NOT NULL,
+    counter_asset_id bigint NOT NULL,
+    trade_count bigint DEFAULT 0 NOT NULL,
+    base_volume numeric DEFAULT 0 NOT NULL,
+    counter_volume numeric DEFAULT 0 NOT NULL
+);
+
+CREATE UNIQUE INDEX htps_by_pair ON history_trade_pair_stats USING btree (base_asset_id, counter_asset_id);
+
+-- +migrate Down
+DROP TABLE history_trade_pair_stats;
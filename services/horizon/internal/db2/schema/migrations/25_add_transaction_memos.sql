@@ -0,0 +1,15 @@
+-- +migrate Up
+
+-- The memos of transactions, when ingesting with IndexMemos, for exact match
+-- lookups without indexing history_transactions
+CREATE TABLE history_transaction_memos (
+    history_transaction_id bigint NOT NULL,
+    memo_type character varying NOT NULL,
+    memo character varying NOT NULL
+);
+
+CREATE INDEX htm_by_memo ON history_transaction_memos USING btree (memo_type, memo);
+CREATE UNIQUE INDEX htm_by_htid ON history_transaction_memos USING btree (history_transaction_id);
+
+-- +migrate Down
+DROP TABLE history_transaction_memos;
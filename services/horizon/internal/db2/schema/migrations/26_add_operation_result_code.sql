@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE ONLY history_operations ADD COLUMN operation_result_code integer;
+
+-- +migrate Down
+ALTER TABLE ONLY history_operations DROP COLUMN operation_result_code;
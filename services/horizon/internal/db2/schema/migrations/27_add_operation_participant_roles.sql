@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE ONLY history_operation_participants ADD COLUMN role character varying(32);
+
+-- +migrate Down
+ALTER TABLE ONLY history_operation_participants DROP COLUMN role;
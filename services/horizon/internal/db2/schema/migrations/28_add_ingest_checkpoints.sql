@@ -0,0 +1,15 @@
+-- +migrate Up
+
+-- The last ledger committed by sessions reingesting a range with
+-- CheckpointEvery set, so that a restarted session resumes after it
+CREATE TABLE history_ingest_checkpoints (
+    first_ledger integer NOT NULL,
+    last_ledger integer NOT NULL,
+    ledger integer NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+CREATE UNIQUE INDEX hic_by_range ON history_ingest_checkpoints USING btree (first_ledger, last_ledger);
+
+-- +migrate Down
+DROP TABLE history_ingest_checkpoints;
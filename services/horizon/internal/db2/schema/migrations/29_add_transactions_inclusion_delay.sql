@@ -0,0 +1,11 @@
+-- +migrate Up
+
+-- The seconds between the min time bound of a transaction and the close of
+-- the ledger that included it, stored when IngestInclusionDelay is set
+ALTER TABLE ONLY history_transactions ADD COLUMN inclusion_delay bigint;
+
+CREATE INDEX htx_by_inclusion_delay ON history_transactions USING btree (inclusion_delay) WHERE (inclusion_delay IS NOT NULL);
+
+-- +migrate Down
+DROP INDEX htx_by_inclusion_delay;
+ALTER TABLE ONLY history_transactions DROP COLUMN inclusion_delay;
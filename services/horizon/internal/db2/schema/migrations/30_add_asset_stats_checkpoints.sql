@@ -0,0 +1,11 @@
+-- +migrate Up
+
+-- The id of the last asset whose stats were recomputed by an unfinished
+-- RecomputeAssetStats, so that a restarted recompute resumes after it
+CREATE TABLE history_asset_stats_checkpoints (
+    asset_id bigint NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE history_asset_stats_checkpoints;
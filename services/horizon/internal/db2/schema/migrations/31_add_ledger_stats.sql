@@ -0,0 +1,19 @@
+-- +migrate Up
+
+-- The composition of the transaction set of each ledger, when ingested with
+-- IngestLedgerStats
+CREATE TABLE history_ledger_stats (
+    id bigint NOT NULL,
+    ledger_sequence integer NOT NULL,
+    source_account_count integer NOT NULL,
+    min_fee integer,
+    median_fee integer,
+    max_fee integer,
+    operation_count integer NOT NULL,
+    failed_transaction_count integer NOT NULL
+);
+
+CREATE UNIQUE INDEX hls_by_id ON history_ledger_stats USING btree (id);
+
+-- +migrate Down
+DROP TABLE history_ledger_stats;
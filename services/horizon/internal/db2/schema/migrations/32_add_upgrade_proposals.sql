@@ -0,0 +1,17 @@
+-- +migrate Up
+
+-- The upgrades carried by the scp value of each ledger's header, when
+-- ingested with IngestUpgradeProposals
+CREATE TABLE history_upgrade_proposals (
+    id bigint NOT NULL,
+    ledger_sequence integer NOT NULL,
+    "order" integer NOT NULL,
+    upgrade_type integer,
+    value bigint,
+    upgrade_xdr text NOT NULL
+);
+
+CREATE UNIQUE INDEX hup_by_order ON history_upgrade_proposals USING btree (id, "order");
+
+-- +migrate Down
+DROP TABLE history_upgrade_proposals;
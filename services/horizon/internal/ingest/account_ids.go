@@ -0,0 +1,75 @@
+package ingest
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"strconv"
+
+	"github.com/lib/pq"
+	"github.com/stellar/go/support/errors"
+)
+
+// maxAccountIDAttempts is the number of ids tried for a new account before
+// giving up, when every id tried is already assigned to another account.
+const maxAccountIDAttempts = 16
+
+// AccountIDStrategy assigns the history ids of new accounts, in place of the
+// history_accounts id sequence.  See Ingestion.AccountIDStrategy.
+type AccountIDStrategy interface {
+	// AccountID returns the id to assign to `address`.  `attempt` starts at
+	// zero and is incremented each time the returned id turns out to be
+	// assigned to another account already, so that the strategy can resolve
+	// the collision by returning a different id.  Ids must be positive.
+	AccountID(address string, attempt int) int64
+}
+
+// HashAccountIDs is an AccountIDStrategy deriving ids from the sha256 hash of
+// the account's address, so that an address is assigned the same id in every
+// db ingested with it.  Collisions are resolved by hashing the address along
+// with the attempt number.
+type HashAccountIDs struct{}
+
+// AccountID implements AccountIDStrategy.
+func (HashAccountIDs) AccountID(address string, attempt int) int64 {
+	input := address
+	if attempt > 0 {
+		input += "/" + strconv.Itoa(attempt)
+	}
+
+	sum := sha256.Sum256([]byte(input))
+	id := int64(binary.BigEndian.Uint64(sum[:8]) &^ (1 << 63))
+	if id == 0 {
+		return 1
+	}
+	return id
+}
+
+// insertAccount inserts `address` into history_accounts, with the id assigned
+// by the ingestion's AccountIDStrategy, if any, for attempt `attempt`.
+func (ingest *Ingestion) insertAccount(address string, attempt int) (id int64, err error) {
+	if ingest.AccountIDStrategy == nil {
+		err = ingest.DB.GetRaw(&id,
+			`INSERT INTO history_accounts (address) VALUES (?) RETURNING id`,
+			address,
+		)
+		return
+	}
+
+	id = ingest.AccountIDStrategy.AccountID(address, attempt)
+	if id <= 0 {
+		return 0, errors.Errorf("invalid account id %d assigned to %s", id, address)
+	}
+
+	_, err = ingest.DB.ExecRaw(
+		`INSERT INTO history_accounts (id, address) VALUES (?, ?)`,
+		id, address,
+	)
+	return
+}
+
+// isAccountIDCollision returns true if `err` was caused by inserting an
+// account with an id that is already assigned.
+func isAccountIDCollision(err error) bool {
+	pqErr, ok := errors.Cause(err).(*pq.Error)
+	return ok && pqErr.Code == "23505" && pqErr.Constraint == "index_history_accounts_on_id"
+}
@@ -0,0 +1,111 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashAccountIDs(t *testing.T) {
+	var ids HashAccountIDs
+	address := "GCFZWN3AOVFQM2BZTZX7P47WSI4QMGJC62LILPKODTNDLVKZZNA5BQJ3"
+
+	id := ids.AccountID(address, 0)
+	assert.True(t, id > 0)
+	assert.Equal(t, id, ids.AccountID(address, 0))
+	assert.Equal(t, id, HashAccountIDs{}.AccountID(address, 0))
+
+	// collisions are resolved with a different id
+	assert.NotEqual(t, id, ids.AccountID(address, 1))
+	assert.Equal(t, ids.AccountID(address, 1), ids.AccountID(address, 1))
+
+	other := "GAB7GMQPJ5YY2E4UJMLNAZPDEUKPK4AAIPRXIZHKZGUIRC6FP2LAQSDN"
+	assert.NotEqual(t, id, ids.AccountID(other, 0))
+}
+
+func TestCreateAccountID_Strategy(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	var strategy HashAccountIDs
+	ingestion := &Ingestion{
+		DB:               tt.HorizonSession(),
+		IngestionOptions: IngestionOptions{AccountIDStrategy: strategy},
+	}
+	tt.Require.NoError(ingestion.Start())
+	defer ingestion.Rollback()
+
+	var aid, other xdr.AccountId
+	tt.Require.NoError(aid.SetAddress("GCFZWN3AOVFQM2BZTZX7P47WSI4QMGJC62LILPKODTNDLVKZZNA5BQJ3"))
+	tt.Require.NoError(other.SetAddress("GAB7GMQPJ5YY2E4UJMLNAZPDEUKPK4AAIPRXIZHKZGUIRC6FP2LAQSDN"))
+
+	id, err := ingestion.createAccountID(aid)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(strategy.AccountID(aid.Address(), 0), id)
+
+	// existing accounts keep their id
+	again, err := ingestion.createAccountID(aid)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(id, again)
+
+	// an id taken by another account is retried
+	_, err = ingestion.DB.ExecRaw(
+		`INSERT INTO history_accounts (id, address) VALUES (?, ?)`,
+		strategy.AccountID(other.Address(), 0), "GTAKEN",
+	)
+	tt.Require.NoError(err)
+
+	id, err = ingestion.createAccountID(other)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(strategy.AccountID(other.Address(), 1), id)
+
+	var account history.Account
+	q := &history.Q{Session: ingestion.DB}
+	tt.Require.NoError(q.AccountByAddress(&account, other.Address()))
+	tt.Assert.Equal(id, account.ID)
+}
+
+func TestCreateAccountID_MergedAccount(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("account_merge")
+	defer tt.Finish()
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	var address string
+	tt.Require.NoError(tt.HorizonSession().GetRaw(&address,
+		`SELECT details->>'account' FROM history_operations WHERE type = ?`,
+		xdr.OperationTypeAccountMerge,
+	))
+
+	var merged history.Account
+	q := &history.Q{Session: tt.HorizonSession()}
+	tt.Require.NoError(q.AccountByAddress(&merged, address))
+
+	ingestion := &Ingestion{DB: tt.HorizonSession()}
+	tt.Require.NoError(ingestion.Start())
+	defer ingestion.Rollback()
+
+	var aid xdr.AccountId
+	tt.Require.NoError(aid.SetAddress(address))
+
+	// an account created anew after being merged keeps its id
+	id, err := ingestion.getCreateAccountID(aid)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(merged.ID, id)
+
+	// clearing the history doesn't release the id either
+	tt.Require.NoError(ingestion.ClearAll())
+	id, err = ingestion.getCreateAccountID(aid)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(merged.ID, id)
+
+	var count int
+	tt.Require.NoError(ingestion.DB.GetRaw(&count,
+		`SELECT COUNT(*) FROM history_accounts WHERE address = ?`, address,
+	))
+	tt.Assert.Equal(1, count)
+}
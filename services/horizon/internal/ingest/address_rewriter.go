@@ -0,0 +1,49 @@
+package ingest
+
+import (
+	"github.com/stellar/go/xdr"
+)
+
+// rewriteAddress returns `address` as rewritten by the ingestion's
+// AddressRewriter, or unchanged when none is set.
+func (ingest *Ingestion) rewriteAddress(address string) string {
+	if ingest.AddressRewriter == nil {
+		return address
+	}
+
+	return ingest.AddressRewriter(address)
+}
+
+// address returns the address of `aid` as stored by the ingestion.  See
+// AddressRewriter.
+func (ingest *Ingestion) address(aid xdr.AccountId) string {
+	return ingest.rewriteAddress(aid.Address())
+}
+
+// extractAsset returns the type, code and issuer of `asset` as stored by the
+// ingestion, such as in history_assets.  The code and issuer of native assets
+// are empty.  See AddressRewriter.
+func (ingest *Ingestion) extractAsset(asset xdr.Asset) (assetType, code, issuer string, err error) {
+	err = asset.Extract(&assetType, &code, &issuer)
+	if err != nil || asset.Type == xdr.AssetTypeAssetTypeNative {
+		return
+	}
+
+	issuer = ingest.rewriteAddress(issuer)
+	return
+}
+
+// assetString returns the representation of `asset` of xdr.Asset.String, as
+// stored by the ingestion.  See AddressRewriter.
+func (ingest *Ingestion) assetString(asset xdr.Asset) string {
+	if ingest.AddressRewriter == nil || asset.Type == xdr.AssetTypeAssetTypeNative {
+		return asset.String()
+	}
+
+	assetType, code, issuer, err := ingest.extractAsset(asset)
+	if err != nil {
+		return asset.String()
+	}
+
+	return assetType + "/" + code + "/" + issuer
+}
@@ -0,0 +1,109 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngest_AddressRewriter(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	// the master account of the test network, which funds the accounts of the
+	// scenario and so appears in operations, effects and participants alike
+	original := "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+	rewritten := "GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2"
+
+	sys := sys(tt)
+	sys.AddressRewriter = func(address string) string {
+		if address == original {
+			return rewritten
+		}
+		return address
+	}
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	count := func(query string, args ...interface{}) int {
+		var n int
+		tt.Require.NoError(tt.HorizonSession().GetRaw(&n, query, args...))
+		return n
+	}
+
+	for _, address := range []string{original, rewritten} {
+		found := address == rewritten
+
+		tt.Assert.Equal(found, count(`
+			SELECT COUNT(*) FROM history_accounts WHERE address = ?
+		`, address) == 1, "history_accounts: %s", address)
+		tt.Assert.Equal(found, count(`
+			SELECT COUNT(*) FROM history_transactions WHERE account = ?
+		`, address) > 0, "history_transactions: %s", address)
+		tt.Assert.Equal(found, count(`
+			SELECT COUNT(*) FROM history_operations WHERE source_account = ?
+		`, address) > 0, "history_operations: %s", address)
+		tt.Assert.Equal(found, count(`
+			SELECT COUNT(*) FROM history_operations WHERE details::text LIKE ?
+		`, "%"+address+"%") > 0, "operation details: %s", address)
+		tt.Assert.Equal(found, count(`
+			SELECT COUNT(*) FROM history_effects WHERE details::text LIKE ?
+		`, "%"+address+"%") > 0, "effect details: %s", address)
+	}
+
+	// the rows referencing the account by id resolve to the rewritten address
+	tt.Assert.True(count(`
+		SELECT COUNT(*) FROM history_effects e
+		JOIN history_accounts a ON a.id = e.history_account_id
+		WHERE a.address = ?
+	`, rewritten) > 0)
+	tt.Assert.True(count(`
+		SELECT COUNT(*) FROM history_transaction_participants p
+		JOIN history_accounts a ON a.id = p.history_account_id
+		WHERE a.address = ?
+	`, rewritten) > 0)
+
+	// every operation of the account has it as a participant
+	tt.Assert.Equal(0, count(`
+		SELECT COUNT(*) FROM history_operations o
+		WHERE o.source_account = ? AND NOT EXISTS (
+			SELECT 1 FROM history_operation_participants p
+			JOIN history_accounts a ON a.id = p.history_account_id
+			WHERE p.history_operation_id = o.id AND a.address = o.source_account
+		)
+	`, rewritten))
+}
+
+func TestIngestion_AssetString(t *testing.T) {
+	issuer := "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+	rewritten := "GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2"
+
+	var aid xdr.AccountId
+	assert.NoError(t, aid.SetAddress(issuer))
+	var usd xdr.Asset
+	assert.NoError(t, usd.SetCredit("USD", aid))
+	native := xdr.Asset{Type: xdr.AssetTypeAssetTypeNative}
+
+	ingestion := &Ingestion{}
+	assert.Equal(t, usd.String(), ingestion.assetString(usd))
+	assert.Equal(t, "native", ingestion.assetString(native))
+
+	ingestion.AddressRewriter = func(address string) string {
+		if address == issuer {
+			return rewritten
+		}
+		return address
+	}
+	assert.Equal(t, "credit_alphanum4/USD/"+rewritten, ingestion.assetString(usd))
+	assert.Equal(t, "native", ingestion.assetString(native))
+	assert.Equal(t, rewritten, ingestion.address(aid))
+
+	assetType, code, assetIssuer, err := ingestion.extractAsset(usd)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"credit_alphanum4", "USD", rewritten}, []string{assetType, code, assetIssuer})
+}
@@ -0,0 +1,70 @@
+package ingest
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/stellar/go/amount"
+	"github.com/stellar/go/xdr"
+)
+
+// DefaultAmountPrecision is the number of fractional digits of formatted
+// amounts when AmountFormat.Precision is unset: the full precision of stellar
+// amounts.
+const DefaultAmountPrecision = 7
+
+// AmountFormat describes how the amounts recorded in the details of
+// operations and effects are represented.  The zero value represents amounts
+// as amount.String does, e.g. "10.0000000".
+type AmountFormat struct {
+	// Precision is the number of fractional digits, between 1 and 7.  Amounts
+	// with more significant digits are rounded half away from zero.
+	// DefaultAmountPrecision is used when zero or out of range.
+	Precision int
+
+	// TrimZeros causes the trailing zeros of the fractional part to be
+	// removed, along with the decimal point when no fractional digit remains,
+	// e.g. "10" rather than "10.0000000".
+	TrimZeros bool
+}
+
+// FormatAmount returns the representation of `v` with the default
+// AmountFormat.
+func FormatAmount(v xdr.Int64) string {
+	return AmountFormat{}.Format(v)
+}
+
+// ParseAmount is the inverse of FormatAmount, returning the raw amount
+// represented by `s`.  It accepts the representations produced by any
+// AmountFormat, though the amounts rounded by a format with a reduced
+// precision cannot be recovered exactly.
+func ParseAmount(s string) (xdr.Int64, error) {
+	return amount.Parse(s)
+}
+
+// Format returns the representation of `v` in format `f`.
+func (f AmountFormat) Format(v xdr.Int64) string {
+	precision := f.Precision
+	if precision <= 0 || precision > DefaultAmountPrecision {
+		precision = DefaultAmountPrecision
+	}
+
+	s := big.NewRat(int64(v), amount.One).FloatString(precision)
+
+	// small negative amounts may round to zero, which is never signed
+	if strings.Trim(s, "-0.") == "" {
+		s = strings.TrimPrefix(s, "-")
+	}
+
+	if f.TrimZeros {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+
+	return s
+}
+
+// formatAmount returns the representation of `v` in the session's
+// AmountFormat.
+func (is *Session) formatAmount(v xdr.Int64) string {
+	return is.AmountFormat.Format(v)
+}
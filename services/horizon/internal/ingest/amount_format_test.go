@@ -0,0 +1,98 @@
+package ingest
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAmountFormat(t *testing.T) {
+	trim := AmountFormat{TrimZeros: true}
+	cents := AmountFormat{Precision: 2}
+
+	cases := []struct {
+		Format   AmountFormat
+		Amount   xdr.Int64
+		Expected string
+	}{
+		{AmountFormat{}, 0, "0.0000000"},
+		{AmountFormat{}, 1, "0.0000001"},
+		{AmountFormat{}, 10000000, "1.0000000"},
+		{AmountFormat{}, 1010010000, "101.0010000"},
+		{AmountFormat{}, -15000000, "-1.5000000"},
+		{AmountFormat{}, math.MaxInt64, "922337203685.4775807"},
+		{AmountFormat{}, math.MinInt64, "-922337203685.4775808"},
+		{AmountFormat{Precision: 9}, 1, "0.0000001"},
+		{trim, 0, "0"},
+		{trim, 1, "0.0000001"},
+		{trim, 1000000000, "100"},
+		{trim, 1010010000, "101.001"},
+		{trim, -15000000, "-1.5"},
+		{trim, math.MaxInt64, "922337203685.4775807"},
+		{cents, 1234567890, "123.46"},
+		{cents, -1234567890, "-123.46"},
+		{cents, 5, "0.00"},
+		{cents, -5, "0.00"},
+		{cents, math.MaxInt64, "922337203685.48"},
+		{AmountFormat{Precision: 2, TrimZeros: true}, 1000049999, "100"},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.Expected, c.Format.Format(c.Amount), "%+v %d", c.Format, c.Amount)
+	}
+
+	assert.Equal(t, "101.0010000", FormatAmount(1010010000))
+}
+
+func TestParseAmount(t *testing.T) {
+	amounts := []xdr.Int64{0, 1, -1, 10000000, 1010010000, -15000000, math.MaxInt64, math.MinInt64}
+
+	for _, v := range amounts {
+		parsed, err := ParseAmount(FormatAmount(v))
+		if assert.NoError(t, err) {
+			assert.Equal(t, v, parsed)
+		}
+
+		parsed, err = ParseAmount(AmountFormat{TrimZeros: true}.Format(v))
+		if assert.NoError(t, err) {
+			assert.Equal(t, v, parsed)
+		}
+	}
+
+	// amounts rounded up beyond the largest amount can't be parsed
+	_, err := ParseAmount(AmountFormat{Precision: 2}.Format(math.MaxInt64))
+	assert.Error(t, err)
+
+	_, err = ParseAmount("ten")
+	assert.Error(t, err)
+}
+
+func TestIngest_AmountFormat(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	count := func(query string) (n int) {
+		tt.Require.NoError(tt.HorizonSession().GetRaw(&n, query))
+		return
+	}
+	padded := `SELECT COUNT(*) FROM history_operations WHERE details->>'amount' LIKE '%.%0'`
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+	tt.Assert.NotZero(count(padded))
+
+	sys := sys(tt)
+	sys.AmountFormat = AmountFormat{TrimZeros: true}
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	tt.Assert.Zero(count(padded))
+	tt.Assert.NotZero(count(`SELECT COUNT(*) FROM history_operations WHERE details->>'amount' IS NOT NULL`))
+}
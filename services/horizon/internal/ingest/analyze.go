@@ -0,0 +1,59 @@
+package ingest
+
+import (
+	"strings"
+
+	"github.com/stellar/go/services/horizon/internal/log"
+	"github.com/stellar/go/support/errors"
+)
+
+// analyzeTables are the tables analyzed after a reingest when
+// AnalyzeAfterReingest is set: those written by the ingestion.
+var analyzeTables = []string{
+	"history_account_flags",
+	"history_accounts",
+	"history_effects",
+	"history_ledger_changes",
+	"history_ledger_stats",
+	"history_ledgers",
+	"history_operation_participants",
+	"history_operations",
+	"history_trade_pair_stats",
+	"history_trades",
+	"history_transaction_memos",
+	"history_transaction_participants",
+	"history_transaction_xdr",
+	"history_transactions",
+	"history_upgrade_proposals",
+}
+
+// analyzeAfterReingest runs ANALYZE once on each of analyzeTables, when
+// AnalyzeAfterReingest is set and `ingested` ledgers were reingested, so that
+// the planner's statistics reflect the reingested history.  It returns
+// `err`, the error of the reingest, or the error analyzing the tables if there
+// was none.  The tables are analyzed even if the reingest failed part way, as
+// the ledgers ingested until then have been committed.  Only the horizon db is
+// analyzed, never a secondary db.
+func (i *System) analyzeAfterReingest(ingested int, err error) error {
+	if !i.AnalyzeAfterReingest || ingested == 0 {
+		return err
+	}
+
+	var failed []string
+	for _, table := range analyzeTables {
+		_, aerr := i.HorizonDB.ExecRaw(`ANALYZE ` + table)
+		if aerr != nil {
+			log.
+				WithField("table", table).
+				WithField("err", aerr).
+				Error("ingest: failed to analyze table after reingest")
+			failed = append(failed, table)
+		}
+	}
+
+	if err == nil && len(failed) > 0 {
+		err = errors.Errorf("failed to analyze tables: %s", strings.Join(failed, ", "))
+	}
+
+	return err
+}
@@ -0,0 +1,79 @@
+package ingest
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// normalizeAssets replaces the assets embedded in the operation `details`,
+// and in its payment path if any, by references to their rows in
+// history_assets: the `<prefix>asset_type`, `<prefix>asset_code` and
+// `<prefix>asset_issuer` keys of each asset are replaced by a single
+// `<prefix>asset_id` key.  See Ingestion.NormalizeAssetsInDetails.
+func (ingest *Ingestion) normalizeAssets(details map[string]interface{}) error {
+	var prefixes []string
+	for key := range details {
+		if strings.HasSuffix(key, "asset_type") {
+			prefixes = append(prefixes, strings.TrimSuffix(key, "asset_type"))
+		}
+	}
+	// new assets are assigned ids in a consistent order
+	sort.Strings(prefixes)
+
+	for _, prefix := range prefixes {
+		asset, err := detailsAsset(details, prefix)
+		if err != nil {
+			return err
+		}
+
+		id, err := ingest.getCreateAssetID(asset)
+		if err != nil {
+			return errors.Wrap(err, "failed to get asset id")
+		}
+
+		delete(details, prefix+"asset_type")
+		delete(details, prefix+"asset_code")
+		delete(details, prefix+"asset_issuer")
+		details[prefix+"asset_id"] = id
+	}
+
+	path, ok := details["path"].([]map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, hop := range path {
+		err := ingest.normalizeAssets(hop)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// detailsAsset decodes the asset written to `details` with keys prefixed by
+// `prefix`.
+func detailsAsset(details map[string]interface{}, prefix string) (xdr.Asset, error) {
+	var asset xdr.Asset
+
+	if details[prefix+"asset_type"] == "native" {
+		err := asset.SetNative()
+		return asset, err
+	}
+
+	code, _ := details[prefix+"asset_code"].(string)
+	issuer, _ := details[prefix+"asset_issuer"].(string)
+
+	var aid xdr.AccountId
+	err := aid.SetAddress(issuer)
+	if err != nil {
+		return asset, errors.Wrapf(err, "invalid %sasset_issuer", prefix)
+	}
+
+	err = asset.SetCredit(code, aid)
+	return asset, err
+}
@@ -0,0 +1,76 @@
+package ingest
+
+import (
+	"github.com/stellar/go/xdr"
+)
+
+// assetKey identifies an asset by its xdr fields, so that it can be looked up
+// without encoding the address of its issuer.
+type assetKey struct {
+	Type   xdr.AssetType
+	Code   [12]byte
+	Issuer xdr.Uint256
+}
+
+// assetFields are the fields of an asset recorded in details.  Native assets
+// only have a Type.
+type assetFields struct {
+	Type   string
+	Code   string
+	Issuer string
+}
+
+// newAssetKey returns the key identifying `a`.
+func newAssetKey(a xdr.Asset) assetKey {
+	key := assetKey{Type: a.Type}
+
+	switch a.Type {
+	case xdr.AssetTypeAssetTypeCreditAlphanum4:
+		an := a.MustAlphaNum4()
+		copy(key.Code[:], an.AssetCode[:])
+		key.Issuer = an.Issuer.MustEd25519()
+	case xdr.AssetTypeAssetTypeCreditAlphanum12:
+		an := a.MustAlphaNum12()
+		copy(key.Code[:], an.AssetCode[:])
+		key.Issuer = an.Issuer.MustEd25519()
+	}
+
+	return key
+}
+
+// extractAssetFields returns the fields of `a` recorded in details.
+func (ingest *Ingestion) extractAssetFields(a xdr.Asset) (assetFields, error) {
+	var f assetFields
+	var err error
+	f.Type, f.Code, f.Issuer, err = ingest.extractAsset(a)
+	return f, err
+}
+
+// assetFields returns the fields of `a` recorded in details, from the
+// session's cache of asset fields when AssetDetailsCacheSize is set.  Once the
+// cache holds AssetDetailsCacheSize assets, the fields of other assets are
+// extracted every time.
+func (is *Session) assetFields(a xdr.Asset) (assetFields, error) {
+	if is.AssetDetailsCacheSize <= 0 {
+		return is.Ingestion.extractAssetFields(a)
+	}
+
+	key := newAssetKey(a)
+	if f, ok := is.assetDetailsCache[key]; ok {
+		return f, nil
+	}
+
+	f, err := is.Ingestion.extractAssetFields(a)
+	if err != nil {
+		return f, err
+	}
+
+	if is.assetDetailsCache == nil {
+		is.assetDetailsCache = map[assetKey]assetFields{}
+	}
+	if len(is.assetDetailsCache) < is.AssetDetailsCacheSize {
+		is.assetDetailsCache[key] = f
+	}
+
+	return f, nil
+}
@@ -0,0 +1,100 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDetailAssets(t testing.TB) []xdr.Asset {
+	var issuer xdr.AccountId
+	require.NoError(t, issuer.SetAddress("GDBAV5OYYLXE56OOEKHCZFZPGABACG53M4DOUHRDPYY7R6F2Z2TRTCV4"))
+
+	var native, usd xdr.Asset
+	require.NoError(t, native.SetNative())
+	require.NoError(t, usd.SetCredit("USD", issuer))
+
+	long := xdr.AssetAlphaNum12{Issuer: issuer}
+	copy(long.AssetCode[:], "STABLECOIN")
+	stable, err := xdr.NewAsset(xdr.AssetTypeAssetTypeCreditAlphanum12, long)
+	require.NoError(t, err)
+
+	return []xdr.Asset{native, usd, stable}
+}
+
+func TestSession_AssetDetailsCache(t *testing.T) {
+	assets := testDetailAssets(t)
+
+	details := func(is *Session) []byte {
+		dets := map[string]interface{}{"amount": "10.0000000"}
+		for i, asset := range assets {
+			prefix := []string{"", "selling_", "buying_"}[i]
+			require.NoError(t, is.assetDetails(dets, asset, prefix))
+		}
+
+		b, err := marshalDetails(dets)
+		require.NoError(t, err)
+		return b
+	}
+
+	want := details(&Session{Ingestion: &Ingestion{}})
+
+	cached := &Session{Ingestion: &Ingestion{}, AssetDetailsCacheSize: 2}
+	assert.Equal(t, string(want), string(details(cached)))
+	// the second pass is served from the cache
+	assert.Equal(t, string(want), string(details(cached)))
+
+	// only the first assets seen are cached
+	assert.Len(t, cached.assetDetailsCache, 2)
+	assert.Contains(t, cached.assetDetailsCache, newAssetKey(assets[0]))
+	assert.Contains(t, cached.assetDetailsCache, newAssetKey(assets[1]))
+}
+
+func TestNewAssetKey(t *testing.T) {
+	assets := testDetailAssets(t)
+
+	var other xdr.AccountId
+	require.NoError(t, other.SetAddress("GDFHTQSNHSYOO5KVISZZMX5FZJHPNOW4QM2S5SKPGVWTKYA5EHH763AK"))
+	var otherUSD xdr.Asset
+	require.NoError(t, otherUSD.SetCredit("USD", other))
+	assets = append(assets, otherUSD)
+
+	keys := map[assetKey]bool{}
+	for _, asset := range assets {
+		keys[newAssetKey(asset)] = true
+	}
+	assert.Len(t, keys, len(assets))
+}
+
+// BenchmarkPaymentDetails measures the details of the payments of a ledger
+// paying a single, popular asset, with and without the asset details cache.
+func BenchmarkPaymentDetails(b *testing.B) {
+	usd := testDetailAssets(b)[1]
+
+	for _, size := range []int{0, 10} {
+		name := "uncached"
+		if size > 0 {
+			name = "cached"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			is := &Session{Ingestion: &Ingestion{}, AssetDetailsCacheSize: size}
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				dets := map[string]interface{}{
+					"from":   "GDFHTQSNHSYOO5KVISZZMX5FZJHPNOW4QM2S5SKPGVWTKYA5EHH763AK",
+					"to":     "GDBAV5OYYLXE56OOEKHCZFZPGABACG53M4DOUHRDPYY7R6F2Z2TRTCV4",
+					"amount": "10.0000000",
+				}
+				if err := is.assetDetails(dets, usd, ""); err != nil {
+					b.Fatal(err)
+				}
+				if _, err := marshalDetails(dets); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
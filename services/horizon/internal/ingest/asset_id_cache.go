@@ -0,0 +1,109 @@
+package ingest
+
+import (
+	"sync"
+
+	"github.com/stellar/go/xdr"
+)
+
+// AssetIDCache caches the history ids of assets, sparing the ingestion a
+// lookup in history_assets for every asset it has already seen.  A cache is
+// shared by the sessions of a System, so implementations must be safe for
+// concurrent use.  See Ingestion.AssetIDCache.
+type AssetIDCache interface {
+	// AssetID returns the id of `asset`, and whether it was found.
+	AssetID(asset xdr.Asset) (int64, bool)
+
+	// AddAssetID records `id` as the id of `asset`.
+	AddAssetID(asset xdr.Asset, id int64)
+}
+
+// MemoryAssetIDCache is an AssetIDCache held in memory.  It is never evicted
+// from: the assets traded on a network are few and rarely change, so it stays
+// small.  The zero value is an empty cache ready to use.
+type MemoryAssetIDCache struct {
+	lock sync.RWMutex
+	ids  map[string]int64
+}
+
+// AssetID implements AssetIDCache.
+func (c *MemoryAssetIDCache) AssetID(asset xdr.Asset) (int64, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	id, ok := c.ids[asset.String()]
+	return id, ok
+}
+
+// AddAssetID implements AssetIDCache.
+func (c *MemoryAssetIDCache) AddAssetID(asset xdr.Asset, id int64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.ids == nil {
+		c.ids = map[string]int64{}
+	}
+	c.ids[asset.String()] = id
+}
+
+// cachedAssetID returns the id of `asset` from the ingestion's AssetIDCache,
+// or from the ids resolved by the current transaction, recording the cache hit
+// or miss.
+func (ingest *Ingestion) cachedAssetID(asset xdr.Asset) (int64, bool) {
+	if ingest.AssetIDCache == nil {
+		return 0, false
+	}
+
+	id, ok := ingest.AssetIDCache.AssetID(asset)
+	if !ok {
+		var pending pendingAssetID
+		pending, ok = ingest.pendingAssetIDs[asset.String()]
+		id = pending.ID
+	}
+
+	if ingest.Metrics != nil {
+		counter := ingest.Metrics.AssetCacheMissCounter
+		if ok {
+			counter = ingest.Metrics.AssetCacheHitCounter
+		}
+		if counter != nil {
+			counter.Inc(1)
+		}
+	}
+
+	return id, ok
+}
+
+// pendingAssetID is an asset id resolved by the current transaction, which is
+// only added to the ingestion's AssetIDCache once the transaction commits.
+type pendingAssetID struct {
+	Asset xdr.Asset
+	ID    int64
+}
+
+// addPendingAssetID records `id` as the id of `asset` for the current
+// transaction.  See pendingAssetID.
+func (ingest *Ingestion) addPendingAssetID(asset xdr.Asset, id int64) {
+	if ingest.AssetIDCache == nil {
+		return
+	}
+
+	if ingest.pendingAssetIDs == nil {
+		ingest.pendingAssetIDs = map[string]pendingAssetID{}
+	}
+	ingest.pendingAssetIDs[asset.String()] = pendingAssetID{Asset: asset, ID: id}
+}
+
+// cachePendingAssetIDs adds the asset ids resolved by the transaction just
+// committed to the ingestion's AssetIDCache.  Ingestions run
+// WithinTransaction never cache them, as the outer transaction may yet roll
+// back the assets they created.
+func (ingest *Ingestion) cachePendingAssetIDs() {
+	if ingest.AssetIDCache != nil && !ingest.WithinTransaction {
+		for _, pending := range ingest.pendingAssetIDs {
+			ingest.AssetIDCache.AddAssetID(pending.Asset, pending.ID)
+		}
+	}
+
+	ingest.pendingAssetIDs = nil
+}
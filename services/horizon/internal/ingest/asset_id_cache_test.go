@@ -0,0 +1,117 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryAssetIDCache(t *testing.T) {
+	var cache MemoryAssetIDCache
+
+	var issuer xdr.AccountId
+	require.NoError(t, issuer.SetAddress("GCFZWN3AOVFQM2BZTZX7P47WSI4QMGJC62LILPKODTNDLVKZZNA5BQJ3"))
+
+	var usd, eur, native xdr.Asset
+	require.NoError(t, usd.SetCredit("USD", issuer))
+	require.NoError(t, eur.SetCredit("EUR", issuer))
+	require.NoError(t, native.SetNative())
+
+	_, ok := cache.AssetID(usd)
+	assert.False(t, ok)
+
+	cache.AddAssetID(usd, 1)
+	cache.AddAssetID(native, 2)
+
+	id, ok := cache.AssetID(usd)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), id)
+
+	id, ok = cache.AssetID(native)
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), id)
+
+	_, ok = cache.AssetID(eur)
+	assert.False(t, ok)
+}
+
+func TestIngest_AssetIDCache(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("trades")
+	defer tt.Finish()
+
+	sys := sys(tt)
+	sys.AssetIDCache = &MemoryAssetIDCache{}
+	hits, misses := sys.Metrics.AssetCacheHitCounter, sys.Metrics.AssetCacheMissCounter
+
+	run := func() {
+		s := NewSession(sys)
+		s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+		s.ClearExisting = true
+		s.Run()
+		tt.Require.NoError(s.Err)
+	}
+
+	count := func(query string) (n int) {
+		tt.Require.NoError(tt.HorizonSession().GetRaw(&n, query))
+		return
+	}
+
+	// each asset is looked up once
+	run()
+	tt.Require.NotZero(count(`SELECT COUNT(*) FROM history_trades`))
+	tt.Assert.Equal(int64(count(`SELECT COUNT(*) FROM history_assets`)), misses.Count())
+	tt.Assert.NotZero(hits.Count())
+
+	// and never again once cached
+	warm, warmHits := misses.Count(), hits.Count()
+	run()
+	tt.Assert.Equal(warm, misses.Count())
+	tt.Assert.True(hits.Count() > warmHits)
+}
+
+func TestAssetIDCache_Rollback(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	cache := &MemoryAssetIDCache{}
+	ingestion := &Ingestion{
+		DB:               tt.HorizonSession(),
+		IngestionOptions: IngestionOptions{AssetIDCache: cache},
+	}
+	var issuer xdr.AccountId
+	tt.Require.NoError(issuer.SetAddress("GCFZWN3AOVFQM2BZTZX7P47WSI4QMGJC62LILPKODTNDLVKZZNA5BQJ3"))
+	var usd xdr.Asset
+	tt.Require.NoError(usd.SetCredit("USD", issuer))
+
+	// ids created by a transaction rolled back are never cached
+	tt.Require.NoError(ingestion.Start())
+	id, err := ingestion.getCreateAssetID(usd)
+	tt.Require.NoError(err)
+	again, err := ingestion.getCreateAssetID(usd)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(id, again)
+	tt.Require.NoError(ingestion.Rollback())
+
+	_, ok := cache.AssetID(usd)
+	tt.Assert.False(ok)
+
+	// while those of a transaction committed are
+	tt.Require.NoError(ingestion.Start())
+	id, err = ingestion.getCreateAssetID(usd)
+	tt.Require.NoError(err)
+	tt.Require.NoError(ingestion.Close())
+
+	cached, ok := cache.AssetID(usd)
+	tt.Assert.True(ok)
+	tt.Assert.Equal(id, cached)
+
+	q := &history.Q{Session: tt.HorizonSession()}
+	stored, err := q.GetAssetID(usd)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(stored, cached)
+}
@@ -6,7 +6,6 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/stellar/go/services/horizon/internal/db2/core"
 	"github.com/stellar/go/services/horizon/internal/db2/history"
-	"github.com/stellar/go/support/db"
 	"github.com/stellar/go/xdr"
 )
 
@@ -38,9 +37,12 @@ func (assetsModified AssetsModified) IngestOperation(err error, op *xdr.Operatio
 	body := op.Body
 	sourceAccount := defaultSourceAccount(op.SourceAccount, source)
 	switch body.Type {
-	// TODO NNS 2 need to fix GetCreateAssetID call when adding assets from account
-	// case xdr.OperationTypeSetOptions:
-	// 	assetsModified.addAssetsFromAccount(coreQ, sourceAccount)
+	case xdr.OperationTypeSetOptions:
+		// the toml url of the assets issued by the account depends on its home
+		// domain
+		if body.SetOptionsOp.HomeDomain != nil {
+			return assetsModified.addAssetsIssuedBy(coreQ, sourceAccount)
+		}
 	case xdr.OperationTypePayment:
 		// payments is the only operation where we currently perform the optimization of checking against the issuer
 		return assetsModified.handlePaymentOp(body.PaymentOp, sourceAccount)
@@ -75,7 +77,13 @@ func (assetsModified AssetsModified) UpdateAssetStats(is *Session) {
 		return
 	}
 
-	hasValue := false
+	// stats are computed from stellar-core, which bundle-driven sessions may
+	// not be connected to.
+	if is.Cursor.DB == nil {
+		return
+	}
+
+	var ids []int64
 	for _, asset := range assetsModified {
 		assetStat := computeAssetStat(is, &asset)
 		if is.Err != nil {
@@ -83,7 +91,7 @@ func (assetsModified AssetsModified) UpdateAssetStats(is *Session) {
 		}
 
 		if assetStat != nil {
-			hasValue = true
+			ids = append(ids, assetStat.ID)
 			is.Ingestion.assetStats = is.Ingestion.assetStats.Values(
 				assetStat.ID,
 				assetStat.Amount,
@@ -91,12 +99,22 @@ func (assetsModified AssetsModified) UpdateAssetStats(is *Session) {
 				assetStat.Flags,
 				assetStat.Toml,
 			)
+
+			if is.TomlFetcher != nil {
+				if is.tomlRequests == nil {
+					is.tomlRequests = map[int64]string{}
+				}
+				is.tomlRequests[assetStat.ID] = assetStat.Toml
+			}
 		}
 	}
 
-	if hasValue {
-		// perform a delete first since upsert is not supported if postgres < 9.5
-		is.Err = assetsModified.deleteRows(is.Ingestion.DB)
+	if len(ids) > 0 {
+		// perform a delete first since upsert is not supported if postgres < 9.5.
+		// The rows are deleted by the ids resolved by the ingestion, rather than
+		// by looking the assets up again, so that assets whose issuers are
+		// rewritten are found.  See AddressRewriter.
+		is.Err = is.Ingestion.exec(sq.Delete("asset_stats").Where(sq.Eq{"id": ids}))
 		if is.Err != nil {
 			return
 		}
@@ -104,7 +122,7 @@ func (assetsModified AssetsModified) UpdateAssetStats(is *Session) {
 		// can perform a direct upsert if postgres > 9.4
 		// is.Ingestion.assetStats = is.Ingestion.assetStats.
 		// 	Suffix("ON CONFLICT (id) DO UPDATE SET (amount, num_accounts, flags, toml) = (excluded.amount, excluded.num_accounts, excluded.flags, excluded.toml)")
-		_, is.Err = is.Ingestion.DB.Exec(is.Ingestion.assetStats)
+		is.Err = is.Ingestion.exec(is.Ingestion.assetStats)
 	}
 }
 
@@ -123,27 +141,23 @@ func (assetsModified AssetsModified) UpdateAssetStats(is *Session) {
 // 	}
 // }
 
-func (assetsModified AssetsModified) deleteRows(session *db.Session) error {
-	if len(assetsModified) == 0 {
+// addAssetsIssuedBy adds the assets issued by `account` that are held by at
+// least one trustline, provided stellar-core's db is available.
+func (assetsModified AssetsModified) addAssetsIssuedBy(coreQ *core.Q, account *xdr.AccountId) error {
+	if coreQ == nil || coreQ.Session == nil {
 		return nil
 	}
 
-	assets := make([]xdr.Asset, 0, len(assetsModified))
-	for _, asset := range assetsModified {
-		assets = append(assets, asset)
-	}
-	historyQ := history.Q{Session: session}
-	ids, err := historyQ.GetAssetIDs(assets)
+	var assets []xdr.Asset
+	err := coreQ.AssetsIssuedBy(&assets, account.Address())
 	if err != nil {
 		return err
 	}
-	if len(ids) == 0 {
-		return nil
-	}
 
-	deleteStmt := sq.Delete("asset_stats").Where(sq.Eq{"id": ids})
-	_, err = session.Exec(deleteStmt)
-	return err
+	for _, asset := range assets {
+		assetsModified.add(asset)
+	}
+	return nil
 }
 
 func (assetsModified AssetsModified) updateIfAssetIssuerInvolved(asset xdr.Asset, account xdr.AccountId) error {
@@ -164,8 +178,7 @@ func computeAssetStat(is *Session, asset *xdr.Asset) *history.AssetStat {
 		return nil
 	}
 
-	historyQ := history.Q{Session: is.Ingestion.DB}
-	assetID, err := historyQ.GetCreateAssetID(*asset)
+	assetID, err := is.Ingestion.getCreateAssetID(*asset)
 	if err != nil {
 		is.Err = err
 		return nil
@@ -400,6 +400,45 @@ func TestSourceAccountForAllowTrust(t *testing.T) {
 	assert.Equal(t, wantAssets, extractKeys(assetsModified))
 }
 
+func TestAssetModified_HomeDomain(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("asset_stat_operations")
+	defer tt.Finish()
+	coreQ := &core.Q{Session: &db.Session{DB: tt.CoreDB}}
+
+	// GCFZWN3AOVFQM2BZTZX7P47WSI4QMGJC62LILPKODTNDLVKZZNA5BQJ3
+	issuerAccount, _ := makeAccount("SCCUFFUANIXJPAWBHDXZXY5D4GB32QPM6MOUWDD6PTYBLPE6JVYZFE76", "USD")
+	domain := xdr.String32("example.com")
+
+	// the assets issued by the account need their toml refreshed
+	assetsModified := AssetsModified(make(map[string]xdr.Asset))
+	err := assetsModified.IngestOperation(
+		nil,
+		&xdr.Operation{
+			Body: makeOperationBody(xdr.OperationTypeSetOptions, xdr.SetOptionsOp{
+				HomeDomain: &domain,
+			}),
+		},
+		&issuerAccount,
+		coreQ)
+	tt.Require.NoError(err)
+	tt.Assert.Equal([]string{"credit_alphanum4/USD/GCFZWN3AOVFQM2BZTZX7P47WSI4QMGJC62LILPKODTNDLVKZZNA5BQJ3"}, extractKeys(assetsModified))
+
+	// other options do not affect asset stats
+	var weight xdr.Uint32 = 2
+	assetsModified = AssetsModified(make(map[string]xdr.Asset))
+	err = assetsModified.IngestOperation(
+		nil,
+		&xdr.Operation{
+			Body: makeOperationBody(xdr.OperationTypeSetOptions, xdr.SetOptionsOp{
+				MasterWeight: &weight,
+			}),
+		},
+		&issuerAccount,
+		coreQ)
+	tt.Require.NoError(err)
+	tt.Assert.Empty(assetsModified)
+}
+
 func makeAccount(secret string, code string) (xdr.AccountId, xdr.Asset) {
 	kp := keypair.MustParse(secret)
 
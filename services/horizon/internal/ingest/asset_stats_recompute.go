@@ -0,0 +1,178 @@
+package ingest
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/services/horizon/internal/assets"
+	"github.com/stellar/go/services/horizon/internal/db2/core"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/log"
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// recomputeAssetStatsBatch is the number of assets loaded from history_assets
+// at a time by RecomputeAssetStats.
+const recomputeAssetStatsBatch = 100
+
+// RecomputeAssetStats recomputes the asset_stats row of every asset of
+// history_assets from the current state of stellar-core, returning the number
+// of assets recomputed.  Assets are recomputed in the order of their ids, each
+// within its own database transaction along with a checkpoint recording its
+// id, so that a recompute interrupted part way resumes after the last asset it
+// completed when run again.  The checkpoint is removed once every asset has
+// been recomputed, so that the next recompute starts over.
+//
+// The stats are looked up in stellar-core by the issuers recorded in
+// history_assets, so assets cannot be recomputed when AddressRewriter is set.
+func (i *System) RecomputeAssetStats() (int, error) {
+	if i.CoreDB == nil {
+		return 0, errors.New("stellar-core db is required to recompute asset stats")
+	}
+	if i.AddressRewriter != nil {
+		return 0, errors.New("asset stats cannot be recomputed when addresses are rewritten")
+	}
+
+	ingestion := i.newIngestion()
+	err := ingestion.Start()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to begin ingestion")
+	}
+	defer ingestion.Rollback()
+
+	last, err := loadAssetStatsCheckpoint(ingestion.DB)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to load checkpoint")
+	}
+	if last > 0 {
+		log.WithField("asset_id", last).Info("ingest: resuming asset stats recompute")
+	}
+
+	coreQ := &core.Q{Session: i.CoreDB}
+	recomputed := 0
+	for {
+		var batch []history.Asset
+		err = ingestion.DB.Select(&batch, sq.
+			Select("id", "asset_type", "asset_code", "asset_issuer").
+			From("history_assets").
+			Where(sq.Gt{"id": last}).
+			OrderBy("id").
+			Limit(recomputeAssetStatsBatch),
+		)
+		if err != nil {
+			return recomputed, errors.Wrap(err, "failed to load assets")
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, asset := range batch {
+			toml, err := recomputeAssetStat(ingestion, coreQ, asset)
+			if err != nil {
+				return recomputed, errors.Wrapf(err, "failed to recompute stats of asset %d", asset.ID)
+			}
+
+			// NOTE: like the checkpoints of sessions, the checkpoint describes the
+			// progress against the primary db only, so it is not mirrored to the
+			// secondary.
+			err = checkpointAssetStats(ingestion.DB, asset.ID, ingestion.now())
+			if err != nil {
+				return recomputed, errors.Wrap(err, "failed to record checkpoint")
+			}
+
+			err = ingestion.Flush()
+			if err != nil {
+				return recomputed, errors.Wrap(err, "failed to flush ingestion")
+			}
+
+			if i.TomlFetcher != nil {
+				i.TomlFetcher.Queue(asset.ID, toml)
+			}
+
+			last = asset.ID
+			recomputed++
+		}
+	}
+
+	_, err = ingestion.DB.Exec(sq.Delete("history_asset_stats_checkpoints"))
+	if err != nil {
+		return recomputed, errors.Wrap(err, "failed to remove checkpoint")
+	}
+
+	err = ingestion.Flush()
+	if err != nil {
+		return recomputed, errors.Wrap(err, "failed to flush ingestion")
+	}
+
+	if i.TomlFetcher != nil {
+		i.TomlFetcher.Start()
+	}
+
+	log.WithField("assets", recomputed).Info("ingest: recomputed asset stats")
+	return recomputed, nil
+}
+
+// recomputeAssetStat replaces the asset_stats row of `asset` with one computed
+// from the current state of stellar-core, returning the url of its
+// stellar.toml file.  Native assets have no stats.
+func recomputeAssetStat(ingestion *Ingestion, coreQ *core.Q, asset history.Asset) (string, error) {
+	assetType, err := assets.Parse(asset.Type)
+	if err != nil {
+		return "", err
+	}
+	if assetType == xdr.AssetTypeAssetTypeNative {
+		return "", nil
+	}
+
+	numAccounts, amount, err := statTrustlinesInfo(coreQ, assetType, asset.Code, asset.Issuer)
+	if err != nil {
+		return "", err
+	}
+
+	flags, toml, err := statAccountInfo(coreQ, asset.Issuer)
+	if err != nil {
+		return "", err
+	}
+
+	err = ingestion.exec(sq.Delete("asset_stats").Where(sq.Eq{"id": asset.ID}))
+	if err != nil {
+		return "", err
+	}
+
+	err = ingestion.exec(ingestion.assetStats.Values(asset.ID, amount, numAccounts, flags, toml))
+	if err != nil {
+		return "", err
+	}
+
+	return toml, nil
+}
+
+// loadAssetStatsCheckpoint returns the id of the last asset recomputed by an
+// unfinished RecomputeAssetStats, or 0 if there is none.
+func loadAssetStatsCheckpoint(session *db.Session) (int64, error) {
+	var id int64
+	err := session.Get(&id, sq.Select("asset_id").From("history_asset_stats_checkpoints"))
+	if session.NoRows(err) {
+		return 0, nil
+	}
+
+	return id, err
+}
+
+// checkpointAssetStats records `id` as the last asset recomputed by
+// RecomputeAssetStats, at time `now`.
+func checkpointAssetStats(session *db.Session, id int64, now time.Time) error {
+	_, err := session.Exec(sq.Delete("history_asset_stats_checkpoints"))
+	if err != nil {
+		return err
+	}
+
+	_, err = session.Exec(sq.
+		Insert("history_asset_stats_checkpoints").
+		Columns("asset_id", "updated_at").
+		Values(id, now),
+	)
+	return err
+}
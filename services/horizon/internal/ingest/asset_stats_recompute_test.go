@@ -0,0 +1,66 @@
+package ingest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/test"
+)
+
+func TestRecomputeAssetStats_Resume(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("ingest_asset_stats")
+	defer tt.Finish()
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+	hq := tt.HorizonSession()
+
+	type stat struct {
+		ID          int64 `db:"id"`
+		Amount      int64 `db:"amount"`
+		NumAccounts int32 `db:"num_accounts"`
+	}
+	stats := func() (result []stat) {
+		tt.Require.NoError(hq.SelectRaw(&result, `
+			SELECT id, amount, num_accounts FROM asset_stats ORDER BY id
+		`))
+		return
+	}
+	checkpoints := func() (n int) {
+		tt.Require.NoError(hq.GetRaw(&n, `SELECT COUNT(*) FROM history_asset_stats_checkpoints`))
+		return
+	}
+
+	want := stats()
+	tt.Require.True(len(want) > 1)
+
+	var assetCount int
+	tt.Require.NoError(hq.GetRaw(&assetCount, `SELECT COUNT(*) FROM history_assets`))
+
+	_, err := hq.ExecRaw(`UPDATE asset_stats SET amount = 0, num_accounts = 0`)
+	tt.Require.NoError(err)
+
+	// simulate a recompute that stopped after completing the first asset
+	first := want[0].ID
+	tt.Require.NoError(checkpointAssetStats(hq, first, time.Now().UTC()))
+
+	n, err := sys(tt).RecomputeAssetStats()
+	tt.Require.NoError(err)
+
+	var remaining int
+	tt.Require.NoError(hq.GetRaw(&remaining, `SELECT COUNT(*) FROM history_assets WHERE id > ?`, first))
+	tt.Assert.Equal(remaining, n)
+	tt.Assert.Equal(0, checkpoints())
+
+	// the first asset was not recomputed again, the others were
+	got := stats()
+	tt.Require.Len(got, len(want))
+	tt.Assert.Equal(stat{ID: first}, got[0])
+	tt.Assert.Equal(want[1:], got[1:])
+
+	// once complete, a recompute starts over
+	n, err = sys(tt).RecomputeAssetStats()
+	tt.Require.NoError(err)
+	tt.Assert.Equal(assetCount, n)
+	tt.Assert.Equal(want, stats())
+	tt.Assert.Equal(0, checkpoints())
+}
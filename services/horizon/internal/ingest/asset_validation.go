@@ -0,0 +1,95 @@
+package ingest
+
+import (
+	"github.com/stellar/go/services/horizon/internal/log"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// validateAsset checks the code of `asset` before it is resolved to a row of
+// history_assets.  A valid code consists of ascii letters and digits, padded
+// with trailing zero bytes, and is at least 1 character long for an
+// alphanum4 asset or 5 for an alphanum12 one, as stellar-core requires.
+//
+// Valid assets are returned unchanged.  An invalid asset is an error when
+// StrictAssetValidation is set; otherwise it is canonicalized by dropping the
+// bytes of its code that are not letters or digits and moving the padding to
+// the end, which is logged.  A code with too few characters left is an error
+// in either case.
+func (ingest *Ingestion) validateAsset(asset xdr.Asset) (xdr.Asset, error) {
+	var code []byte
+	var min int
+	switch asset.Type {
+	case xdr.AssetTypeAssetTypeCreditAlphanum4:
+		an := asset.MustAlphaNum4()
+		code, min = an.AssetCode[:], 1
+	case xdr.AssetTypeAssetTypeCreditAlphanum12:
+		an := asset.MustAlphaNum12()
+		code, min = an.AssetCode[:], 5
+	default:
+		return asset, nil
+	}
+
+	if validAssetCode(code, min) {
+		return asset, nil
+	}
+
+	if ingest.StrictAssetValidation {
+		return asset, errors.Errorf("invalid asset code %q", code)
+	}
+
+	canonical := make([]byte, 0, len(code))
+	for _, b := range code {
+		if isAssetCodeChar(b) {
+			canonical = append(canonical, b)
+		}
+	}
+	if len(canonical) < min {
+		return asset, errors.Errorf("invalid asset code %q cannot be canonicalized", code)
+	}
+
+	// the asset's arm is replaced rather than modified, as it may be shared
+	// with the xdr of the ledger being ingested
+	switch asset.Type {
+	case xdr.AssetTypeAssetTypeCreditAlphanum4:
+		an := asset.MustAlphaNum4()
+		an.AssetCode = [4]byte{}
+		copy(an.AssetCode[:], canonical)
+		asset.AlphaNum4 = &an
+	case xdr.AssetTypeAssetTypeCreditAlphanum12:
+		an := asset.MustAlphaNum12()
+		an.AssetCode = [12]byte{}
+		copy(an.AssetCode[:], canonical)
+		asset.AlphaNum12 = &an
+	}
+
+	log.
+		WithField("code", string(code)).
+		WithField("canonical", string(canonical)).
+		Warn("ingest: canonicalized invalid asset code")
+	return asset, nil
+}
+
+// validAssetCode returns true if `code` is at least `min` letters or digits
+// followed only by zero bytes.
+func validAssetCode(code []byte, min int) bool {
+	n := 0
+	for n < len(code) && code[n] != 0 {
+		if !isAssetCodeChar(code[n]) {
+			return false
+		}
+		n++
+	}
+
+	for _, b := range code[n:] {
+		if b != 0 {
+			return false
+		}
+	}
+
+	return n >= min
+}
+
+func isAssetCodeChar(b byte) bool {
+	return ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') || ('0' <= b && b <= '9')
+}
@@ -0,0 +1,69 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAsset(t *testing.T) {
+	var issuer xdr.AccountId
+	require.NoError(t, issuer.SetAddress("GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"))
+
+	alphanum4 := func(code string) xdr.Asset {
+		an := xdr.AssetAlphaNum4{Issuer: issuer}
+		copy(an.AssetCode[:], code)
+		return xdr.Asset{Type: xdr.AssetTypeAssetTypeCreditAlphanum4, AlphaNum4: &an}
+	}
+	alphanum12 := func(code string) xdr.Asset {
+		an := xdr.AssetAlphaNum12{Issuer: issuer}
+		copy(an.AssetCode[:], code)
+		return xdr.Asset{Type: xdr.AssetTypeAssetTypeCreditAlphanum12, AlphaNum12: &an}
+	}
+
+	cases := []struct {
+		Name      string
+		Asset     xdr.Asset
+		Canonical xdr.Asset
+		Strict    bool
+		Lenient   bool
+	}{
+		{"native", xdr.Asset{Type: xdr.AssetTypeAssetTypeNative}, xdr.Asset{Type: xdr.AssetTypeAssetTypeNative}, true, true},
+		{"alphanum4", alphanum4("USD"), alphanum4("USD"), true, true},
+		{"alphanum12", alphanum12("SCOTTBUCKS"), alphanum12("SCOTTBUCKS"), true, true},
+		{"invalid bytes", alphanum4("U\xffSD"), alphanum4("USD"), false, true},
+		{"invalid utf-8", alphanum12("SCOTT\xc3BUCKS"), alphanum12("SCOTTBUCKS"), false, true},
+		{"interior padding", alphanum4("US\x00D"), alphanum4("USD"), false, true},
+		{"empty", alphanum4(""), alphanum4(""), false, false},
+		{"short alphanum12", alphanum12("USD\xff\xff\xff"), alphanum12("USD\xff\xff\xff"), false, false},
+	}
+
+	for _, kase := range cases {
+		var ingest Ingestion
+
+		// a copy of the asset, to verify the original is not modified
+		original, err := xdr.MarshalBase64(kase.Asset)
+		require.NoError(t, err)
+
+		asset, err := ingest.validateAsset(kase.Asset)
+		if kase.Lenient && assert.NoError(t, err, kase.Name) {
+			assert.True(t, asset.Equals(kase.Canonical), kase.Name)
+		} else {
+			assert.Error(t, err, kase.Name)
+		}
+
+		ingest.StrictAssetValidation = true
+		asset, err = ingest.validateAsset(kase.Asset)
+		if kase.Strict && assert.NoError(t, err, kase.Name) {
+			assert.True(t, asset.Equals(kase.Asset), kase.Name)
+		} else {
+			assert.Error(t, err, kase.Name)
+		}
+
+		after, err := xdr.MarshalBase64(kase.Asset)
+		require.NoError(t, err)
+		assert.Equal(t, original, after, kase.Name)
+	}
+}
@@ -0,0 +1,193 @@
+package ingest
+
+import (
+	"strings"
+
+	"github.com/stellar/go/services/horizon/internal/log"
+	"github.com/stellar/go/support/errors"
+)
+
+// bulkIndexTables are the tables whose indexes BulkReingest drops.  The
+// tables read by the ingestion itself, such as history_transactions for
+// duplicate detection, keep their indexes.
+var bulkIndexTables = []string{
+	"history_account_flags",
+	"history_effects",
+	"history_ledger_changes",
+	"history_operation_participants",
+	"history_operations",
+	"history_trades",
+	"history_transaction_participants",
+}
+
+// bulkIndex is an index dropped by BulkReingest.
+type bulkIndex struct {
+	Name       string `db:"indexname"`
+	Definition string `db:"indexdef"`
+}
+
+// BulkReingest reingests the ledgers `first` through `last`, inclusive, for
+// bulk loads of history.  When BulkReingestDropIndexes is set, the range is
+// first cleared, then the non-unique indexes of the tables in
+// bulkIndexTables are dropped, so that the ledgers are loaded without
+// maintaining them, and finally recreated from the definitions captured
+// before dropping them.  The indexes are recreated whether or not the
+// reingest succeeds, even should it panic.  Queries relying on the indexes
+// are slow in the meantime.  Should the process die before the indexes are
+// recreated, the definitions logged when they were dropped must be recreated
+// by hand.  Only the indexes of the horizon db are dropped, never those of a
+// secondary db.
+//
+// The history tables are analyzed once the indexes have been recreated when
+// AnalyzeAfterReingest is set.
+//
+// When BulkReingestDropIndexes is not set, BulkReingest is ReingestRange.
+func (i *System) BulkReingest(first, last int32) (int, error) {
+	if first < 1 || first > last {
+		return 0, errors.Errorf("invalid ledger range: %d to %d", first, last)
+	}
+
+	if !i.BulkReingestDropIndexes {
+		return i.ReingestRange(first, last)
+	}
+
+	// clearing the range relies on the indexes, so it happens first
+	err := i.clearRange(first, last)
+	if err != nil {
+		return 0, err
+	}
+
+	ingested, err := i.bulkReingest(first, last)
+	return ingested, i.analyzeAfterReingest(ingested, err)
+}
+
+// bulkReingest reingests the ledgers `first` through `last` with the indexes
+// of bulkIndexTables dropped.  The indexes are recreated by a deferred call,
+// so that they are restored when the reingest fails or panics alike.
+func (i *System) bulkReingest(first, last int32) (ingested int, err error) {
+	indexes, err := i.bulkIndexes()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, idx := range indexes {
+		log.
+			WithField("index", idx.Name).
+			WithField("definition", idx.Definition).
+			Info("ingest: dropping index for bulk reingest")
+	}
+
+	dropped, err := i.dropIndexes(indexes)
+	defer func() {
+		err = i.restoreIndexes(dropped, err)
+	}()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to drop indexes")
+	}
+
+	is := NewSession(i)
+	is.Cursor = NewCursor(first, last, i)
+
+	// a panic leaves the session's transaction open, holding locks that would
+	// block recreating the indexes of the tables it wrote to
+	defer func() {
+		if rec := recover(); rec != nil {
+			is.Ingestion.Rollback()
+			panic(rec)
+		}
+	}()
+
+	is.Run()
+
+	log.WithField("start", first).
+		WithField("end", last).
+		WithField("err", is.Err).
+		WithField("ingested", is.Ingested).
+		Info("ingest: bulk range complete")
+
+	return is.Ingested, is.Err
+}
+
+// clearRange removes the history of the ledgers `first` through `last`.
+func (i *System) clearRange(first, last int32) error {
+	ingestion := i.newIngestion()
+
+	err := ingestion.Start()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin ingestion")
+	}
+
+	err = ingestion.Clear(ledgerIDRange(i.ids(), first, last))
+	if err != nil {
+		ingestion.Rollback()
+		return errors.Wrap(err, "failed to clear ingestion")
+	}
+
+	err = ingestion.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to close ingestion")
+	}
+
+	return nil
+}
+
+// bulkIndexes returns the indexes dropped by BulkReingest: those of the
+// tables in bulkIndexTables that are neither unique nor back a constraint.
+func (i *System) bulkIndexes() ([]bulkIndex, error) {
+	var indexes []bulkIndex
+	err := i.HorizonDB.SelectRaw(&indexes, `
+		SELECT ci.relname AS indexname, pg_get_indexdef(ci.oid) AS indexdef
+		FROM pg_index x
+		JOIN pg_class ci ON ci.oid = x.indexrelid
+		JOIN pg_class ct ON ct.oid = x.indrelid
+		WHERE ct.relname IN ('`+strings.Join(bulkIndexTables, "', '")+`')
+		AND ct.relnamespace = (SELECT oid FROM pg_namespace WHERE nspname = current_schema())
+		AND NOT x.indisunique
+		AND NOT x.indisprimary
+		AND NOT EXISTS (SELECT 1 FROM pg_constraint c WHERE c.conindid = ci.oid)
+		ORDER BY ci.relname`,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load indexes")
+	}
+
+	return indexes, nil
+}
+
+// dropIndexes drops `indexes`, returning those dropped, even on failure.
+func (i *System) dropIndexes(indexes []bulkIndex) ([]bulkIndex, error) {
+	var dropped []bulkIndex
+	for _, idx := range indexes {
+		_, err := i.HorizonDB.ExecRaw(`DROP INDEX ` + idx.Name)
+		if err != nil {
+			return dropped, errors.Wrapf(err, "failed to drop index %s", idx.Name)
+		}
+		dropped = append(dropped, idx)
+	}
+
+	return dropped, nil
+}
+
+// restoreIndexes recreates the dropped `indexes`, returning `err`, the error
+// of the bulk reingest, or the error recreating the indexes if there was
+// none.  Every index is attempted, even if one fails.
+func (i *System) restoreIndexes(indexes []bulkIndex, err error) error {
+	var failed []string
+	for _, idx := range indexes {
+		_, cerr := i.HorizonDB.ExecRaw(idx.Definition)
+		if cerr != nil {
+			log.
+				WithField("index", idx.Name).
+				WithField("definition", idx.Definition).
+				WithField("err", cerr).
+				Error("ingest: failed to recreate index after bulk reingest")
+			failed = append(failed, idx.Name)
+		}
+	}
+
+	if err == nil && len(failed) > 0 {
+		err = errors.Errorf("failed to recreate indexes: %s", strings.Join(failed, ", "))
+	}
+
+	return err
+}
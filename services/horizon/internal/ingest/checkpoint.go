@@ -0,0 +1,103 @@
+package ingest
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/services/horizon/internal/log"
+	"github.com/stellar/go/support/errors"
+)
+
+// checkpointRange is the range of ledgers a session's checkpoints are
+// recorded against: the range of its cursor before resuming from a checkpoint
+// moved the cursor's start.
+type checkpointRange struct {
+	First int32
+	Last  int32
+}
+
+// resumeFromCheckpoint moves the start of the session's cursor past the
+// ledger recorded by the last checkpoint of its range, if any, so that a
+// session rerun over a range after a crash does not reingest the ledgers it
+// already committed.  See Session.CheckpointEvery.
+func (is *Session) resumeFromCheckpoint() {
+	if is.Err != nil || is.CheckpointEvery <= 0 || is.Cursor.bundles != nil {
+		return
+	}
+
+	is.checkpoints = &checkpointRange{
+		First: is.Cursor.FirstLedger,
+		Last:  is.Cursor.LastLedger,
+	}
+
+	var ledger int32
+	err := is.Ingestion.DB.Get(&ledger, sq.
+		Select("ledger").
+		From("history_ingest_checkpoints").
+		Where(sq.Eq{
+			"first_ledger": is.checkpoints.First,
+			"last_ledger":  is.checkpoints.Last,
+		}),
+	)
+	if is.Ingestion.DB.NoRows(err) {
+		return
+	}
+	if err != nil {
+		is.Err = errors.Wrap(err, "failed to load checkpoint")
+		return
+	}
+
+	// checkpoints are never recorded for the last ledger of the range, so the
+	// cursor cannot be moved past its end
+	if is.Cursor.Descending() {
+		is.Cursor.FirstLedger = ledger - 1
+	} else {
+		is.Cursor.FirstLedger = ledger + 1
+	}
+
+	log.
+		WithField("first", is.checkpoints.First).
+		WithField("last", is.checkpoints.Last).
+		WithField("checkpoint", ledger).
+		Info("ingest: resuming from checkpoint")
+}
+
+// checkpoint records the current ledger as the checkpoint of the session's
+// range every CheckpointEvery ledgers, counted from the start of the range.
+// The checkpoint is written within the ledger's transaction, so that it is
+// committed if, and only if, the ledger is.  Once the last ledger of the range
+// is reached the range's checkpoint is removed instead, so that a later
+// session over the same range ingests it in full.
+func (is *Session) checkpoint() {
+	if is.Err != nil || is.checkpoints == nil {
+		return
+	}
+
+	seq := is.Cursor.LedgerSequence()
+	r := is.checkpoints
+	where := sq.Eq{"first_ledger": r.First, "last_ledger": r.Last}
+
+	if seq == r.Last {
+		_, is.Err = is.Ingestion.DB.Exec(sq.Delete("history_ingest_checkpoints").Where(where))
+		return
+	}
+
+	n := seq - r.First
+	if n < 0 {
+		n = -n
+	}
+	if (n+1)%is.CheckpointEvery != 0 {
+		return
+	}
+
+	// NOTE: checkpoints describe the progress of the session against the
+	// primary db only, so they are not mirrored to the secondary.
+	_, is.Err = is.Ingestion.DB.Exec(sq.Delete("history_ingest_checkpoints").Where(where))
+	if is.Err != nil {
+		return
+	}
+
+	_, is.Err = is.Ingestion.DB.Exec(sq.
+		Insert("history_ingest_checkpoints").
+		Columns("first_ledger", "last_ledger", "ledger", "updated_at").
+		Values(r.First, r.Last, seq, is.Ingestion.now()),
+	)
+}
@@ -0,0 +1,66 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+)
+
+func TestSession_CheckpointResume(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	latest := ledger.CurrentState().CoreLatest
+	hq := tt.HorizonSession()
+	cq := tt.CoreSession()
+
+	count := func(sql string) (n int) {
+		tt.Require.NoError(hq.GetRaw(&n, sql))
+		return
+	}
+
+	checkpoint := func() (ledgers []int32) {
+		tt.Require.NoError(hq.SelectRaw(&ledgers, `
+			SELECT ledger FROM history_ingest_checkpoints
+			WHERE first_ledger = 1 AND last_ledger = ?
+		`, latest))
+		return
+	}
+
+	run := func() *Session {
+		sys := sys(tt)
+		sys.CheckpointEvery = 5
+		s := NewSession(sys)
+		s.Cursor = NewCursor(1, latest, sys)
+		s.ClearExisting = true
+		s.SkipCursorUpdate = true
+		s.Run()
+		return s
+	}
+
+	// crash the session at ledger 18 by hiding its header from the cursor
+	_, err := cq.ExecRaw(`UPDATE ledgerheaders SET ledgerseq = -18 WHERE ledgerseq = 18`)
+	tt.Require.NoError(err)
+
+	s := run()
+	tt.Require.Error(s.Err)
+	tt.Assert.Equal(17, count(`SELECT COUNT(*) FROM history_ledgers`))
+	tt.Assert.Equal([]int32{15}, checkpoint())
+
+	_, err = cq.ExecRaw(`UPDATE ledgerheaders SET ledgerseq = 18 WHERE ledgerseq = -18`)
+	tt.Require.NoError(err)
+
+	// the restarted session resumes after the checkpoint, reingesting the
+	// ledgers committed since
+	s = run()
+	tt.Require.NoError(s.Err)
+	tt.Assert.Equal(int(latest)-15, s.Ingested)
+	tt.Assert.Equal(int(latest), count(`SELECT COUNT(*) FROM history_ledgers`))
+	tt.Assert.Empty(checkpoint())
+
+	// once complete, the range is ingested in full again
+	s = run()
+	tt.Require.NoError(s.Err)
+	tt.Assert.Equal(int(latest), s.Ingested)
+}
@@ -0,0 +1,51 @@
+package ingest
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/services/horizon/internal/log"
+	"github.com/stellar/go/support/errors"
+)
+
+// ErrCommitIndeterminate is returned, wrapped, when a commit to the horizon db
+// failed and it could not be determined whether the ingestion's ledgers were
+// committed regardless.  Callers should check the ledgers in the history db
+// before reingesting them.  See Ingestion.VerifyFailedCommits.
+var ErrCommitIndeterminate = errors.New("ingest: commit outcome indeterminate")
+
+// verifyCommit checks whether the ledgers written by the transaction whose
+// commit failed with `commitErr` landed in the horizon db.  The check runs
+// over a new connection, as the failed transaction has been discarded.  A
+// ledger is considered to have landed when its row was created since the
+// transaction started, distinguishing it from the row it replaced when
+// reingesting.
+func (ingest *Ingestion) verifyCommit(commitErr error) error {
+	if len(ingest.txLedgers) == 0 {
+		return errors.Wrap(ErrCommitIndeterminate, commitErr.Error())
+	}
+
+	var landed int
+	err := ingest.DB.Get(&landed, sq.
+		Select("COUNT(*)").
+		From("history_ledgers").
+		Where(sq.Eq{"sequence": ingest.txLedgers}).
+		Where("created_at >= ?", ingest.txStarted),
+	)
+	if err != nil {
+		return errors.Wrapf(ErrCommitIndeterminate, "%s (verification failed: %s)", commitErr, err)
+	}
+
+	switch landed {
+	case len(ingest.txLedgers):
+		log.
+			WithField("ledgers", len(ingest.txLedgers)).
+			WithField("err", commitErr).
+			Warn("ingest: commit reported failure but ledgers landed")
+		return nil
+	case 0:
+		return errors.Wrap(commitErr, "commit failed, ledgers were not committed")
+	default:
+		return errors.Wrapf(ErrCommitIndeterminate,
+			"%s (%d of %d ledgers found)", commitErr, landed, len(ingest.txLedgers),
+		)
+	}
+}
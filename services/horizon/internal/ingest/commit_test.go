@@ -0,0 +1,41 @@
+package ingest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/support/errors"
+)
+
+func TestVerifyCommit(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	started := time.Now().UTC()
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	commitErr := errors.New("connection reset by peer")
+	ingestion := &Ingestion{DB: tt.HorizonSession(), txStarted: started}
+
+	// every ledger landed
+	ingestion.txLedgers = []int32{2, 3}
+	tt.Assert.NoError(ingestion.verifyCommit(commitErr))
+
+	// some ledgers are missing
+	ingestion.txLedgers = []int32{2, 99}
+	err := ingestion.verifyCommit(commitErr)
+	tt.Assert.Equal(ErrCommitIndeterminate, errors.Cause(err))
+
+	// no ledger was written since the transaction started
+	ingestion.txStarted = time.Now().UTC()
+	ingestion.txLedgers = []int32{2, 3}
+	err = ingestion.verifyCommit(commitErr)
+	tt.Assert.Equal(commitErr, errors.Cause(err))
+
+	// nothing to verify against
+	ingestion.txLedgers = nil
+	err = ingestion.verifyCommit(commitErr)
+	tt.Assert.Equal(ErrCommitIndeterminate, errors.Cause(err))
+}
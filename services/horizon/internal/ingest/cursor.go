@@ -3,9 +3,12 @@ package ingest
 import (
 	"time"
 
+	"github.com/guregu/null"
 	"github.com/stellar/go/meta"
 	"github.com/stellar/go/services/horizon/internal/db2/core"
-	"github.com/stellar/go/services/horizon/internal/toid"
+	"github.com/stellar/go/services/horizon/internal/ingest/participants"
+	"github.com/stellar/go/services/horizon/internal/log"
+	"github.com/stellar/go/support/db"
 	"github.com/stellar/go/xdr"
 )
 
@@ -54,21 +57,21 @@ func (c *Cursor) Ledger() *core.LedgerHeader {
 	return &c.data.Header
 }
 
+// Descending returns true if the cursor iterates from its newest ledger to
+// its oldest, that is when FirstLedger is greater than LastLedger.
+func (c *Cursor) Descending() bool {
+	return c.FirstLedger > c.LastLedger
+}
+
 // LedgerID returns the current ledger's id, as used by the history system.
 func (c *Cursor) LedgerID() int64 {
-	return toid.New(c.lg, 0, 0).ToInt64()
+	return c.ids().ID(c.lg, 0, 0)
 }
 
 // LedgerRange returns the beginning and end of id values that map to the
 // current ledger.  Useful for clearing a ledgers worth of data.
 func (c *Cursor) LedgerRange() (start int64, end int64) {
-	if c.lg == 1 {
-		start = 0
-	} else {
-		start = toid.New(c.lg, 0, 0).ToInt64()
-	}
-
-	return start, toid.New(c.lg+1, 0, 0).ToInt64()
+	return ledgerIDRange(c.ids(), c.lg, c.lg)
 }
 
 // LedgerSequence returns the current ledger's sequence
@@ -84,29 +87,161 @@ func (c *Cursor) NextLedger() bool {
 		return false
 	}
 
+	if c.bundles != nil {
+		return c.nextBundle()
+	}
+
+	if c.PrefetchDepth > 0 {
+		return c.nextPrefetched()
+	}
+
 	if !c.incrementLg() {
 		return false
 	}
 
-	c.data = &LedgerBundle{Sequence: c.lg}
+	bundle, err := c.loadLedger(c.DB, c.lg)
+	if err != nil {
+		c.Err = err
+		return false
+	}
+
+	c.data = bundle
+	c.tx = -1
+	c.op = -1
+
+	return true
+}
+
+// Close stops the loading of prefetched ledgers, if any.  It must be called
+// when abandoning an iteration before it completes.
+func (c *Cursor) Close() {
+	if c.stopPrefetch == nil {
+		return
+	}
+
+	close(c.stopPrefetch)
+	c.stopPrefetch = nil
+	c.prefetch = nil
+}
+
+// loadLedger loads the bundle for the ledger `seq` from `db`, retrying failed
+// loads up to `c.LoadRetries` times.
+func (c *Cursor) loadLedger(db *db.Session, seq int32) (*LedgerBundle, error) {
 	start := time.Now()
-	c.Err = c.data.Load(c.DB)
-	if c.Err != nil {
+	backoff := c.LoadRetryBackoff
+
+	for attempt := 0; ; attempt++ {
+		bundle := &LedgerBundle{Sequence: seq, XDRErrorPolicy: c.XDRErrorPolicy}
+		err := c.loadBundle(bundle, db)
+		if err == nil {
+			if c.Metrics != nil {
+				c.Metrics.LoadLedgerTimer.Update(time.Since(start))
+			}
+			return bundle, nil
+		}
+
+		if attempt >= c.LoadRetries {
+			return nil, err
+		}
+
+		log.
+			WithField("ledger", seq).
+			WithField("attempt", attempt+1).
+			WithField("err", err).
+			Warn("ingest: ledger load failed, retrying")
+
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// loadBundle loads `bundle` from `db`.
+func (c *Cursor) loadBundle(bundle *LedgerBundle, db *db.Session) error {
+	if c.load != nil {
+		return c.load(bundle, db)
+	}
+	return bundle.Load(db)
+}
+
+// prefetchedLedger is the result of loading a ledger in the background.
+type prefetchedLedger struct {
+	bundle *LedgerBundle
+	err    error
+}
+
+// nextPrefetched advances `c` to the next ledger loaded by its prefetching
+// goroutine, starting the goroutine if needed.
+func (c *Cursor) nextPrefetched() bool {
+	if c.prefetch == nil {
+		c.startPrefetch()
+	}
+
+	result, ok := <-c.prefetch
+	if !ok {
+		c.Close()
+		c.data = nil
+		c.lg = 0
 		return false
 	}
 
-	if c.Metrics != nil {
-		c.Metrics.LoadLedgerTimer.Update(time.Since(start))
+	if result.err != nil {
+		c.Close()
+		c.Err = result.err
+		return false
 	}
 
+	c.data = result.bundle
+	c.lg = c.data.Sequence
 	c.tx = -1
 	c.op = -1
 
 	return true
 }
 
+// startPrefetch starts a goroutine that loads the cursor's ledgers in order,
+// staying up to PrefetchDepth ledgers ahead of the iteration.  The goroutine
+// stops after the last ledger, after a failed load, or when the cursor is
+// closed.  It loads ledgers through a clone of DB, so as not to share the
+// cursor's connection state.
+func (c *Cursor) startPrefetch() {
+	results := make(chan prefetchedLedger, c.PrefetchDepth)
+	stop := make(chan struct{})
+	c.prefetch = results
+	c.stopPrefetch = stop
+
+	coreDB := c.DB.Clone()
+	first, last := c.FirstLedger, c.LastLedger
+	increment := int32(1)
+	if c.Descending() {
+		increment = -1
+	}
+
+	go func() {
+		defer close(results)
+
+		for seq := first; (increment > 0 && seq <= last) || (increment < 0 && seq >= last); seq += increment {
+			bundle, err := c.loadLedger(coreDB, seq)
+
+			select {
+			case results <- prefetchedLedger{bundle: bundle, err: err}:
+			case <-stop:
+				return
+			}
+
+			if err != nil {
+				return
+			}
+		}
+	}()
+}
+
+// ids returns the id scheme used by the cursor.
+func (c *Cursor) ids() IDScheme {
+	return idSchemeOrDefault(c.IDScheme)
+}
+
 func (c *Cursor) incrementLg() bool {
-	isReverse := c.FirstLedger > c.LastLedger
+	isReverse := c.Descending()
 
 	if c.lg == 0 {
 		c.lg = c.FirstLedger
@@ -131,6 +266,23 @@ func (c *Cursor) incrementLg() bool {
 	return true
 }
 
+// nextBundle advances `c` to the next of its in-memory bundles.
+func (c *Cursor) nextBundle() bool {
+	if c.bundleIdx >= len(c.bundles) {
+		c.data = nil
+		c.lg = 0
+		return false
+	}
+
+	c.data = &c.bundles[c.bundleIdx]
+	c.lg = c.data.Sequence
+	c.bundleIdx++
+	c.tx = -1
+	c.op = -1
+
+	return true
+}
+
 // NextOp advances `c` to the next operation in the current transaction.  Returns
 // false if the current transaction has nothing left to visit.
 func (c *Cursor) NextOp() bool {
@@ -147,9 +299,18 @@ func (c *Cursor) NextTx() bool {
 	if c.Err != nil {
 		return false
 	}
-	c.tx++
-	c.op = -1
-	return c.tx < len(c.data.Transactions)
+	for {
+		c.tx++
+		c.op = -1
+
+		if c.tx >= len(c.data.Transactions) {
+			return false
+		}
+
+		if !c.data.isSkipped(c.tx) {
+			return true
+		}
+	}
 }
 
 // Operation returns the current operation
@@ -170,7 +331,7 @@ func (c *Cursor) OperationCount() int {
 
 // OperationID returns the current operations id, as used by the history system.
 func (c *Cursor) OperationID() int64 {
-	return toid.New(c.lg, int32(c.tx+1), int32(c.op+1)).ToInt64()
+	return c.ids().ID(c.lg, int32(c.tx+1), int32(c.op+1))
 }
 
 // OperationOrder returns the order of the current operation amongst the
@@ -186,15 +347,53 @@ func (c *Cursor) OperationResult() *xdr.OperationResultTr {
 	return &tr
 }
 
+// OperationResultCode returns the result code of the current operation,
+// specific to its type, such as a PaymentResultCode.  Unlike
+// OperationSuccessful, it reflects the outcome of the operation itself, even
+// within a failed transaction.  The code is null when the operation has no
+// result of its own, such as when the transaction failed before its
+// operations were applied or the operation's source account was missing.
+func (c *Cursor) OperationResultCode() null.Int {
+	results, ok := c.Transaction().Result.Result.Result.GetResults()
+	if !ok || c.op >= len(results) {
+		return null.Int{}
+	}
+
+	result := results[c.op]
+	if result.Code != xdr.OperationResultCodeOpInner {
+		return null.Int{}
+	}
+
+	code, ok := operationResultCode(result.MustTr())
+	return null.NewInt(int64(code), ok)
+}
+
+// OperationSuccessful returns true if the current operation was successfully
+// applied.  Operations of a failed transaction are never considered
+// successful.
+func (c *Cursor) OperationSuccessful() bool {
+	tx := c.Transaction()
+	if !tx.IsSuccessful() {
+		return false
+	}
+
+	results, ok := tx.Result.Result.Result.GetResults()
+	if !ok || c.op >= len(results) {
+		return false
+	}
+
+	result := results[c.op]
+	if result.Code != xdr.OperationResultCodeOpInner {
+		return false
+	}
+
+	return operationResultSuccessful(result.MustTr())
+}
+
 // OperationSourceAccount returns the current operation's effective source
 // account (i.e. default's to the transaction's source account).
 func (c *Cursor) OperationSourceAccount() xdr.AccountId {
-	aid := c.Operation().SourceAccount
-	if aid != nil {
-		return *aid
-	}
-
-	return c.TransactionSourceAccount()
+	return participants.OperationSource(&c.Transaction().Envelope.Tx, c.Operation())
 }
 
 // OperationType returns the current operation type
@@ -227,10 +426,16 @@ func (c *Cursor) TransactionMetaBundle() *meta.Bundle {
 	}
 }
 
+// SkippedTransactions returns the transactions of the current ledger that are
+// skipped because their xdr could not be decoded.
+func (c *Cursor) SkippedTransactions() []SkippedTransaction {
+	return c.data.Skipped
+}
+
 // SuccessfulLedgerOperationCount returns the count of operations in the current ledger
 func (c *Cursor) SuccessfulLedgerOperationCount() (ret int) {
 	for i := range c.data.Transactions {
-		if !c.data.Transactions[i].IsSuccessful() {
+		if c.data.isSkipped(i) || !c.data.Transactions[i].IsSuccessful() {
 			continue
 		}
 		ret += len(c.data.Transactions[i].Envelope.Tx.Operations)
@@ -242,20 +447,89 @@ func (c *Cursor) SuccessfulLedgerOperationCount() (ret int) {
 // ledger that succeeded.
 func (c *Cursor) SuccessfulTransactionCount() (ret int) {
 	for i := range c.data.Transactions {
-		if c.data.Transactions[i].IsSuccessful() {
+		if !c.data.isSkipped(i) && c.data.Transactions[i].IsSuccessful() {
 			ret++
 		}
 	}
 	return
 }
 
+// TrustlinesChanged returns the count of trustline entries created, updated or
+// removed by the operations of the transactions in the current ledger that
+// succeeded.
+func (c *Cursor) TrustlinesChanged() (ret int) {
+	for i := range c.data.Transactions {
+		if c.data.isSkipped(i) || !c.data.Transactions[i].IsSuccessful() {
+			continue
+		}
+
+		for _, op := range c.data.Transactions[i].ResultMeta.MustOperations() {
+			for _, change := range op.Changes {
+				if change.Type == xdr.LedgerEntryChangeTypeLedgerEntryState {
+					continue
+				}
+
+				if change.LedgerKey().Type == xdr.LedgerEntryTypeTrustline {
+					ret++
+				}
+			}
+		}
+	}
+	return
+}
+
+// TransactionCount returns the count of transactions in the current ledger,
+// including those that failed or were skipped.
+func (c *Cursor) TransactionCount() int {
+	return len(c.data.Transactions)
+}
+
 // TransactionID returns the current tranaction's id, as used by the history
 // system.
 func (c *Cursor) TransactionID() int64 {
-	return toid.New(c.lg, int32(c.tx+1), 0).ToInt64()
+	return c.ids().ID(c.lg, int32(c.tx+1), 0)
 }
 
 // TransactionSourceAccount returns the current transaction's source account id
 func (c *Cursor) TransactionSourceAccount() xdr.AccountId {
 	return c.Transaction().Envelope.Tx.SourceAccount
 }
+
+// operationResultSuccessful returns true if the result code of `tr` is the
+// success variant for its operation type.
+func operationResultSuccessful(tr xdr.OperationResultTr) bool {
+	code, ok := operationResultCode(tr)
+	return ok && code == 0
+}
+
+// operationResultCode returns the result code of `tr`, specific to its
+// operation type.  The success variant of every type is zero.  ok is false if
+// the type is unknown.
+func operationResultCode(tr xdr.OperationResultTr) (code int32, ok bool) {
+	switch tr.Type {
+	case xdr.OperationTypeCreateAccount:
+		return int32(tr.MustCreateAccountResult().Code), true
+	case xdr.OperationTypePayment:
+		return int32(tr.MustPaymentResult().Code), true
+	case xdr.OperationTypePathPayment:
+		return int32(tr.MustPathPaymentResult().Code), true
+	case xdr.OperationTypeManageOffer:
+		return int32(tr.MustManageOfferResult().Code), true
+	case xdr.OperationTypeCreatePassiveOffer:
+		return int32(tr.MustCreatePassiveOfferResult().Code), true
+	case xdr.OperationTypeSetOptions:
+		return int32(tr.MustSetOptionsResult().Code), true
+	case xdr.OperationTypeChangeTrust:
+		return int32(tr.MustChangeTrustResult().Code), true
+	case xdr.OperationTypeAllowTrust:
+		return int32(tr.MustAllowTrustResult().Code), true
+	case xdr.OperationTypeAccountMerge:
+		return int32(tr.MustAccountMergeResult().Code), true
+	case xdr.OperationTypeInflation:
+		return int32(tr.MustInflationResult().Code), true
+	case xdr.OperationTypeManageData:
+		return int32(tr.MustManageDataResult().Code), true
+	default:
+		return 0, false
+	}
+}
@@ -3,7 +3,13 @@ package ingest
 import (
 	"testing"
 
+	"github.com/stellar/go/services/horizon/internal/db2/core"
+	"github.com/stellar/go/services/horizon/internal/ledger"
 	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCursor(t *testing.T) {
@@ -63,6 +69,7 @@ func TestCursor(t *testing.T) {
 		LastLedger:  7,
 		DB:          tt.CoreSession(),
 	}
+	tt.Require.True(c.Descending())
 
 	tt.Require.True(c.NextLedger())
 	tt.Require.Equal(uint32(10), c.Ledger().Sequence)
@@ -76,3 +83,168 @@ func TestCursor(t *testing.T) {
 	tt.Require.False(c.NextLedger())
 
 }
+
+func TestOperationResultSuccessful(t *testing.T) {
+	success := xdr.OperationResultTr{
+		Type:          xdr.OperationTypePayment,
+		PaymentResult: &xdr.PaymentResult{Code: xdr.PaymentResultCodePaymentSuccess},
+	}
+	assert.True(t, operationResultSuccessful(success))
+
+	failure := xdr.OperationResultTr{
+		Type:          xdr.OperationTypePayment,
+		PaymentResult: &xdr.PaymentResult{Code: xdr.PaymentResultCodePaymentUnderfunded},
+	}
+	assert.False(t, operationResultSuccessful(failure))
+}
+
+func TestCursor_Prefetch(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	sequences := func(c *Cursor) (seqs []uint32) {
+		for c.NextLedger() {
+			seqs = append(seqs, c.Ledger().Sequence)
+		}
+		tt.Require.NoError(c.Err)
+		return
+	}
+
+	c := &Cursor{FirstLedger: 3, LastLedger: 10, DB: tt.CoreSession(), PrefetchDepth: 2}
+	tt.Assert.Equal([]uint32{3, 4, 5, 6, 7, 8, 9, 10}, sequences(c))
+
+	// the cursor can be iterated again once complete
+	tt.Assert.Equal([]uint32{3, 4, 5, 6, 7, 8, 9, 10}, sequences(c))
+
+	c = &Cursor{FirstLedger: 10, LastLedger: 7, DB: tt.CoreSession(), PrefetchDepth: 3}
+	tt.Assert.Equal([]uint32{10, 9, 8, 7}, sequences(c))
+
+	// prefetched ledgers are visited like loaded ones
+	c = &Cursor{FirstLedger: 8, LastLedger: 8, DB: tt.CoreSession(), PrefetchDepth: 1}
+	tt.Require.True(c.NextLedger())
+	txs := 0
+	for c.NextTx() {
+		txs++
+	}
+	tt.Assert.Equal(4, txs)
+	tt.Require.False(c.NextLedger())
+
+	// closing an unfinished iteration stops the prefetching
+	c = &Cursor{FirstLedger: 3, LastLedger: 10, DB: tt.CoreSession(), PrefetchDepth: 1}
+	tt.Require.True(c.NextLedger())
+	c.Close()
+	tt.Assert.Nil(c.prefetch)
+
+	// a failed load ends the iteration with an error
+	c = &Cursor{FirstLedger: 9, LastLedger: 12, DB: tt.CoreSession(), PrefetchDepth: 2}
+	_, err := tt.CoreSession().ExecRaw(`UPDATE txhistory SET txbody = 'AAAA' WHERE ledgerseq = 10`)
+	tt.Require.NoError(err)
+	tt.Require.True(c.NextLedger())
+	tt.Require.False(c.NextLedger())
+	tt.Assert.Error(c.Err)
+}
+
+// failingLoad returns a Cursor.load failing the first `n` attempts to load
+// every ledger, along with the number of attempts made per ledger.
+func failingLoad(n int) (func(*LedgerBundle, *db.Session) error, map[int32]int) {
+	attempts := map[int32]int{}
+	return func(bundle *LedgerBundle, db *db.Session) error {
+		attempts[bundle.Sequence]++
+		if attempts[bundle.Sequence] <= n {
+			return errors.New("transient failure")
+		}
+		return bundle.Load(db)
+	}, attempts
+}
+
+func TestCursor_LoadRetries(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	// transient failures are retried transparently
+	load, attempts := failingLoad(2)
+	c := &Cursor{FirstLedger: 3, LastLedger: 5, DB: tt.CoreSession(), LoadRetries: 2, load: load}
+	var seqs []uint32
+	for c.NextLedger() {
+		seqs = append(seqs, c.Ledger().Sequence)
+	}
+	tt.Require.NoError(c.Err)
+	tt.Assert.Equal([]uint32{3, 4, 5}, seqs)
+	tt.Assert.Equal(map[int32]int{3: 3, 4: 3, 5: 3}, attempts)
+
+	// the error is only reported once the retries are exhausted
+	load, attempts = failingLoad(3)
+	c = &Cursor{FirstLedger: 3, LastLedger: 5, DB: tt.CoreSession(), LoadRetries: 2, load: load}
+	tt.Assert.False(c.NextLedger())
+	tt.Assert.EqualError(c.Err, "transient failure")
+	tt.Assert.Equal(map[int32]int{3: 3}, attempts)
+
+	// without retries, the first failure is reported
+	load, attempts = failingLoad(1)
+	c = &Cursor{FirstLedger: 3, LastLedger: 5, DB: tt.CoreSession(), load: load}
+	tt.Assert.False(c.NextLedger())
+	tt.Assert.Error(c.Err)
+	tt.Assert.Equal(map[int32]int{3: 1}, attempts)
+}
+
+func TestSession_LoadRetries(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	sys := sys(tt)
+	sys.LoadRetries = 1
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	tt.Assert.Equal(1, s.Cursor.LoadRetries)
+
+	// a session driven by the system recovers from transient failures
+	load, attempts := failingLoad(1)
+	s.Cursor.load = load
+	s.Run()
+	tt.Require.NoError(s.Err)
+	tt.Assert.Equal(int(ledger.CurrentState().CoreLatest), s.Ingested)
+	tt.Assert.Equal(2, attempts[ledger.CurrentState().CoreLatest])
+}
+
+func TestCursor_OperationResultCode(t *testing.T) {
+	// a failed transaction whose first payment succeeded and whose second was
+	// underfunded, followed by one that failed before applying its operation
+	payment := func(code xdr.PaymentResultCode) xdr.OperationResult {
+		return xdr.OperationResult{
+			Code: xdr.OperationResultCodeOpInner,
+			Tr: &xdr.OperationResultTr{
+				Type:          xdr.OperationTypePayment,
+				PaymentResult: &xdr.PaymentResult{Code: code},
+			},
+		}
+	}
+	results := []xdr.OperationResult{
+		payment(xdr.PaymentResultCodePaymentSuccess),
+		payment(xdr.PaymentResultCodePaymentUnderfunded),
+	}
+
+	var failed, badSeq core.Transaction
+	failed.Result.Result.Result = xdr.TransactionResultResult{
+		Code:    xdr.TransactionResultCodeTxFailed,
+		Results: &results,
+	}
+	badSeq.Result.Result.Result = xdr.TransactionResultResult{
+		Code: xdr.TransactionResultCodeTxBadSeq,
+	}
+
+	c := &Cursor{data: &LedgerBundle{
+		Transactions: []core.Transaction{failed, badSeq},
+	}}
+
+	c.tx, c.op = 0, 0
+	assert.Equal(t, int64(xdr.PaymentResultCodePaymentSuccess), c.OperationResultCode().Int64)
+	assert.True(t, c.OperationResultCode().Valid)
+	assert.False(t, c.OperationSuccessful())
+
+	c.op = 1
+	assert.Equal(t, int64(xdr.PaymentResultCodePaymentUnderfunded), c.OperationResultCode().Int64)
+	assert.True(t, c.OperationResultCode().Valid)
+
+	c.tx, c.op = 1, 0
+	assert.False(t, c.OperationResultCode().Valid)
+}
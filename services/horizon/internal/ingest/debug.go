@@ -0,0 +1,177 @@
+package ingest
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// IngestDebugState is a snapshot of what the live ingestion is doing, as
+// returned by System.DebugState for display by an operator.
+type IngestDebugState struct {
+	// Running is true while a live session is ingesting.  Ledger,
+	// PendingRows, Paused and FlushDuration are only set when it is.
+	Running bool
+
+	// Ledger is the sequence of the ledger being ingested.
+	Ledger int32
+
+	// PendingRows is the number of rows inserted into each table by the
+	// current transaction, yet to be committed.
+	PendingRows map[string]int
+
+	// Paused is true while the session waits for the replication lag of the
+	// horizon db to recover.  See Session.ReplicationLag.
+	Paused bool
+
+	// FlushDuration is how long the commit in progress has been running, or
+	// zero if none is.
+	FlushDuration time.Duration
+
+	// LastCommit is when the live ingestion last committed, or zero if it
+	// has not since the system started.
+	LastCommit time.Time
+
+	// LastError is the error of the most recent live session, or empty if
+	// it succeeded.
+	LastError string
+}
+
+// DebugState returns a snapshot of the live ingestion's state.  It never
+// waits on the database, only briefly on the locks guarding the state, so it
+// is safe to call while ingestion is stuck.
+func (i *System) DebugState() IngestDebugState {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	state := IngestDebugState{LastCommit: i.lastCommit}
+	if i.lastErr != nil {
+		state.LastError = i.lastErr.Error()
+	}
+
+	if i.current != nil {
+		state.Running = true
+		i.current.Ingestion.debug.snapshot(&state)
+	}
+
+	return state
+}
+
+// debugState is the state of an ingestion reported by System.DebugState.  It
+// is updated by the goroutine running the ingestion and read by others, so
+// it is guarded by its own lock.
+type debugState struct {
+	lock         sync.Mutex
+	ledger       int32
+	paused       bool
+	pending      map[string]int
+	flushStarted time.Time
+	lastCommit   time.Time
+}
+
+func (d *debugState) setLedger(seq int32) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.ledger = seq
+}
+
+func (d *debugState) setPaused(paused bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.paused = paused
+}
+
+// addPending records the execution of the statement `query`, taking `args`
+// arguments.  Insert statements add the rows they insert to the pending rows
+// of their table.
+func (d *debugState) addPending(query string, args int) {
+	table, rows, ok := insertRows(query, args)
+	if !ok {
+		return
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.pending == nil {
+		d.pending = map[string]int{}
+	}
+	d.pending[table] += rows
+}
+
+// resetPending discards the pending rows, when a transaction begins or is
+// rolled back.
+func (d *debugState) resetPending() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.pending = nil
+}
+
+func (d *debugState) startFlush() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.flushStarted = time.Now()
+}
+
+// endFlush records the end of the commit started by startFlush, and whether
+// it succeeded.
+func (d *debugState) endFlush(committed bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.flushStarted = time.Time{}
+	if committed {
+		d.lastCommit = time.Now()
+		d.pending = nil
+	}
+}
+
+// snapshot copies the state into `dest`.
+func (d *debugState) snapshot(dest *IngestDebugState) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	dest.Ledger = d.ledger
+	dest.Paused = d.paused
+
+	dest.PendingRows = make(map[string]int, len(d.pending))
+	for table, rows := range d.pending {
+		dest.PendingRows[table] = rows
+	}
+
+	if !d.flushStarted.IsZero() {
+		dest.FlushDuration = time.Since(d.flushStarted)
+	}
+
+	if d.lastCommit.After(dest.LastCommit) {
+		dest.LastCommit = d.lastCommit
+	}
+}
+
+// insertRows returns the table and number of rows of the multi row insert
+// `query`, taking `args` arguments, as built by squirrel.  ok is false when
+// the query is not such an insert.
+func insertRows(query string, args int) (table string, rows int, ok bool) {
+	const prefix = "INSERT INTO "
+	if !strings.HasPrefix(query, prefix) {
+		return "", 0, false
+	}
+
+	rest := query[len(prefix):]
+	open := strings.Index(rest, " (")
+	if open < 0 {
+		return "", 0, false
+	}
+	table = rest[:open]
+
+	end := strings.Index(rest, ")")
+	if end < open {
+		return "", 0, false
+	}
+	columns := strings.Count(rest[open:end], ",") + 1
+
+	rows = args / columns
+	if rows == 0 {
+		rows = 1
+	}
+
+	return table, rows, true
+}
@@ -0,0 +1,69 @@
+package ingest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertRows(t *testing.T) {
+	query, args, err := sq.Insert("history_effects").
+		Columns("history_account_id", "history_operation_id", `"order"`).
+		Values(1, 2, 3).
+		Values(4, 5, 6).
+		ToSql()
+	require.NoError(t, err)
+
+	table, rows, ok := insertRows(query, len(args))
+	assert.True(t, ok)
+	assert.Equal(t, "history_effects", table)
+	assert.Equal(t, 2, rows)
+
+	_, _, ok = insertRows("UPDATE history_ledgers SET partial = false", 0)
+	assert.False(t, ok)
+}
+
+func TestDebugState(t *testing.T) {
+	sys := &System{}
+
+	state := sys.DebugState()
+	assert.False(t, state.Running)
+	assert.Empty(t, state.LastError)
+
+	is := &Session{Ingestion: &Ingestion{}}
+	sys.SetCurrentSession(is)
+	sys.lastErr = errors.New("boom")
+
+	d := &is.Ingestion.debug
+	d.setLedger(10)
+	d.setPaused(true)
+	d.addPending(`INSERT INTO history_operations (id,type) VALUES (?,?),(?,?)`, 4)
+	d.addPending(`INSERT INTO history_operations (id,type) VALUES (?,?)`, 2)
+	d.startFlush()
+
+	state = sys.DebugState()
+	assert.True(t, state.Running)
+	assert.Equal(t, int32(10), state.Ledger)
+	assert.True(t, state.Paused)
+	assert.Equal(t, map[string]int{"history_operations": 3}, state.PendingRows)
+	assert.NotZero(t, state.FlushDuration)
+	assert.True(t, state.LastCommit.IsZero())
+	assert.Equal(t, "boom", state.LastError)
+
+	// a failed commit leaves the rows pending
+	d.endFlush(false)
+	state = sys.DebugState()
+	assert.Zero(t, state.FlushDuration)
+	assert.Equal(t, 3, state.PendingRows["history_operations"])
+
+	before := time.Now()
+	d.startFlush()
+	d.endFlush(true)
+	state = sys.DebugState()
+	assert.Empty(t, state.PendingRows)
+	assert.False(t, state.LastCommit.Before(before))
+}
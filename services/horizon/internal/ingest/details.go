@@ -0,0 +1,111 @@
+package ingest
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/stellar/go/services/horizon/internal/log"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// TableName is the name of a history table whose rows carry details.
+type TableName string
+
+const (
+	// OperationsTable is the table of operation details.
+	OperationsTable TableName = "history_operations"
+
+	// EffectsTable is the table of effect details.
+	EffectsTable TableName = "history_effects"
+)
+
+// marshalDetails encodes `details` as json suitable for a details column.  The
+// output is canonical: object keys are sorted at every level of nesting,
+// regardless of whether the value was built from maps or structs, so that
+// logically equal details always produce identical bytes.
+func marshalDetails(details interface{}) ([]byte, error) {
+	raw, err := json.Marshal(details)
+	if err != nil {
+		return nil, err
+	}
+
+	// round trip through a generic value, which encoding/json always encodes
+	// with sorted keys.  Numbers are kept as json.Number to preserve their
+	// exact representation.
+	var generic interface{}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	err = dec.Decode(&generic)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(generic)
+}
+
+// whitelistDetails returns the keys of `details`, the details of an operation
+// of type `typ`, that are in the ingestion's DetailsWhitelist for the type, or
+// `details` itself when the type has no whitelist.
+func (ingest *Ingestion) whitelistDetails(
+	typ xdr.OperationType,
+	details map[string]interface{},
+) map[string]interface{} {
+	keys, ok := ingest.DetailsWhitelist[typ]
+	if !ok {
+		return details
+	}
+
+	whitelisted := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		if value, ok := details[key]; ok {
+			whitelisted[key] = value
+		}
+	}
+	return whitelisted
+}
+
+// detailsSize records the size of a details blob written for the operation
+// identified by `opid`, counting and logging the blob when it exceeds the
+// ingestion's LargeDetailsThreshold.
+func (ingest *Ingestion) detailsSize(opid int64, size int) {
+	if ingest.Metrics != nil && ingest.Metrics.DetailsSizeHistogram != nil {
+		ingest.Metrics.DetailsSizeHistogram.Update(int64(size))
+	}
+
+	if ingest.LargeDetailsThreshold <= 0 || size <= ingest.LargeDetailsThreshold {
+		return
+	}
+
+	if ingest.Metrics != nil && ingest.Metrics.LargeDetailsCounter != nil {
+		ingest.Metrics.LargeDetailsCounter.Inc(1)
+	}
+
+	log.WithField("operation_id", opid).WithField("size", size).Warn("ingest: large details blob")
+}
+
+// filterDetails applies the ingestion's DetailFieldFilter to `raw`, the
+// details of a row of `table` as encoded by marshalDetails, returning the
+// details to store.  `raw` is decoded afresh, rather than the filter being
+// handed the caller's details, since the details of effects may be shared by
+// several rows.
+func (ingest *Ingestion) filterDetails(table TableName, raw []byte) ([]byte, error) {
+	if ingest.DetailFieldFilter == nil {
+		return raw, nil
+	}
+
+	var details map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	err := dec.Decode(&details)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode details")
+	}
+
+	details = ingest.DetailFieldFilter(table, details)
+	if details == nil {
+		details = map[string]interface{}{}
+	}
+
+	return marshalDetails(details)
+}
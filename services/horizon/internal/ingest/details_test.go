@@ -0,0 +1,179 @@
+package ingest
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"hash"
+	"testing"
+
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalDetails(t *testing.T) {
+	type nested struct {
+		Zeta  string `json:"zeta"`
+		Alpha int64  `json:"alpha"`
+	}
+
+	fromStruct, err := marshalDetails(map[string]interface{}{
+		"b": nested{Zeta: "z", Alpha: 9007199254740993},
+		"a": []interface{}{nested{Zeta: "y", Alpha: 1}},
+	})
+	assert.NoError(t, err)
+
+	fromMap, err := marshalDetails(map[string]interface{}{
+		"a": []interface{}{map[string]interface{}{"alpha": 1, "zeta": "y"}},
+		"b": map[string]interface{}{"zeta": "z", "alpha": int64(9007199254740993)},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, fromMap, fromStruct)
+	assert.Equal(t, `{"a":[{"alpha":1,"zeta":"y"}],"b":{"alpha":9007199254740993,"zeta":"z"}}`, string(fromStruct))
+}
+
+// detailsHashSink is a Sink hashing the details of the operations and effects
+// written to it, in order.  Other rows are discarded.
+type detailsHashSink struct {
+	hash hash.Hash
+}
+
+func (s *detailsHashSink) Ledger(row SinkLedger) error           { return nil }
+func (s *detailsHashSink) Transaction(row SinkTransaction) error { return nil }
+func (s *detailsHashSink) Trade(row SinkTrade) error             { return nil }
+func (s *detailsHashSink) Commit() error                         { return nil }
+func (s *detailsHashSink) Rollback() error                       { return nil }
+
+func (s *detailsHashSink) Operation(row SinkOperation) error {
+	s.hash.Write(row.Details)
+	return nil
+}
+
+func (s *detailsHashSink) Effect(row SinkEffect) error {
+	s.hash.Write(row.Details)
+	return nil
+}
+
+func TestIngest_DetailsReproducible(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	run := func() []byte {
+		sink := &detailsHashSink{hash: sha256.New()}
+		sys := sys(tt)
+		sys.Sink = sink
+		s := NewSession(sys)
+		s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+		s.ClearExisting = true
+		s.Run()
+		tt.Require.NoError(s.Err)
+		return sink.hash.Sum(nil)
+	}
+
+	// reingesting produces byte identical details
+	tt.Assert.Equal(run(), run())
+}
+
+func TestDetailsSize(t *testing.T) {
+	m := &IngesterMetrics{
+		DetailsSizeHistogram: metrics.NewHistogram(metrics.NewUniformSample(100)),
+		LargeDetailsCounter:  metrics.NewCounter(),
+	}
+	ingestion := &Ingestion{Metrics: m, IngestionOptions: IngestionOptions{LargeDetailsThreshold: 100}}
+
+	ingestion.detailsSize(1, 10)
+	ingestion.detailsSize(1, 100)
+	ingestion.detailsSize(1, 101)
+
+	assert.Equal(t, int64(3), m.DetailsSizeHistogram.Count())
+	assert.Equal(t, int64(101), m.DetailsSizeHistogram.Max())
+	assert.Equal(t, int64(1), m.LargeDetailsCounter.Count())
+
+	// a zero threshold disables the check
+	ingestion.LargeDetailsThreshold = 0
+	ingestion.detailsSize(1, 1000)
+	assert.Equal(t, int64(1), m.LargeDetailsCounter.Count())
+
+	// metrics are optional
+	ingestion = &Ingestion{IngestionOptions: IngestionOptions{LargeDetailsThreshold: 1}}
+	ingestion.detailsSize(1, 10)
+}
+
+func TestIngest_DetailFieldFilter(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	var tables []TableName
+	sys := sys(tt)
+	sys.DetailFieldFilter = func(table TableName, details map[string]interface{}) map[string]interface{} {
+		tables = append(tables, table)
+		delete(details, "amount")
+		return details
+	}
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+	tt.Assert.Contains(tables, OperationsTable)
+	tt.Assert.Contains(tables, EffectsTable)
+
+	count := func(query string) int {
+		var n int
+		tt.Require.NoError(tt.HorizonSession().GetRaw(&n, query))
+		return n
+	}
+
+	// the filtered field is absent from every stored details blob
+	tt.Assert.Equal(0, count(`SELECT COUNT(*) FROM history_operations WHERE details->>'amount' IS NOT NULL`))
+	tt.Assert.Equal(0, count(`SELECT COUNT(*) FROM history_effects WHERE details->>'amount' IS NOT NULL`))
+
+	// while the rest remain
+	tt.Assert.NotZero(count(`SELECT COUNT(*) FROM history_operations WHERE type = 1 AND details->>'to' IS NOT NULL`))
+	tt.Assert.NotZero(count(`SELECT COUNT(*) FROM history_effects WHERE type = 2 AND details->>'asset_type' IS NOT NULL`))
+}
+
+func TestIngest_DetailsWhitelist(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	sys := sys(tt)
+	sys.DetailsWhitelist = map[xdr.OperationType][]string{
+		xdr.OperationTypePayment: {"amount", "to", "missing"},
+	}
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	operationDetails := func(typ xdr.OperationType) []map[string]interface{} {
+		var rows []string
+		tt.Require.NoError(tt.HorizonSession().SelectRaw(&rows,
+			`SELECT details FROM history_operations WHERE type = ?`, typ))
+		tt.Require.NotEmpty(rows)
+
+		details := make([]map[string]interface{}, len(rows))
+		for i, row := range rows {
+			tt.Require.NoError(json.Unmarshal([]byte(row), &details[i]))
+		}
+		return details
+	}
+
+	// only the whitelisted keys persist
+	for _, details := range operationDetails(xdr.OperationTypePayment) {
+		tt.Assert.Len(details, 2)
+		tt.Assert.Contains(details, "amount")
+		tt.Assert.Contains(details, "to")
+	}
+
+	// types without a whitelist are untouched
+	for _, details := range operationDetails(xdr.OperationTypeCreateAccount) {
+		tt.Assert.Contains(details, "starting_balance")
+		tt.Assert.Contains(details, "funder")
+		tt.Assert.Contains(details, "account")
+	}
+}
@@ -1,15 +1,43 @@
 package ingest
 
 import (
+	"encoding/json"
+
 	"github.com/stellar/go/services/horizon/internal/db2/history"
 	"github.com/stellar/go/xdr"
 )
 
+// Effects returns an EffectIngestion that writes the effects of the operation
+// `opid` into `ingest`, assigning each effect added the next order within the
+// operation, starting at 1.  Callers needing control over the order of an
+// effect should use Ingestion.Effect instead.
+func (ingest *Ingestion) Effects(opid int64) *EffectIngestion {
+	return &EffectIngestion{
+		Dest:        ingest,
+		OperationID: opid,
+		parent:      ingest,
+	}
+}
+
 // Add writes an effect to the database while automatically tracking the index
 // to use.
 func (ei *EffectIngestion) Add(aid xdr.AccountId, typ history.EffectType, details interface{}) bool {
-	q := history.Q{Session: ei.parent.DB}
+	if ei.err != nil {
+		return false
+	}
+
+	var raw []byte
+	raw, ei.err = marshalDetails(details)
+	if ei.err != nil {
+		return false
+	}
+
+	return ei.AddRaw(aid, typ, raw)
+}
 
+// AddRaw is like Add, but writes details that have already been marshaled with
+// marshalDetails.
+func (ei *EffectIngestion) AddRaw(aid xdr.AccountId, typ history.EffectType, raw json.RawMessage) bool {
 	if ei.err != nil {
 		return false
 	}
@@ -17,12 +45,12 @@ func (ei *EffectIngestion) Add(aid xdr.AccountId, typ history.EffectType, detail
 	ei.added++
 	var haid int64
 
-	haid, ei.err = q.GetCreateAccountID(aid)
+	haid, ei.err = ei.parent.getCreateAccountID(aid)
 	if ei.err != nil {
 		return false
 	}
 
-	ei.err = ei.Dest.Effect(haid, ei.OperationID, ei.added, typ, details)
+	ei.err = ei.Dest.EffectRaw(haid, ei.OperationID, ei.added, typ, raw)
 	if ei.err != nil {
 		return false
 	}
@@ -0,0 +1,74 @@
+package ingest
+
+import (
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/log"
+	"github.com/stellar/go/support/errors"
+)
+
+// DefaultEffectTypes is the registry of every effect type defined by the
+// history package.
+var DefaultEffectTypes = NewEffectTypeRegistry(
+	history.EffectAccountCreated,
+	history.EffectAccountRemoved,
+	history.EffectAccountCredited,
+	history.EffectAccountDebited,
+	history.EffectAccountThresholdsUpdated,
+	history.EffectAccountHomeDomainUpdated,
+	history.EffectAccountFlagsUpdated,
+	history.EffectSignerCreated,
+	history.EffectSignerRemoved,
+	history.EffectSignerUpdated,
+	history.EffectTrustlineCreated,
+	history.EffectTrustlineRemoved,
+	history.EffectTrustlineUpdated,
+	history.EffectTrustlineAuthorized,
+	history.EffectTrustlineDeauthorized,
+	history.EffectOfferCreated,
+	history.EffectOfferRemoved,
+	history.EffectOfferUpdated,
+	history.EffectTrade,
+	history.EffectDataCreated,
+	history.EffectDataRemoved,
+	history.EffectDataUpdated,
+)
+
+// NewEffectTypeRegistry returns a registry recognizing `types`.
+func NewEffectTypeRegistry(types ...history.EffectType) EffectTypeRegistry {
+	r := EffectTypeRegistry{}
+	for _, typ := range types {
+		r[typ] = true
+	}
+	return r
+}
+
+// Known returns true if `typ` is in the registry.
+func (r EffectTypeRegistry) Known(typ history.EffectType) bool {
+	return r[typ]
+}
+
+// checkEffectType applies the UnknownEffects policy to an effect of type
+// `typ`, returning whether the effect should be stored.
+func (ingest *Ingestion) checkEffectType(opid int64, typ history.EffectType) (bool, error) {
+	types := ingest.EffectTypes
+	if types == nil {
+		types = DefaultEffectTypes
+	}
+
+	if types.Known(typ) {
+		return true, nil
+	}
+
+	switch ingest.UnknownEffects {
+	case UnknownEffectSkip:
+		log.
+			WithField("operation_id", opid).
+			WithField("type", typ).
+			Warn("ingest: skipping effect of unknown type")
+		return false, nil
+	case UnknownEffectFail:
+		return false, errors.Errorf("effect of operation %d has unknown type %d", opid, typ)
+	default:
+		return true, nil
+	}
+}
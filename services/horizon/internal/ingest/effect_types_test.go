@@ -0,0 +1,45 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffectTypeRegistry(t *testing.T) {
+	assert.True(t, DefaultEffectTypes.Known(history.EffectAccountCreated))
+	assert.True(t, DefaultEffectTypes.Known(history.EffectDataUpdated))
+	assert.False(t, DefaultEffectTypes.Known(history.EffectType(99)))
+
+	r := NewEffectTypeRegistry(history.EffectTrade)
+	assert.True(t, r.Known(history.EffectTrade))
+	assert.False(t, r.Known(history.EffectAccountCreated))
+}
+
+func TestEffectRaw_UnknownType(t *testing.T) {
+	unknown := history.EffectType(99)
+	ingestion := &Ingestion{}
+
+	store, err := ingestion.checkEffectType(1, unknown)
+	assert.NoError(t, err)
+	assert.True(t, store, "unknown effects are stored by default")
+
+	store, err = ingestion.checkEffectType(1, history.EffectTrade)
+	assert.NoError(t, err)
+	assert.True(t, store)
+
+	// neither policy reaches the database
+	ingestion.UnknownEffects = UnknownEffectSkip
+	assert.NoError(t, ingestion.EffectRaw(1, 1, 1, unknown, []byte(`{}`)))
+
+	ingestion.UnknownEffects = UnknownEffectFail
+	err = ingestion.EffectRaw(1, 1, 1, unknown, []byte(`{}`))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "unknown type 99")
+	}
+
+	// a registry that omits a type treats it as unknown
+	ingestion.EffectTypes = NewEffectTypeRegistry(history.EffectAccountCreated)
+	assert.Error(t, ingestion.EffectRaw(1, 1, 1, history.EffectTrade, []byte(`{}`)))
+}
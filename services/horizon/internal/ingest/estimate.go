@@ -0,0 +1,148 @@
+package ingest
+
+import (
+	"time"
+
+	"github.com/stellar/go/support/errors"
+)
+
+// estimateSamples is the number of ledgers EstimateReingest samples.
+const estimateSamples = 10
+
+// reingestSample is the cost of ingesting one of the ledgers sampled by
+// EstimateReingest.
+type reingestSample struct {
+	Transactions int
+	Operations   int
+	Elapsed      time.Duration
+}
+
+// EstimateReingest estimates the time ReingestRange would take to reingest
+// the ledgers `first` through `last`.  A few ledgers spread across the range
+// are loaded from the core db and ingested into transactions that are rolled
+// back rather than committed, leaving the history db unchanged, while being
+// timed.  The cost of a ledger is modeled as a fixed part plus a part
+// proportional to its operations, and extrapolated to the number of ledgers
+// and transactions the core db holds for the range, assuming the range's
+// transactions have as many operations on average as the sampled ones.
+//
+// The estimate is rough: it assumes the db performs as it did while sampling,
+// and does not account for the cost of clearing existing history or of
+// creating the accounts and assets first seen in the range.
+func (i *System) EstimateReingest(first, last int32) (time.Duration, error) {
+	if first < 1 || first > last {
+		return 0, errors.Errorf("invalid ledger range: %d to %d", first, last)
+	}
+
+	var ledgers, transactions int
+	err := i.CoreDB.GetRaw(&ledgers,
+		`SELECT COUNT(*) FROM ledgerheaders WHERE ledgerseq BETWEEN ? AND ?`,
+		first, last,
+	)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count ledgers")
+	}
+
+	err = i.CoreDB.GetRaw(&transactions,
+		`SELECT COUNT(*) FROM txhistory WHERE ledgerseq BETWEEN ? AND ?`,
+		first, last,
+	)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count transactions")
+	}
+
+	samples := make([]reingestSample, 0, estimateSamples)
+	for _, seq := range sampleLedgers(first, last, estimateSamples) {
+		sample, err := i.sampleReingest(seq)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to sample ledger %d", seq)
+		}
+		samples = append(samples, sample)
+	}
+
+	return extrapolateReingest(samples, ledgers, transactions), nil
+}
+
+// sampleReingest times the loading and ingestion of ledger `seq`, rolling
+// back everything it writes.
+func (i *System) sampleReingest(seq int32) (reingestSample, error) {
+	is := NewSession(i)
+	is.Cursor = NewCursor(seq, seq, i)
+	is.ClearExisting = true
+	is.SkipCursorUpdate = true
+	is.CheckpointEvery = 0
+	is.TomlFetcher = nil
+	is.ReplicationLag = nil
+
+	// only the primary db is written to, and never committed
+	is.Ingestion.rollbackOnly = true
+	is.Ingestion.SecondaryDB = nil
+	is.Ingestion.Sink = nil
+	is.Ingestion.SpoolDir = ""
+
+	start := time.Now()
+	bundle := LedgerBundle{Sequence: seq, XDRErrorPolicy: i.XDRErrorPolicy}
+	err := bundle.Load(i.CoreDB)
+	if err != nil {
+		return reingestSample{}, err
+	}
+
+	err = is.IngestBundles([]LedgerBundle{bundle})
+	if err != nil {
+		return reingestSample{}, err
+	}
+
+	sample := reingestSample{
+		Transactions: len(bundle.Transactions),
+		Elapsed:      time.Since(start),
+	}
+	for _, tx := range bundle.Transactions {
+		sample.Operations += len(tx.Envelope.Tx.Operations)
+	}
+	return sample, nil
+}
+
+// sampleLedgers returns up to `n` ledgers spread evenly across `first`
+// through `last`, including both.
+func sampleLedgers(first, last int32, n int) []int32 {
+	span := int64(last) - int64(first)
+	if span+1 <= int64(n) {
+		n = int(span + 1)
+	}
+
+	seqs := make([]int32, 0, n)
+	for k := 0; k < n; k++ {
+		var offset int64
+		if n > 1 {
+			offset = span * int64(k) / int64(n-1)
+		}
+		seqs = append(seqs, first+int32(offset))
+	}
+	return seqs
+}
+
+// extrapolateReingest extrapolates the cost of `samples` to a range of
+// `ledgers` ledgers holding `transactions` transactions.  Each ledger costs
+// as much as a single operation on top of the cost of its operations.
+func extrapolateReingest(samples []reingestSample, ledgers, transactions int) time.Duration {
+	var elapsed time.Duration
+	var txs, ops int
+	for _, s := range samples {
+		elapsed += s.Elapsed
+		txs += s.Transactions
+		ops += s.Operations
+	}
+
+	units := len(samples) + ops
+	if units == 0 {
+		return 0
+	}
+	perUnit := float64(elapsed) / float64(units)
+
+	var rangeOps float64
+	if txs > 0 {
+		rangeOps = float64(transactions) * float64(ops) / float64(txs)
+	}
+
+	return time.Duration(perUnit * (float64(ledgers) + rangeOps))
+}
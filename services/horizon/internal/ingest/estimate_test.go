@@ -0,0 +1,57 @@
+package ingest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampleLedgers(t *testing.T) {
+	assert.Equal(t, []int32{5}, sampleLedgers(5, 5, 10))
+	assert.Equal(t, []int32{1, 2, 3}, sampleLedgers(1, 3, 10))
+	assert.Equal(t, []int32{1, 25, 50, 75, 100}, sampleLedgers(1, 100, 5))
+}
+
+func TestExtrapolateReingest(t *testing.T) {
+	samples := func(ops int) []reingestSample {
+		// each ledger costs 1ms, and each operation 1ms
+		return []reingestSample{
+			{Transactions: 2, Operations: ops, Elapsed: time.Duration(1+ops) * time.Millisecond},
+			{Transactions: 2, Operations: ops, Elapsed: time.Duration(1+ops) * time.Millisecond},
+		}
+	}
+
+	// 100 ledgers with 1000 transactions
+	assert.Equal(t, 100*time.Millisecond, extrapolateReingest(samples(0), 100, 1000))
+	assert.Equal(t, 600*time.Millisecond, extrapolateReingest(samples(1), 100, 1000))
+	assert.Equal(t, 1100*time.Millisecond, extrapolateReingest(samples(2), 100, 1000))
+	assert.Equal(t, 2100*time.Millisecond, extrapolateReingest(samples(4), 100, 1000))
+
+	// the estimate scales with the size of the range
+	assert.Equal(t, 4200*time.Millisecond, extrapolateReingest(samples(4), 200, 2000))
+
+	assert.Equal(t, time.Duration(0), extrapolateReingest(nil, 100, 1000))
+}
+
+func TestEstimateReingest(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	sys := sys(tt)
+	estimate, err := sys.EstimateReingest(1, ledger.CurrentState().CoreLatest)
+	tt.Require.NoError(err)
+	tt.Assert.True(estimate > 0)
+
+	// sampling leaves the history db unchanged
+	var count int
+	tt.Require.NoError(tt.HorizonSession().GetRaw(&count, `SELECT COUNT(*) FROM history_ledgers`))
+	tt.Assert.Equal(0, count)
+	tt.Require.NoError(tt.HorizonSession().GetRaw(&count, `SELECT COUNT(*) FROM history_accounts`))
+	tt.Assert.Equal(0, count)
+
+	_, err = sys.EstimateReingest(10, 5)
+	tt.Assert.Error(err)
+}
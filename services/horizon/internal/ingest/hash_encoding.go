@@ -0,0 +1,89 @@
+package ingest
+
+import (
+	"encoding/hex"
+
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/errors"
+)
+
+// HashEncoding controls how the transaction and ledger hashes of ingested
+// rows are stored.
+type HashEncoding int
+
+const (
+	// HashEncodingHex stores hashes as hex strings.  This is the default, and
+	// the encoding the horizon API reads.
+	HashEncodingHex HashEncoding = iota
+
+	// HashEncodingBytes stores hashes as raw bytes, half the size of their hex
+	// encoding, for dbs whose history_transactions.transaction_hash and
+	// history_ledgers.ledger_hash and previous_ledger_hash columns have been
+	// converted to bytea, such as a secondary db serving downstream systems.
+	// No migration performs the conversion, since the horizon API does not
+	// read such columns: the operator must alter the columns, and Start fails
+	// while any of them is not bytea.
+	HashEncodingBytes
+)
+
+// hashColumns are the columns whose values are stored in the ingestion's
+// HashEncoding.
+var hashColumns = []struct {
+	Table  string
+	Column string
+}{
+	{"history_transactions", "transaction_hash"},
+	{"history_ledgers", "ledger_hash"},
+	{"history_ledgers", "previous_ledger_hash"},
+}
+
+// checkHashColumns returns an error if HashEncodingBytes is set but the hash
+// columns of DB, or of SecondaryDB when configured, are not bytea.  The
+// columns are only checked by the first call to succeed.
+func (ingest *Ingestion) checkHashColumns() error {
+	if ingest.HashEncoding != HashEncodingBytes || ingest.hashColumnsChecked {
+		return nil
+	}
+
+	for _, session := range []*db.Session{ingest.DB, ingest.SecondaryDB} {
+		if session == nil {
+			continue
+		}
+
+		for _, c := range hashColumns {
+			var typ string
+			err := session.GetRaw(&typ, `
+				SELECT data_type FROM information_schema.columns
+				WHERE table_schema = current_schema() AND table_name = ? AND column_name = ?
+			`, c.Table, c.Column)
+			if err != nil {
+				return errors.Wrapf(err, "failed to load type of %s.%s", c.Table, c.Column)
+			}
+
+			if typ != "bytea" {
+				return errors.Errorf(
+					"HashEncodingBytes requires %s.%s to be bytea, not %s",
+					c.Table, c.Column, typ,
+				)
+			}
+		}
+	}
+
+	ingest.hashColumnsChecked = true
+	return nil
+}
+
+// encodeHash returns the hex encoded hash `hash` in the ingestion's
+// HashEncoding, for storage.
+func (ingest *Ingestion) encodeHash(hash string) (interface{}, error) {
+	if ingest.HashEncoding != HashEncodingBytes {
+		return hash, nil
+	}
+
+	raw, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid hash %q", hash)
+	}
+
+	return raw, nil
+}
@@ -0,0 +1,92 @@
+package ingest
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngest_HashEncoding(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	hq := tt.HorizonSession()
+
+	type hashes struct {
+		Transactions []string
+		Ledgers      []string
+		PrevLedgers  []string
+	}
+
+	// load returns the stored hashes, hex encoding them first if `bytea`
+	load := func(bytea bool) (h hashes) {
+		col := func(name string) string {
+			if bytea {
+				return "encode(" + name + ", 'hex')"
+			}
+			return name
+		}
+
+		tt.Require.NoError(hq.SelectRaw(&h.Transactions,
+			`SELECT `+col("transaction_hash")+` FROM history_transactions ORDER BY id`))
+		tt.Require.NoError(hq.SelectRaw(&h.Ledgers,
+			`SELECT `+col("ledger_hash")+` FROM history_ledgers ORDER BY id`))
+		tt.Require.NoError(hq.SelectRaw(&h.PrevLedgers,
+			`SELECT `+col("previous_ledger_hash")+` FROM history_ledgers WHERE sequence > 1 ORDER BY id`))
+		return
+	}
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+	hexHashes := load(false)
+	tt.Require.NotEmpty(hexHashes.Transactions)
+
+	// the stock schema's hex columns are rejected
+	rejected := &Ingestion{DB: hq, IngestionOptions: IngestionOptions{HashEncoding: HashEncodingBytes}}
+	err := rejected.Start()
+	tt.Require.Error(err)
+	tt.Assert.Contains(err.Error(), "history_transactions.transaction_hash to be bytea")
+
+	// convert the hash columns to bytea
+	_, err = hq.ExecRaw(`
+		TRUNCATE history_transactions, history_ledgers;
+		ALTER TABLE history_transactions ALTER COLUMN transaction_hash TYPE bytea USING decode(transaction_hash, 'hex');
+		ALTER TABLE history_ledgers ALTER COLUMN ledger_hash TYPE bytea USING decode(ledger_hash, 'hex');
+		ALTER TABLE history_ledgers ALTER COLUMN previous_ledger_hash TYPE bytea USING decode(previous_ledger_hash, 'hex');
+	`)
+	tt.Require.NoError(err)
+
+	sys := sys(tt)
+	sys.HashEncoding = HashEncodingBytes
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	var size int
+	tt.Require.NoError(hq.GetRaw(&size, `SELECT MAX(octet_length(transaction_hash)) FROM history_transactions`))
+	tt.Assert.Equal(32, size)
+
+	tt.Assert.Equal(hexHashes, load(true))
+}
+
+func TestIngestion_EncodeHash(t *testing.T) {
+	hash := "1939a8de30981e4171e1aaeca54a058a7fb06684864facba0620ab8cc5076d4f"
+	ingestion := &Ingestion{}
+
+	encoded, err := ingestion.encodeHash(hash)
+	assert.NoError(t, err)
+	assert.Equal(t, hash, encoded)
+
+	ingestion.HashEncoding = HashEncodingBytes
+	encoded, err = ingestion.encodeHash(hash)
+	assert.NoError(t, err)
+	assert.Equal(t, hash, hex.EncodeToString(encoded.([]byte)))
+
+	// hashes that do not decode are rejected rather than stored as hex
+	_, err = ingestion.encodeHash("not a hash")
+	assert.Error(t, err)
+}
@@ -0,0 +1,42 @@
+package ingest
+
+import (
+	"encoding/hex"
+
+	"github.com/guregu/null"
+	"github.com/stellar/go/services/horizon/internal/db2/core"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// headerFieldValues are the values of the history_ledgers columns holding
+// fields of the ledger header.  Only the bucket list hash is always written;
+// the other fields are written when ingesting with StoreFullHeaderFields.
+type headerFieldValues struct {
+	BucketListHash  null.String
+	TxSetHash       null.String
+	TxSetResultHash null.String
+	ScpValue        null.String
+}
+
+// headerFields decodes the values of the header columns from `header`.  The
+// values other than the bucket list hash are null unless
+// StoreFullHeaderFields is set.
+func (ingest *Ingestion) headerFields(header *core.LedgerHeader) (headerFieldValues, error) {
+	bucketListHash := null.StringFrom(hex.EncodeToString(header.Data.BucketListHash[:]))
+	if !ingest.StoreFullHeaderFields {
+		return headerFieldValues{BucketListHash: bucketListHash}, nil
+	}
+
+	scp, err := xdr.MarshalBase64(header.Data.ScpValue)
+	if err != nil {
+		return headerFieldValues{}, errors.Wrap(err, "failed to encode scp value")
+	}
+
+	return headerFieldValues{
+		BucketListHash:  bucketListHash,
+		TxSetHash:       null.StringFrom(hex.EncodeToString(header.Data.ScpValue.TxSetHash[:])),
+		TxSetResultHash: null.StringFrom(hex.EncodeToString(header.Data.TxSetResultHash[:])),
+		ScpValue:        null.StringFrom(scp),
+	}, nil
+}
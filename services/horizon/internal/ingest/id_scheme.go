@@ -0,0 +1,48 @@
+package ingest
+
+import (
+	"github.com/stellar/go/services/horizon/internal/toid"
+)
+
+// ID returns the toid of the provided position.
+func (TOIDScheme) ID(ledger int32, tx int32, op int32) int64 {
+	return toid.New(ledger, tx, op).ToInt64()
+}
+
+// Parse parses `id` as a toid.
+func (TOIDScheme) Parse(id int64) (ledger int32, tx int32, op int32) {
+	parsed := toid.Parse(id)
+	return parsed.LedgerSequence, parsed.TransactionOrder, parsed.OperationOrder
+}
+
+// LedgerToIDRange returns the range [start, end) of the toids of ledger `seq`
+// and of every transaction, operation and effect within it.
+func LedgerToIDRange(seq int32) (start int64, end int64) {
+	return LedgersToIDRange(seq, seq)
+}
+
+// LedgersToIDRange returns the range [start, end) of the toids of the ledgers
+// `first` through `last`, inclusive, and of every transaction, operation and
+// effect within them.
+func LedgersToIDRange(first, last int32) (start int64, end int64) {
+	return ledgerIDRange(TOIDScheme{}, first, last)
+}
+
+// ledgerIDRange is LedgersToIDRange for an arbitrary id scheme.  The range of
+// the genesis ledger starts at 0, so that it also covers any rows written with
+// ids below that of the ledger itself.
+func ledgerIDRange(ids IDScheme, first, last int32) (start int64, end int64) {
+	if first > 1 {
+		start = ids.ID(first, 0, 0)
+	}
+
+	return start, ids.ID(last+1, 0, 0)
+}
+
+// idSchemeOrDefault returns `scheme`, or TOIDScheme if it is nil.
+func idSchemeOrDefault(scheme IDScheme) IDScheme {
+	if scheme == nil {
+		return TOIDScheme{}
+	}
+	return scheme
+}
@@ -0,0 +1,105 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/services/horizon/internal/toid"
+	"github.com/stretchr/testify/assert"
+)
+
+// decimalScheme is an id scheme that encodes positions as decimal digits.
+type decimalScheme struct{}
+
+func (decimalScheme) ID(ledger int32, tx int32, op int32) int64 {
+	return int64(ledger)*1000000 + int64(tx)*1000 + int64(op)
+}
+
+func (decimalScheme) Parse(id int64) (int32, int32, int32) {
+	return int32(id / 1000000), int32(id / 1000 % 1000), int32(id % 1000)
+}
+
+func TestTOIDScheme(t *testing.T) {
+	var scheme TOIDScheme
+
+	id := scheme.ID(10, 2, 3)
+	assert.Equal(t, toid.New(10, 2, 3).ToInt64(), id)
+
+	ledger, tx, op := scheme.Parse(id)
+	assert.Equal(t, int32(10), ledger)
+	assert.Equal(t, int32(2), tx)
+	assert.Equal(t, int32(3), op)
+}
+
+func TestCursorIDScheme(t *testing.T) {
+	// the default scheme is toid
+	c := Cursor{lg: 10, tx: 1, op: 2}
+	assert.Equal(t, toid.New(10, 0, 0).ToInt64(), c.LedgerID())
+	assert.Equal(t, toid.New(10, 2, 0).ToInt64(), c.TransactionID())
+	assert.Equal(t, toid.New(10, 2, 3).ToInt64(), c.OperationID())
+
+	c.IDScheme = decimalScheme{}
+	assert.Equal(t, int64(10000000), c.LedgerID())
+	assert.Equal(t, int64(10002000), c.TransactionID())
+	assert.Equal(t, int64(10002003), c.OperationID())
+
+	start, end := c.LedgerRange()
+	assert.Equal(t, int64(10000000), start)
+	assert.Equal(t, int64(11000000), end)
+}
+
+func TestLedgerToIDRange(t *testing.T) {
+	start, end := LedgerToIDRange(10)
+	assert.Equal(t, toid.New(10, 0, 0).ToInt64(), start)
+	assert.Equal(t, toid.New(11, 0, 0).ToInt64(), end)
+
+	// the last operation of the ledger is covered, and nothing from the
+	// adjacent ledgers is
+	last := toid.New(10, toid.TransactionMask, toid.OperationMask).ToInt64()
+	assert.True(t, last < end)
+	assert.True(t, toid.New(9, toid.TransactionMask, toid.OperationMask).ToInt64() < start)
+
+	start, end = LedgersToIDRange(10, 12)
+	assert.Equal(t, toid.New(10, 0, 0).ToInt64(), start)
+	assert.Equal(t, toid.New(13, 0, 0).ToInt64(), end)
+
+	// the genesis ledger's range starts at 0
+	start, end = LedgerToIDRange(1)
+	assert.Equal(t, int64(0), start)
+	assert.Equal(t, toid.New(2, 0, 0).ToInt64(), end)
+}
+
+func TestLedgerToIDRange_Ingested(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	hq := tt.HorizonSession()
+	count := func(query string, args ...interface{}) (found int) {
+		tt.Require.NoError(hq.GetRaw(&found, query, args...))
+		return
+	}
+
+	for seq := int32(2); seq <= ledger.CurrentState().CoreLatest; seq++ {
+		start, end := LedgerToIDRange(seq)
+
+		tt.Assert.Equal(
+			count(`SELECT COUNT(*) FROM history_operations hop
+				JOIN history_transactions ht ON ht.id = hop.transaction_id
+				WHERE ht.ledger_sequence = ?`, seq),
+			count(`SELECT COUNT(*) FROM history_operations WHERE id >= ? AND id < ?`, start, end),
+			"operations of ledger %d", seq,
+		)
+		tt.Assert.Equal(
+			count(`SELECT COUNT(*) FROM history_effects heff
+				JOIN history_operations hop ON hop.id = heff.history_operation_id
+				JOIN history_transactions ht ON ht.id = hop.transaction_id
+				WHERE ht.ledger_sequence = ?`, seq),
+			count(`SELECT COUNT(*) FROM history_effects WHERE history_operation_id >= ? AND history_operation_id < ?`, start, end),
+			"effects of ledger %d", seq,
+		)
+	}
+}
@@ -1,6 +1,7 @@
 package ingest
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -9,13 +10,33 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/guregu/null"
+	"github.com/lib/pq"
 	"github.com/stellar/go/services/horizon/internal/db2/core"
 	"github.com/stellar/go/services/horizon/internal/db2/history"
 	"github.com/stellar/go/services/horizon/internal/db2/sqx"
+	"github.com/stellar/go/services/horizon/internal/ingest/participants"
+	"github.com/stellar/go/services/horizon/internal/log"
+	"github.com/stellar/go/services/horizon/internal/toid"
+	"github.com/stellar/go/support/db"
 	"github.com/stellar/go/support/errors"
+	sTime "github.com/stellar/go/support/time"
 	"github.com/stellar/go/xdr"
 )
 
+// AccountFlags records a change to the flags of `account`, made by the
+// operation with id `opid`, into the history_account_flags table.
+func (ingest *Ingestion) AccountFlags(
+	opid int64,
+	ledgerSeq int32,
+	account xdr.AccountId,
+	before xdr.Uint32,
+	after xdr.Uint32,
+) error {
+	return ingest.insertRow(ingest.accountFlags, "history_account_flags",
+		opid, ledgerSeq, ingest.address(account), int32(before), int32(after),
+	)
+}
+
 // ClearAll clears the entire history database
 func (ingest *Ingestion) ClearAll() error {
 	return ingest.Clear(0, math.MaxInt64)
@@ -24,12 +45,20 @@ func (ingest *Ingestion) ClearAll() error {
 // Clear removes a range of data from the history database, exclusive of the end
 // id provided.
 func (ingest *Ingestion) Clear(start int64, end int64) error {
-	clear := ingest.DB.DeleteRange
+	clear := ingest.deleteRange
 
 	err := clear(start, end, "history_effects", "history_operation_id")
 	if err != nil {
 		return err
 	}
+	err = clear(start, end, "history_account_flags", "history_operation_id")
+	if err != nil {
+		return err
+	}
+	err = clear(start, end, "history_ledger_changes", "history_operation_id")
+	if err != nil {
+		return err
+	}
 	err = clear(start, end, "history_operation_participants", "history_operation_id")
 	if err != nil {
 		return err
@@ -42,6 +71,14 @@ func (ingest *Ingestion) Clear(start int64, end int64) error {
 	if err != nil {
 		return err
 	}
+	err = clear(start, end, "history_transaction_xdr", "history_transaction_id")
+	if err != nil {
+		return err
+	}
+	err = clear(start, end, "history_transaction_memos", "history_transaction_id")
+	if err != nil {
+		return err
+	}
 	err = clear(start, end, "history_transactions", "id")
 	if err != nil {
 		return err
@@ -50,6 +87,18 @@ func (ingest *Ingestion) Clear(start int64, end int64) error {
 	if err != nil {
 		return err
 	}
+	err = clear(start, end, "history_ledger_stats", "id")
+	if err != nil {
+		return err
+	}
+	err = clear(start, end, "history_upgrade_proposals", "id")
+	if err != nil {
+		return err
+	}
+	err = ingest.clearTradePairStats(start, end)
+	if err != nil {
+		return err
+	}
 	err = clear(start, end, "history_trades", "history_operation_id")
 	if err != nil {
 		return err
@@ -69,19 +118,45 @@ func (ingest *Ingestion) Close() error {
 
 // Effect adds a new row into the `history_effects` table.
 func (ingest *Ingestion) Effect(aid int64, opid int64, order int, typ history.EffectType, details interface{}) error {
-	djson, err := json.Marshal(details)
+	djson, err := marshalDetails(details)
 	if err != nil {
 		return err
 	}
 
-	sql := ingest.effects.Values(aid, opid, order, typ, djson)
+	return ingest.EffectRaw(aid, opid, order, typ, djson)
+}
 
-	_, err = ingest.DB.Exec(sql)
+// EffectRaw adds a new row into the `history_effects` table using details that
+// have already been marshaled.  `raw` is stored as is, unless a
+// DetailFieldFilter is set, so it should be produced by marshalDetails for the
+// row to match one written by Effect.  This allows
+// callers writing several effects with the same details to marshal them once.
+func (ingest *Ingestion) EffectRaw(aid int64, opid int64, order int, typ history.EffectType, raw json.RawMessage) error {
+	store, err := ingest.checkEffectType(opid, typ)
+	if !store || err != nil {
+		return err
+	}
+
+	raw, err = ingest.filterDetails(EffectsTable, raw)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	ingest.detailsSize(opid, len(raw))
+	err = ingest.insertRow(ingest.effects, "history_effects", aid, opid, order, typ, []byte(raw))
+	if err != nil {
+		return err
+	}
+
+	return ingest.toSink(func(s Sink) error {
+		return s.Effect(SinkEffect{
+			AccountID:   aid,
+			OperationID: opid,
+			Order:       order,
+			Type:        typ,
+			Details:     raw,
+		})
+	})
 }
 
 // Flush writes the currently buffered rows to the db, and if successful
@@ -95,44 +170,158 @@ func (ingest *Ingestion) Flush() error {
 	return ingest.Start()
 }
 
-// Ledger adds a ledger to the current ingestion
+// Ledger adds a ledger to the current ingestion.  `txs` and `ops` are the
+// counts of the ledger's successful transactions and of their operations: the
+// operations of failed transactions, none of which were applied, are not
+// counted, even when IngestFailedTransactions is set.
 func (ingest *Ingestion) Ledger(
 	id int64,
 	header *core.LedgerHeader,
 	txs int,
 	ops int,
 ) error {
+	err := ingest.ledger(id, header, txs, ops, false)
+	if err != nil {
+		return err
+	}
+
+	if !ingest.OutboxEnabled {
+		return nil
+	}
+
+	return ingest.outbox(id, header, txs, ops, ingest.now())
+}
+
+// PartialLedger adds a ledger to the current ingestion, marking it as partial.
+// No outbox event is written for a partial ledger; one is written once the
+// ledger has been ingested in full.
+func (ingest *Ingestion) PartialLedger(
+	id int64,
+	header *core.LedgerHeader,
+	txs int,
+	ops int,
+) error {
+	return ingest.ledger(id, header, txs, ops, true)
+}
 
-	sql := ingest.ledgers.Values(
+// IsPartialLedger returns true if the ledger `seq` has been partially
+// ingested.
+func (ingest *Ingestion) IsPartialLedger(seq int32) (bool, error) {
+	var partial bool
+	err := ingest.DB.GetRaw(&partial,
+		`SELECT EXISTS(SELECT 1 FROM history_ledgers WHERE sequence = ? AND partial)`,
+		seq,
+	)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to load ledger")
+	}
+
+	return partial, nil
+}
+
+// LedgerStats adds the stats of a ledger's transaction set to the current
+// ingestion.  See IngestLedgerStats.
+func (ingest *Ingestion) LedgerStats(stats history.LedgerStats) error {
+	return ingest.insertRow(ingest.ledgerStats, "history_ledger_stats",
+		stats.ID,
+		stats.LedgerSequence,
+		stats.SourceAccountCount,
+		stats.MinFee,
+		stats.MedianFee,
+		stats.MaxFee,
+		stats.OperationCount,
+		stats.FailedTransactionCount,
+	)
+}
+
+// LedgerTrustlinesChanged records that ledger `seq`, which must have been
+// added to the current ingestion, changed `count` trustlines.  See
+// CountTrustlineChanges.
+func (ingest *Ingestion) LedgerTrustlinesChanged(seq int32, count int) error {
+	return ingest.exec(sq.Update("history_ledgers").
+		Set("trustlines_changed", count).
+		Where("sequence = ?", seq),
+	)
+}
+
+func (ingest *Ingestion) ledger(
+	id int64,
+	header *core.LedgerHeader,
+	txs int,
+	ops int,
+	partial bool,
+) error {
+	fields, err := ingest.headerFields(header)
+	if err != nil {
+		return err
+	}
+
+	var prevHash interface{}
+	if header.Sequence > 1 {
+		prevHash, err = ingest.encodeHash(header.PrevHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	hash, err := ingest.encodeHash(header.LedgerHash)
+	if err != nil {
+		return err
+	}
+
+	dataXDR, err := ingest.storedXDR(header.DataXDR())
+	if err != nil {
+		return err
+	}
+
+	err = ingest.insertRow(ingest.ledgers, "history_ledgers",
 		CurrentVersion,
 		id,
 		header.Sequence,
-		header.LedgerHash,
-		null.NewString(header.PrevHash, header.Sequence > 1),
+		hash,
+		prevHash,
 		header.Data.TotalCoins,
 		header.Data.FeePool,
 		header.Data.BaseFee,
 		header.Data.BaseReserve,
 		header.Data.MaxTxSetSize,
-		time.Unix(header.CloseTime, 0).UTC(),
-		time.Now().UTC(),
-		time.Now().UTC(),
+		ingest.closeTime(header.CloseTime),
+		ingest.now(),
+		ingest.now(),
 		txs,
 		ops,
 		header.Data.LedgerVersion,
-		header.DataXDR(),
+		dataXDR,
+		closeTimeVersion(header.Data.LedgerVersion),
+		partial,
+		null.NewString(ingest.InstanceID, ingest.InstanceID != ""),
+		fields.BucketListHash,
+		fields.TxSetHash,
+		fields.TxSetResultHash,
+		fields.ScpValue,
 	)
-
-	_, err := ingest.DB.Exec(sql)
 	if err != nil {
 		return err
 	}
+	ingest.txLedgers = append(ingest.txLedgers, int32(header.Sequence))
 
-	return nil
+	return ingest.toSink(func(s Sink) error {
+		return s.Ledger(SinkLedger{
+			ID:               id,
+			Sequence:         int32(header.Sequence),
+			Hash:             header.LedgerHash,
+			PrevHash:         header.PrevHash,
+			ClosedAt:         ingest.closeTime(header.CloseTime),
+			TransactionCount: txs,
+			OperationCount:   ops,
+			ProtocolVersion:  int32(header.Data.LedgerVersion),
+		})
+	})
 }
 
 // Operation ingests the provided operation data into a new row in the
-// `history_operations` table
+// `history_operations` table.  `resultCode` is the operation's own result
+// code, see Cursor.OperationResultCode.
 func (ingest *Ingestion) Operation(
 	id int64,
 	txid int64,
@@ -140,87 +329,290 @@ func (ingest *Ingestion) Operation(
 	source xdr.AccountId,
 	typ xdr.OperationType,
 	details map[string]interface{},
-
+	successful bool,
+	resultCode null.Int,
 ) error {
-	djson, err := json.Marshal(details)
+	order, err := ingest.applyOrderBase(order, toid.OperationMask)
+	if err != nil {
+		return err
+	}
+
+	if ingest.NormalizeAssetsInDetails {
+		err = ingest.normalizeAssets(details)
+		if err != nil {
+			return err
+		}
+	}
+
+	details = ingest.whitelistDetails(typ, details)
+	djson, err := marshalDetails(details)
 	if err != nil {
 		return err
 	}
 
-	sql := ingest.operations.Values(id, txid, order, source.Address(), typ, djson)
-	_, err = ingest.DB.Exec(sql)
+	djson, err = ingest.filterDetails(OperationsTable, djson)
 	if err != nil {
 		return err
 	}
+	ingest.detailsSize(id, len(djson))
 
-	return nil
+	err = ingest.insertRow(ingest.operations, "history_operations",
+		id, txid, order, ingest.address(source), typ, djson, successful, resultCode,
+	)
+	if err != nil {
+		return err
+	}
+
+	return ingest.toSink(func(s Sink) error {
+		return s.Operation(SinkOperation{
+			ID:               id,
+			TransactionID:    txid,
+			ApplicationOrder: order,
+			Type:             typ,
+			SourceAccount:    ingest.address(source),
+			Details:          djson,
+			Successful:       successful,
+		})
+	})
 }
 
-// OperationParticipants ingests the provided accounts `aids` as participants of
-// operation with id `op`, creating a new row in the
-// `history_operation_participants` table.
-func (ingest *Ingestion) OperationParticipants(op int64, aids []xdr.AccountId) error {
+// OperationParticipants ingests the provided participants `p` of operation
+// with id `op`, creating a new row in the `history_operation_participants`
+// table.  Their roles are only stored when ParticipantRoles is set.
+func (ingest *Ingestion) OperationParticipants(op int64, p []participants.Participant) error {
 	sql := ingest.operation_participants
-	q := history.Q{Session: ingest.DB}
+	rows := 0
 
-	for _, aid := range aids {
-		haid, err := q.GetCreateAccountID(aid)
+	for _, participant := range p {
+		haid, err := ingest.getCreateAccountID(participant.Account)
 		if err != nil {
 			return err
 		}
-		sql = sql.Values(op, haid)
+
+		var role null.String
+		if ingest.ParticipantRoles {
+			role = null.StringFrom(string(participant.Role))
+		}
+
+		var added bool
+		sql, added, err = ingest.appendRow(sql, "history_operation_participants", op, haid, role)
+		if err != nil {
+			return err
+		}
+		if added {
+			rows++
+		}
 	}
 
-	_, err := ingest.DB.Exec(sql)
-	if err != nil {
-		return err
+	if rows == 0 {
+		return nil
 	}
 
-	return nil
+	return ingest.exec(sql)
 }
 
 // Rollback aborts this ingestions transaction
 func (ingest *Ingestion) Rollback() (err error) {
-	err = ingest.DB.Rollback()
+	ingest.stopWatchdog()
+	ingest.debug.resetPending()
+	ingest.pendingAssetIDs = nil
+	err = ingest.rollbackDB()
+
+	if ingest.SecondaryDB != nil && ingest.secondaryErr == nil {
+		ingest.SecondaryDB.Rollback()
+	}
+
+	if ingest.Sink != nil && ingest.sinkErr == nil {
+		ingest.Sink.Rollback()
+	}
 	return
 }
 
 // Start makes the ingestion reeady, initializing the insert builders and tx
 func (ingest *Ingestion) Start() (err error) {
-	err = ingest.DB.Begin()
+	err = ingest.checkHashColumns()
+	if err != nil {
+		return
+	}
+
+	err = ingest.begin()
+	if err != nil {
+		return
+	}
+
+	err = ingest.setIsolationLevel()
+	if err != nil {
+		ingest.rollbackDB()
+		return
+	}
+
+	if ingest.SecondaryDB != nil {
+		ingest.secondaryErr = nil
+		serr := ingest.SecondaryDB.Begin()
+		if serr != nil {
+			err = ingest.secondaryFailed(serr)
+			if err != nil {
+				ingest.rollbackDB()
+				return
+			}
+		}
+	}
+
+	err = ingest.startWatchdog()
 	if err != nil {
+		ingest.Rollback()
 		return
 	}
 
+	ingest.sinkErr = nil
+	ingest.tradePairStats = nil
+	ingest.txStarted = ingest.now()
+	ingest.txLedgers = nil
+	ingest.pendingAssetIDs = nil
+	ingest.pendingTxHashes = nil
+	ingest.debug.resetPending()
 	ingest.createInsertBuilders()
 
 	return
 }
 
-// transactionInsertBuilder returns sql.InsertBuilder for a single transaction
-func (ingest *Ingestion) transactionInsertBuilder(id int64, tx *core.Transaction, fee *core.TransactionFee) sq.InsertBuilder {
+// transactionValues returns the values of the history_transactions row of a
+// single transaction included in a ledger closed at `closedAt`.
+func (ingest *Ingestion) transactionValues(
+	id int64,
+	tx *core.Transaction,
+	fee *core.TransactionFee,
+	closedAt time.Time,
+) ([]interface{}, error) {
+	hash, err := ingest.encodeHash(tx.TransactionHash)
+	if err != nil {
+		return nil, err
+	}
+
 	// Enquote empty signatures
 	signatures := tx.Base64Signatures()
 
-	return ingest.transactions.Values(
+	stored, err := ingest.storedXDRs(
+		tx.EnvelopeXDR(),
+		tx.ResultXDR(),
+		tx.ResultMetaXDR(),
+		fee.ChangesXDR(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	envelope, result, meta, feeMeta := stored[0], stored[1], stored[2], stored[3]
+	switch ingest.StoreMeta {
+	case MetaStoreSeparate:
+		result, meta, feeMeta = "", "", ""
+	case MetaStoreNone:
+		meta, feeMeta = "", ""
+	}
+
+	return []interface{}{
 		id,
-		tx.TransactionHash,
+		hash,
 		tx.LedgerSequence,
-		tx.Index,
-		tx.SourceAddress(),
+		tx.Index + ingest.OrderBase,
+		ingest.rewriteAddress(tx.SourceAddress()),
 		tx.Sequence(),
 		tx.Fee(),
 		len(tx.Envelope.Tx.Operations),
-		tx.EnvelopeXDR(),
-		tx.ResultXDR(),
-		tx.ResultMetaXDR(),
-		fee.ChangesXDR(),
+		envelope,
+		result,
+		meta,
+		feeMeta,
 		sqx.StringArray(signatures),
+		len(signatures),
 		ingest.formatTimeBounds(tx.Envelope.Tx.TimeBounds),
 		tx.MemoType(),
 		tx.Memo(),
-		time.Now().UTC(),
-		time.Now().UTC(),
+		ingest.now(),
+		ingest.now(),
+		ingest.inclusionDelay(tx, closedAt),
+	}, nil
+}
+
+// DuplicateTransaction checks whether a transaction with the hash of `tx` has
+// already been ingested, applying the OnDuplicateTransaction policy.  It
+// returns true when `tx` should be skipped.  Under DuplicateTransactionFail no
+// check is made here: the duplicates of the whole transaction are found by a
+// single query when it commits.  See checkDuplicateTransactions.
+func (ingest *Ingestion) DuplicateTransaction(tx *core.Transaction) (bool, error) {
+	if ingest.OnDuplicateTransaction == DuplicateTransactionFail {
+		return false, nil
+	}
+
+	hash, err := ingest.encodeHash(tx.TransactionHash)
+	if err != nil {
+		return false, err
+	}
+
+	var found bool
+	err = ingest.DB.GetRaw(&found,
+		`SELECT EXISTS(SELECT 1 FROM history_transactions WHERE transaction_hash = ?)`,
+		hash,
+	)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check for duplicate transaction")
+	}
+
+	if !found {
+		return false, nil
+	}
+
+	if ingest.OnDuplicateTransaction == DuplicateTransactionSkip {
+		return true, nil
+	}
+
+	log.
+		WithField("ledger", tx.LedgerSequence).
+		WithField("hash", tx.TransactionHash).
+		Warn("ingest: duplicate transaction hash")
+	return false, nil
+}
+
+// checkDuplicateTransactions returns an error if any transaction ingested by
+// the current transaction shares its hash with another row of
+// history_transactions, under DuplicateTransactionFail.
+func (ingest *Ingestion) checkDuplicateTransactions() error {
+	if len(ingest.pendingTxHashes) == 0 {
+		return nil
+	}
+
+	hashes := make([]interface{}, 0, len(ingest.pendingTxHashes))
+	for hash := range ingest.pendingTxHashes {
+		encoded, err := ingest.encodeHash(hash)
+		if err != nil {
+			return err
+		}
+		hashes = append(hashes, encoded)
+	}
+
+	var found [][]byte
+	sql := sq.Select("transaction_hash").
+		From("history_transactions").
+		Where(sq.Eq{"transaction_hash": hashes}).
+		GroupBy("transaction_hash").
+		Having("COUNT(*) > 1").
+		Limit(1)
+	err := ingest.DB.Select(&found, sql)
+	if err != nil {
+		return errors.Wrap(err, "failed to check for duplicate transactions")
+	}
+
+	if len(found) == 0 {
+		return nil
+	}
+
+	hash := string(found[0])
+	if ingest.HashEncoding == HashEncodingBytes {
+		hash = hex.EncodeToString(found[0])
+	}
+
+	return errors.Errorf(
+		"transaction %s in ledger %d has already been ingested",
+		hash, ingest.pendingTxHashes[hash],
 	)
 }
 
@@ -233,23 +625,21 @@ func (ingest *Ingestion) Trade(
 	ledgerClosedAt int64,
 ) error {
 
-	q := history.Q{Session: ingest.DB}
-
-	sellerAccountId, err := q.GetCreateAccountID(trade.SellerId)
+	sellerAccountId, err := ingest.getCreateAccountID(trade.SellerId)
 	if err != nil {
 		return errors.Wrap(err, "failed to load seller account id")
 	}
 
-	buyerAccountId, err := q.GetCreateAccountID(buyer)
+	buyerAccountId, err := ingest.getCreateAccountID(buyer)
 	if err != nil {
 		return errors.Wrap(err, "failed to load buyer account id")
 	}
-	soldAssetId, err := q.GetCreateAssetID(trade.AssetSold)
+	soldAssetId, err := ingest.getCreateAssetID(trade.AssetSold)
 	if err != nil {
 		return errors.Wrap(err, "failed to get sold asset id")
 	}
 
-	boughtAssetId, err := q.GetCreateAssetID(trade.AssetBought)
+	boughtAssetId, err := ingest.getCreateAssetID(trade.AssetBought)
 	if err != nil {
 		return errors.Wrap(err, "failed to get bought asset id")
 	}
@@ -266,10 +656,10 @@ func (ingest *Ingestion) Trade(
 			buyerAccountId, boughtAssetId, trade.AmountBought, sellerAccountId, soldAssetId, trade.AmountSold
 	}
 
-	sql := ingest.trades.Values(
+	err = ingest.insertRow(ingest.trades, "history_trades",
 		opid,
 		order,
-		time.Unix(ledgerClosedAt, 0).UTC(),
+		ingest.closeTime(ledgerClosedAt),
 		trade.OfferId,
 		baseAccountId,
 		baseAssetId,
@@ -279,7 +669,6 @@ func (ingest *Ingestion) Trade(
 		counterAmount,
 		soldAssetId < boughtAssetId,
 	)
-	_, err = ingest.DB.Exec(sql)
 	if err != nil {
 		return errors.Wrap(err, "failed to exec sql")
 	}
@@ -288,20 +677,73 @@ func (ingest *Ingestion) Trade(
 }
 
 // Transaction ingests the provided transaction data into a new row in the
-// `history_transactions` table
+// `history_transactions` table.  `closedAt` is the close time of the ledger
+// that included the transaction.
 func (ingest *Ingestion) Transaction(
 	id int64,
 	tx *core.Transaction,
 	fee *core.TransactionFee,
+	closedAt time.Time,
 ) error {
 
-	sql := ingest.transactionInsertBuilder(id, tx, fee)
-	_, err := ingest.DB.Exec(sql)
+	_, err := ingest.applyOrderBase(tx.Index, toid.TransactionMask)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	values, err := ingest.transactionValues(id, tx, fee, closedAt)
+	if err != nil {
+		return err
+	}
+
+	err = ingest.insertRow(ingest.transactions, "history_transactions", values...)
+	if err != nil {
+		return err
+	}
+
+	if ingest.OnDuplicateTransaction == DuplicateTransactionFail {
+		if ingest.pendingTxHashes == nil {
+			ingest.pendingTxHashes = map[string]int32{}
+		}
+		ingest.pendingTxHashes[tx.TransactionHash] = tx.LedgerSequence
+	}
+
+	err = ingest.toSink(func(s Sink) error {
+		return s.Transaction(SinkTransaction{
+			ID:               id,
+			Hash:             tx.TransactionHash,
+			LedgerSequence:   tx.LedgerSequence,
+			ApplicationOrder: tx.Index + ingest.OrderBase,
+			Account:          ingest.rewriteAddress(tx.SourceAddress()),
+			FeePaid:          tx.Fee(),
+			OperationCount:   len(tx.Envelope.Tx.Operations),
+			Successful:       tx.IsSuccessful(),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	err = ingest.transactionMemo(id, tx)
+	if err != nil {
+		return err
+	}
+
+	if ingest.StoreMeta != MetaStoreSeparate {
+		return nil
+	}
+
+	stored, err := ingest.storedXDRs(tx.ResultXDR(), tx.ResultMetaXDR(), fee.ChangesXDR())
+	if err != nil {
+		return err
+	}
+
+	return ingest.insertRow(ingest.transactionXDR, "history_transaction_xdr",
+		id,
+		stored[0],
+		stored[1],
+		stored[2],
+	)
 }
 
 // TransactionParticipants ingests the provided account ids as participants of
@@ -309,26 +751,65 @@ func (ingest *Ingestion) Transaction(
 // `history_transaction_participants` table.
 func (ingest *Ingestion) TransactionParticipants(tx int64, aids []xdr.AccountId) error {
 	sql := ingest.transaction_participants
-	q := history.Q{Session: ingest.DB}
+	rows := 0
 
 	for _, aid := range aids {
-		haid, err := q.GetCreateAccountID(aid)
+		haid, err := ingest.getCreateAccountID(aid)
 		if err != nil {
 			return err
 		}
-		sql = sql.Values(tx, haid)
+
+		var added bool
+		sql, added, err = ingest.appendRow(sql, "history_transaction_participants", tx, haid)
+		if err != nil {
+			return err
+		}
+		if added {
+			rows++
+		}
 	}
 
-	_, err := ingest.DB.Exec(sql)
-	if err != nil {
-		return err
+	if rows == 0 {
+		return nil
 	}
 
-	return nil
+	return ingest.exec(sql)
+}
+
+// applyOrderBase returns `order` offset by the ingestion's OrderBase.  An error
+// is returned if the result falls outside of the range that can be encoded in
+// the toid component whose maximum value is `max`.
+func (ingest *Ingestion) applyOrderBase(order int32, max int64) (int32, error) {
+	result := int64(order) + int64(ingest.OrderBase)
+	if result < 0 || result > max {
+		return 0, errors.Errorf(
+			"application order %d with base %d overflows toid encoding",
+			order, ingest.OrderBase,
+		)
+	}
+
+	return int32(result), nil
+}
+
+// insert returns an insert into `table`, using the ingestion's
+// PlaceholderFormat.
+func (ingest *Ingestion) insert(table string) sq.InsertBuilder {
+	format := ingest.PlaceholderFormat
+	if format == nil {
+		format = sq.Dollar
+	}
+
+	return sq.Insert(table).PlaceholderFormat(format)
 }
 
 func (ingest *Ingestion) createInsertBuilders() {
-	ingest.ledgers = sq.Insert("history_ledgers").Columns(
+	ingest.insertColumns = map[string][]string{}
+	insert := func(table string, columns ...string) sq.InsertBuilder {
+		ingest.insertColumns[table] = columns
+		return ingest.insert(table).Columns(columns...)
+	}
+
+	ingest.ledgers = insert("history_ledgers",
 		"importer_version",
 		"id",
 		"sequence",
@@ -346,13 +827,20 @@ func (ingest *Ingestion) createInsertBuilders() {
 		"operation_count",
 		"protocol_version",
 		"ledger_header",
+		"close_time_version",
+		"partial",
+		"ingested_by",
+		"bucket_list_hash",
+		"tx_set_hash",
+		"tx_set_result_hash",
+		"scp_value",
 	)
 
-	ingest.accounts = sq.Insert("history_accounts").Columns(
+	ingest.accounts = insert("history_accounts",
 		"address",
 	)
 
-	ingest.transactions = sq.Insert("history_transactions").Columns(
+	ingest.transactions = insert("history_transactions",
 		"id",
 		"transaction_hash",
 		"ledger_sequence",
@@ -366,33 +854,51 @@ func (ingest *Ingestion) createInsertBuilders() {
 		"tx_meta",
 		"tx_fee_meta",
 		"signatures",
+		"signature_count",
 		"time_bounds",
 		"memo_type",
 		"memo",
 		"created_at",
 		"updated_at",
+		"inclusion_delay",
 	)
 
-	ingest.transaction_participants = sq.Insert("history_transaction_participants").Columns(
+	ingest.transaction_participants = insert("history_transaction_participants",
 		"history_transaction_id",
 		"history_account_id",
 	)
 
-	ingest.operations = sq.Insert("history_operations").Columns(
+	ingest.transactionMemos = insert("history_transaction_memos",
+		"history_transaction_id",
+		"memo_type",
+		"memo",
+	)
+
+	ingest.transactionXDR = insert("history_transaction_xdr",
+		"history_transaction_id",
+		"tx_result",
+		"tx_meta",
+		"tx_fee_meta",
+	)
+
+	ingest.operations = insert("history_operations",
 		"id",
 		"transaction_id",
 		"application_order",
 		"source_account",
 		"type",
 		"details",
+		"successful",
+		"operation_result_code",
 	)
 
-	ingest.operation_participants = sq.Insert("history_operation_participants").Columns(
+	ingest.operation_participants = insert("history_operation_participants",
 		"history_operation_id",
 		"history_account_id",
+		"role",
 	)
 
-	ingest.effects = sq.Insert("history_effects").Columns(
+	ingest.effects = insert("history_effects",
 		"history_account_id",
 		"history_operation_id",
 		"\"order\"",
@@ -400,7 +906,7 @@ func (ingest *Ingestion) createInsertBuilders() {
 		"details",
 	)
 
-	ingest.trades = sq.Insert("history_trades").Columns(
+	ingest.trades = insert("history_trades",
 		"history_operation_id",
 		"\"order\"",
 		"ledger_closed_at",
@@ -414,21 +920,436 @@ func (ingest *Ingestion) createInsertBuilders() {
 		"base_is_seller",
 	)
 
-	ingest.assetStats = sq.Insert("asset_stats").Columns(
+	ingest.assetStats = insert("asset_stats",
 		"id",
 		"amount",
 		"num_accounts",
 		"flags",
 		"toml",
 	)
+
+	ingest.accountFlags = insert("history_account_flags",
+		"history_operation_id",
+		"ledger_sequence",
+		"account",
+		"flags_before",
+		"flags_after",
+	)
+
+	ingest.ledgerChanges = insert("history_ledger_changes",
+		"history_operation_id",
+		"\"order\"",
+		"change_type",
+		"entry_type",
+		"account",
+		"asset",
+		"balance_before",
+		"balance_after",
+		"entry_before",
+		"entry_after",
+	)
+
+	ingest.ledgerStats = insert("history_ledger_stats",
+		"id",
+		"ledger_sequence",
+		"source_account_count",
+		"min_fee",
+		"median_fee",
+		"max_fee",
+		"operation_count",
+		"failed_transaction_count",
+	)
+
+	ingest.upgradeProposals = insert("history_upgrade_proposals",
+		"id",
+		"ledger_sequence",
+		"\"order\"",
+		"upgrade_type",
+		"value",
+		"upgrade_xdr",
+	)
 }
 
 func (ingest *Ingestion) commit() error {
-	err := ingest.DB.Commit()
+	if ingest.rollbackOnly {
+		return ingest.Rollback()
+	}
+
+	if ingest.timedOut() {
+		return ingest.abortTimedOut(nil)
+	}
+
+	ingest.debug.startFlush()
+
+	err := ingest.writeTradePairStats()
+	if err != nil {
+		ingest.debug.endFlush(false)
+		return ingest.abortTimedOut(err)
+	}
+
+	err = ingest.checkDuplicateTransactions()
+	if err != nil {
+		ingest.debug.endFlush(false)
+		return ingest.abortTimedOut(err)
+	}
+
+	ingest.stopWatchdog()
+	err = ingest.commitDB()
+	if err != nil && ingest.VerifyFailedCommits {
+		err = ingest.verifyCommit(err)
+	}
+	ingest.debug.endFlush(err == nil)
+	if err != nil {
+		return err
+	}
+	ingest.cachePendingAssetIDs()
+
+	// NOTE: the primary transaction has already been committed at this point.  A
+	// failure to commit to the secondary db leaves the two databases diverged,
+	// and the affected ledgers must be reingested into the secondary to repair
+	// it.
+	err = ingest.secondary(func(s *db.Session) error {
+		return s.Commit()
+	})
+	if err != nil {
+		return err
+	}
+
+	return ingest.toSink(func(s Sink) error {
+		return s.Commit()
+	})
+}
+
+// deleteRange deletes a range of rows from `table` in both the primary and, if
+// configured, the secondary db.
+func (ingest *Ingestion) deleteRange(
+	start, end int64,
+	table string,
+	idCol string,
+) error {
+	err := ingest.DB.DeleteRange(start, end, table, idCol)
+	if err != nil {
+		return err
+	}
+
+	return ingest.secondary(func(s *db.Session) error {
+		return s.DeleteRange(start, end, table, idCol)
+	})
+}
+
+// exec runs `sql` against the primary db and, if configured, the secondary db.
+func (ingest *Ingestion) exec(sql sq.Sqlizer) error {
+	if ingest.timedOut() {
+		return ingest.abortTimedOut(nil)
+	}
+
+	query, args, err := sql.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "to-sql failed")
+	}
+
+	_, err = ingest.DB.ExecRaw(query, args...)
 	if err != nil {
+		return ingest.abortTimedOut(err)
+	}
+	ingest.debug.addPending(query, len(args))
+
+	return ingest.secondary(func(s *db.Session) error {
+		_, err := s.ExecRaw(query, args...)
 		return err
+	})
+}
+
+// closeTimeVersions are the versions of close time semantics, each with the
+// first protocol version it applies to, in order.  Protocol 14 (CAP-0034)
+// made the close time of a ledger the one nominated along with its
+// transaction set, rather than one picked independently of it.
+var closeTimeVersions = []struct {
+	Protocol xdr.Uint32
+	Version  int32
+}{
+	{Protocol: 0, Version: 1},
+	{Protocol: 14, Version: 2},
+}
+
+// closeTimeVersion returns the version of close time semantics that applies to
+// ledgers closed under `protocolVersion`.  Consumers of `history_ledgers` can
+// use this value to correctly interpret `closed_at` for ranges that span a
+// protocol upgrade.  When a future protocol changes how stellar-core computes
+// close times, a new entry should be added to closeTimeVersions.
+func closeTimeVersion(protocolVersion xdr.Uint32) int32 {
+	version := closeTimeVersions[0].Version
+	for _, v := range closeTimeVersions {
+		if protocolVersion < v.Protocol {
+			break
+		}
+		version = v.Version
+	}
+
+	return version
+}
+
+// getCreateAccountID returns the history id for `aid`, creating it in the
+// primary db if needed.  When a secondary db is configured, the account row is
+// copied to it using the primary's id so that rows referencing the id are
+// consistent between the two.
+func (ingest *Ingestion) getCreateAccountID(aid xdr.AccountId) (int64, error) {
+	id, err := ingest.createAccountID(aid)
+	if err != nil {
+		return 0, err
 	}
 
+	err = ingest.secondary(func(s *db.Session) error {
+		_, err := s.ExecRaw(`
+			INSERT INTO history_accounts (id, address)
+			SELECT ?, ?
+			WHERE NOT EXISTS (SELECT 1 FROM history_accounts WHERE id = ?)`,
+			id, ingest.address(aid), id,
+		)
+		return err
+	})
+
+	return id, err
+}
+
+// createAccountID returns the history id for `aid` from the primary db,
+// creating it if needed.  The account is inserted within a savepoint: should
+// another session concurrently insert and commit the same account, such as a
+// historical reingest running alongside the live session, the resulting
+// unique violation is rolled back rather than aborting the ingestion's
+// transaction, and the id assigned by the other session is used instead.
+// Should the id assigned to the account already belong to another, the
+// insertion is retried with the next id.
+//
+// The address to id mapping is never cached: it is loaded from
+// history_accounts on every call, and rows of history_accounts are never
+// removed, neither when an account is merged nor when the history of a range
+// is cleared.  An account merged and later created anew at the same address
+// thus keeps the id first assigned to it, and an id is never reassigned to
+// another address.
+func (ingest *Ingestion) createAccountID(aid xdr.AccountId) (int64, error) {
+	q := history.Q{Session: ingest.DB}
+	address := ingest.address(aid)
+
+	var existing history.Account
+	err := q.AccountByAddress(&existing, address)
+	if err == nil {
+		return existing.ID, nil
+	}
+	if !q.NoRows(err) {
+		return 0, err
+	}
+
+	_, err = ingest.DB.ExecRaw(`SAVEPOINT create_account`)
+	if err != nil {
+		return 0, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		id, ierr := ingest.insertAccount(address, attempt)
+		if ierr == nil {
+			_, err = ingest.DB.ExecRaw(`RELEASE SAVEPOINT create_account`)
+			return id, err
+		}
+
+		if !isUniqueViolation(ierr) {
+			return 0, ierr
+		}
+
+		_, err = ingest.DB.ExecRaw(`ROLLBACK TO SAVEPOINT create_account`)
+		if err != nil {
+			return 0, err
+		}
+
+		if !isAccountIDCollision(ierr) {
+			break
+		}
+
+		if attempt+1 == maxAccountIDAttempts {
+			return 0, errors.Wrapf(ierr, "no free id found for account %s", address)
+		}
+	}
+
+	err = q.AccountByAddress(&existing, address)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to load concurrently created account")
+	}
+
+	return existing.ID, nil
+}
+
+// isUniqueViolation returns true if `err` was caused by the violation of a
+// unique constraint.
+func isUniqueViolation(err error) bool {
+	pqErr, ok := errors.Cause(err).(*pq.Error)
+	return ok && pqErr.Code == "23505"
+}
+
+// getCreateAssetID returns the history id for `asset`, creating it in the
+// primary db if needed.  Like getCreateAccountID, the row is copied to the
+// secondary db when one is configured.  The asset is validated first; see
+// validateAsset.  The primary db is only queried when the asset misses the
+// ingestion's AssetIDCache, if any.
+func (ingest *Ingestion) getCreateAssetID(asset xdr.Asset) (int64, error) {
+	asset, err := ingest.validateAsset(asset)
+	if err != nil {
+		return 0, err
+	}
+
+	id, ok := ingest.cachedAssetID(asset)
+	if !ok {
+		id, err = ingest.createAssetID(asset)
+		if err != nil {
+			return 0, err
+		}
+		ingest.addPendingAssetID(asset, id)
+	}
+
+	err = ingest.secondary(func(s *db.Session) error {
+		assetType, assetCode, assetIssuer, err := ingest.extractAsset(asset)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.ExecRaw(`
+			INSERT INTO history_assets (id, asset_type, asset_code, asset_issuer)
+			SELECT ?, ?, ?, ?
+			WHERE NOT EXISTS (SELECT 1 FROM history_assets WHERE id = ?)`,
+			id, assetType, assetCode, assetIssuer, id,
+		)
+		return err
+	})
+
+	return id, err
+}
+
+// createAssetID returns the history id for `asset` from the primary db,
+// creating it if needed.  As with accounts, the asset is inserted within a
+// savepoint: should another session concurrently insert and commit the same
+// asset, the resulting unique violation is rolled back rather than aborting
+// the ingestion's transaction, and the id assigned by the other session is
+// used instead.  See createAccountID.
+func (ingest *Ingestion) createAssetID(asset xdr.Asset) (int64, error) {
+	assetType, assetCode, assetIssuer, err := ingest.extractAsset(asset)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	load := func() error {
+		return ingest.DB.Get(&id, sq.Select("id").From("history_assets").Where(sq.Eq{
+			"asset_type":   assetType,
+			"asset_code":   assetCode,
+			"asset_issuer": assetIssuer,
+		}))
+	}
+
+	err = load()
+	if err == nil || !ingest.DB.NoRows(err) {
+		return id, err
+	}
+
+	_, err = ingest.DB.ExecRaw(`SAVEPOINT create_asset`)
+	if err != nil {
+		return 0, err
+	}
+
+	ierr := ingest.DB.GetRaw(&id,
+		`INSERT INTO history_assets (asset_type, asset_code, asset_issuer) VALUES (?,?,?) RETURNING id`,
+		assetType, assetCode, assetIssuer,
+	)
+	if ierr == nil {
+		_, err = ingest.DB.ExecRaw(`RELEASE SAVEPOINT create_asset`)
+		return id, err
+	}
+
+	if !isUniqueViolation(ierr) {
+		return 0, ierr
+	}
+
+	_, err = ingest.DB.ExecRaw(`ROLLBACK TO SAVEPOINT create_asset`)
+	if err != nil {
+		return 0, err
+	}
+
+	err = load()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to load concurrently created asset")
+	}
+
+	return id, nil
+}
+
+// mirrorTrade writes a trade inserted into the primary db by
+// `history.Q.InsertTrade` to the secondary db, if one is configured.  The
+// accounts and assets involved are copied first, ensuring the secondary
+// resolves them to the same ids as the primary.
+func (ingest *Ingestion) mirrorTrade(
+	opid int64,
+	order int32,
+	buyer xdr.AccountId,
+	trade xdr.ClaimOfferAtom,
+	price xdr.Price,
+	remaining *xdr.Int64,
+	ledgerClosedAt sTime.Millis,
+) error {
+	if ingest.SecondaryDB == nil || ingest.secondaryErr != nil {
+		return nil
+	}
+
+	var ids [4]int64
+	for i, aid := range []xdr.AccountId{trade.SellerId, buyer} {
+		id, err := ingest.getCreateAccountID(aid)
+		if err != nil {
+			return err
+		}
+		ids[i] = id
+	}
+
+	for i, asset := range []xdr.Asset{trade.AssetSold, trade.AssetBought} {
+		id, err := ingest.getCreateAssetID(asset)
+		if err != nil {
+			return err
+		}
+		ids[2+i] = id
+	}
+
+	return ingest.secondary(func(s *db.Session) error {
+		q := history.Q{Session: s}
+		return q.InsertTradeWithIDs(
+			opid, order, ids[0], ids[1], trade, ids[2], ids[3], price, remaining, ledgerClosedAt,
+		)
+	})
+}
+
+// secondary runs `fn` against the secondary db, provided one is configured and
+// it has not failed during the current transaction.
+func (ingest *Ingestion) secondary(fn func(*db.Session) error) error {
+	if ingest.SecondaryDB == nil || ingest.secondaryErr != nil {
+		return nil
+	}
+
+	err := fn(ingest.SecondaryDB)
+	if err == nil {
+		return nil
+	}
+
+	return ingest.secondaryFailed(err)
+}
+
+// secondaryFailed records a failure to write to the secondary db.  In strict
+// mode the error is returned so that the ingestion aborts.  Otherwise the
+// secondary's transaction is abandoned and further writes to it are skipped
+// until the next call to Start.
+func (ingest *Ingestion) secondaryFailed(err error) error {
+	err = errors.Wrap(err, "secondary db write failed")
+	if ingest.SecondaryStrict {
+		return err
+	}
+
+	log.WithField("err", err).Warn("ingest: suspending writes to secondary db")
+	ingest.secondaryErr = err
+	ingest.SecondaryDB.Rollback()
 	return nil
 }
 
@@ -443,3 +1364,15 @@ func (ingest *Ingestion) formatTimeBounds(bounds *xdr.TimeBounds) interface{} {
 
 	return sq.Expr("?::int8range", fmt.Sprintf("[%d,%d]", bounds.MinTime, bounds.MaxTime))
 }
+
+// inclusionDelay returns the number of seconds between the min time bound of
+// `tx` and `closedAt`, the close time of the ledger that included it, or null
+// when IngestInclusionDelay is not set or `tx` has no min time bound.
+func (ingest *Ingestion) inclusionDelay(tx *core.Transaction, closedAt time.Time) null.Int {
+	bounds := tx.Envelope.Tx.TimeBounds
+	if !ingest.IngestInclusionDelay || bounds == nil || bounds.MinTime == 0 {
+		return null.Int{}
+	}
+
+	return null.IntFrom(closedAt.Unix() - int64(bounds.MinTime))
+}
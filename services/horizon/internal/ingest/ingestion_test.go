@@ -1,16 +1,21 @@
 package ingest
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/guregu/null"
 	"github.com/stellar/go/services/horizon/internal/db2/core"
 	"github.com/stellar/go/services/horizon/internal/db2/history"
 	"github.com/stellar/go/services/horizon/internal/test"
 	testDB "github.com/stellar/go/services/horizon/internal/test/db"
+	"github.com/stellar/go/services/horizon/internal/toid"
 	"github.com/stellar/go/support/db"
 	"github.com/stellar/go/xdr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEmptySignature(t *testing.T) {
@@ -40,19 +45,54 @@ func TestEmptySignature(t *testing.T) {
 
 	transactionFee := &core.TransactionFee{}
 
-	builder := ingestion.transactionInsertBuilder(1, transaction, transactionFee)
+	closedAt := time.Unix(1510000000, 0).UTC()
+	values, err := ingestion.transactionValues(1, transaction, transactionFee, closedAt)
+	assert.NoError(t, err)
+	builder := ingestion.transactions.Values(values...)
 	sql, args, err := builder.ToSql()
-	assert.Equal(t, "INSERT INTO history_transactions (id,transaction_hash,ledger_sequence,application_order,account,account_sequence,fee_paid,operation_count,tx_envelope,tx_result,tx_meta,tx_fee_meta,signatures,time_bounds,memo_type,memo,created_at,updated_at) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?::character varying[],?,?,?,?,?)", sql)
+	assert.Equal(t, "INSERT INTO history_transactions (id,transaction_hash,ledger_sequence,application_order,account,account_sequence,fee_paid,operation_count,tx_envelope,tx_result,tx_meta,tx_fee_meta,signatures,signature_count,time_bounds,memo_type,memo,created_at,updated_at,inclusion_delay) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?::character varying[],?,?,?,?,?,?,?)", sql)
 	assert.Equal(t, `{"8qkkeKaKfsbgInyIkzXJhqJE5/Ufxri2LdxmyKkgkT6I3sPmvrs5cPWQSzEQyhV750IW2ds97xTHqTpOfuZCAg==",""}`, args[12])
+	assert.Equal(t, 2, args[13])
 	assert.NoError(t, err)
 
-	err = ingestion.Transaction(1, transaction, transactionFee)
+	err = ingestion.Transaction(1, transaction, transactionFee, closedAt)
 	assert.NoError(t, err)
 
 	err = ingestion.Close()
 	assert.NoError(t, err)
 }
 
+func TestInclusionDelay(t *testing.T) {
+	closedAt := time.Unix(1510000060, 0).UTC()
+	transaction := func(bounds *xdr.TimeBounds) *core.Transaction {
+		tx := &core.Transaction{}
+		tx.Envelope.Tx.TimeBounds = bounds
+		return tx
+	}
+
+	bounded := transaction(&xdr.TimeBounds{MinTime: 1510000000, MaxTime: 1510000100})
+	minOnly := transaction(&xdr.TimeBounds{MinTime: 1510000045})
+	maxOnly := transaction(&xdr.TimeBounds{MaxTime: 1510000100})
+	unbounded := transaction(nil)
+
+	// not stored by default
+	var ingestion Ingestion
+	assert.False(t, ingestion.inclusionDelay(bounded, closedAt).Valid)
+
+	ingestion.IngestInclusionDelay = true
+	delay := ingestion.inclusionDelay(bounded, closedAt)
+	assert.True(t, delay.Valid)
+	assert.Equal(t, int64(60), delay.Int64)
+
+	delay = ingestion.inclusionDelay(minOnly, closedAt)
+	assert.True(t, delay.Valid)
+	assert.Equal(t, int64(15), delay.Int64)
+
+	// null without a min time bound
+	assert.False(t, ingestion.inclusionDelay(maxOnly, closedAt).Valid)
+	assert.False(t, ingestion.inclusionDelay(unbounded, closedAt).Valid)
+}
+
 func TestAssetIngest(t *testing.T) {
 	//ingest kahuna and sample a single expected asset output
 
@@ -160,3 +200,184 @@ func TestTradeIngestTimestamp(t *testing.T) {
 
 	tt.Require.Equal(trades[len(trades)-1].LedgerCloseTime, ledgers[len(ledgers)-1].ClosedAt)
 }
+
+func TestCloseTimeVersion(t *testing.T) {
+	assert.Equal(t, int32(1), closeTimeVersion(0))
+	assert.Equal(t, int32(1), closeTimeVersion(9))
+	assert.Equal(t, int32(1), closeTimeVersion(13))
+	assert.Equal(t, int32(2), closeTimeVersion(14))
+	assert.Equal(t, int32(2), closeTimeVersion(15))
+}
+
+func TestLedgerIngest_CloseTimeVersion(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	var headers []core.LedgerHeader
+	tt.Require.NoError(tt.CoreSession().SelectRaw(&headers, `
+		SELECT * FROM ledgerheaders ORDER BY ledgerseq
+	`))
+	tt.Require.NotEmpty(headers)
+
+	for _, header := range headers {
+		var version int32
+		tt.Require.NoError(tt.HorizonSession().GetRaw(&version, `
+			SELECT close_time_version FROM history_ledgers WHERE sequence = ?
+		`, header.Sequence))
+		tt.Assert.Equal(closeTimeVersion(header.Data.LedgerVersion), version, "ledger %d", header.Sequence)
+	}
+}
+
+func TestApplyOrderBase(t *testing.T) {
+	ingestion := Ingestion{OrderBase: 10}
+
+	order, err := ingestion.applyOrderBase(1, toid.OperationMask)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(11), order)
+
+	_, err = ingestion.applyOrderBase(toid.OperationMask, toid.OperationMask)
+	assert.Error(t, err)
+
+	ingestion.OrderBase = -2
+	_, err = ingestion.applyOrderBase(1, toid.TransactionMask)
+	assert.Error(t, err)
+}
+
+func TestEffectRaw(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	ingestion := Ingestion{DB: tt.HorizonSession()}
+	ingestion.Start()
+
+	details := map[string]interface{}{
+		"amount":     "10.0000000",
+		"asset_type": "native",
+	}
+	raw, err := marshalDetails(details)
+	tt.Require.NoError(err)
+
+	tt.Require.NoError(ingestion.Effect(1, 10, 0, history.EffectAccountCredited, details))
+	tt.Require.NoError(ingestion.EffectRaw(2, 10, 1, history.EffectAccountDebited, raw))
+	tt.Require.NoError(ingestion.Close())
+
+	var stored []string
+	err = tt.HorizonSession().SelectRaw(&stored, `SELECT details FROM history_effects WHERE history_operation_id = ? ORDER BY "order"`, 10)
+	tt.Require.NoError(err)
+	tt.Require.Len(stored, 2)
+	tt.Assert.Equal(stored[0], stored[1])
+}
+
+func TestEffectIngestion_Order(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	ingestion := Ingestion{DB: tt.HorizonSession()}
+	ingestion.Start()
+
+	var aid xdr.AccountId
+	tt.Require.NoError(aid.SetAddress("GAXMF43TGZHW3QN3REOUA2U5PW5BTARXGGYJ3JIFHW3YT6QRKRL3CPPU"))
+
+	effects := ingestion.Effects(10)
+	tt.Assert.True(effects.Add(aid, history.EffectAccountCredited, map[string]interface{}{"amount": "1.0000000"}))
+	tt.Assert.True(effects.Add(aid, history.EffectAccountDebited, map[string]interface{}{"amount": "1.0000000"}))
+	tt.Assert.True(effects.Add(aid, history.EffectTrade, map[string]interface{}{}))
+	tt.Require.NoError(effects.Finish())
+	tt.Require.NoError(ingestion.Close())
+
+	var orders []int
+	err := tt.HorizonSession().SelectRaw(&orders, `SELECT "order" FROM history_effects WHERE history_operation_id = ? ORDER BY "order"`, 10)
+	tt.Require.NoError(err)
+	tt.Assert.Equal([]int{1, 2, 3}, orders)
+}
+
+func TestCreateInsertBuilders_PlaceholderFormat(t *testing.T) {
+	ingestion := Ingestion{}
+	ingestion.createInsertBuilders()
+
+	sql, _, err := ingestion.operation_participants.Values(1, 2).ToSql()
+	if assert.NoError(t, err) {
+		assert.Contains(t, sql, "VALUES ($1,$2)")
+	}
+
+	ingestion.PlaceholderFormat = sq.Question
+	ingestion.createInsertBuilders()
+
+	sql, _, err = ingestion.operation_participants.Values(1, 2).ToSql()
+	if assert.NoError(t, err) {
+		assert.Contains(t, sql, "VALUES (?,?)")
+	}
+}
+
+func upgradeXDR(t *testing.T, typ xdr.LedgerUpgradeType, value uint32) xdr.UpgradeType {
+	upgrade, err := xdr.NewLedgerUpgrade(typ, xdr.Uint32(value))
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	_, err = xdr.Marshal(&b, upgrade)
+	require.NoError(t, err)
+	return xdr.UpgradeType(b.Bytes())
+}
+
+func TestIngestion_UpgradeProposals(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	header := &core.LedgerHeader{Sequence: 5}
+	header.Data.ScpValue.Upgrades = []xdr.UpgradeType{
+		upgradeXDR(t, xdr.LedgerUpgradeTypeLedgerUpgradeVersion, 10),
+		upgradeXDR(t, xdr.LedgerUpgradeTypeLedgerUpgradeBaseFee, 200),
+		upgradeXDR(t, xdr.LedgerUpgradeTypeLedgerUpgradeMaxTxSetSize, 500),
+		// an upgrade of an unknown type
+		xdr.UpgradeType{0, 0, 0, 99, 0, 0, 0, 1},
+	}
+
+	ingestion := &Ingestion{DB: tt.HorizonSession()}
+	tt.Require.NoError(ingestion.Start())
+	tt.Require.NoError(ingestion.UpgradeProposals(42, header))
+	tt.Require.NoError(ingestion.Close())
+
+	var proposals []history.UpgradeProposal
+	tt.Require.NoError(tt.HorizonSession().SelectRaw(&proposals, `
+		SELECT * FROM history_upgrade_proposals ORDER BY id, "order"
+	`))
+
+	tt.Assert.Equal([]history.UpgradeProposal{{
+		TotalOrderID:   history.TotalOrderID{ID: 42},
+		LedgerSequence: 5,
+		Order:          0,
+		Type:           null.IntFrom(int64(xdr.LedgerUpgradeTypeLedgerUpgradeVersion)),
+		Value:          null.IntFrom(10),
+		UpgradeXDR:     "AAAAAQAAAAo=",
+	}, {
+		TotalOrderID:   history.TotalOrderID{ID: 42},
+		LedgerSequence: 5,
+		Order:          1,
+		Type:           null.IntFrom(int64(xdr.LedgerUpgradeTypeLedgerUpgradeBaseFee)),
+		Value:          null.IntFrom(200),
+		UpgradeXDR:     "AAAAAgAAAMg=",
+	}, {
+		TotalOrderID:   history.TotalOrderID{ID: 42},
+		LedgerSequence: 5,
+		Order:          2,
+		Type:           null.IntFrom(int64(xdr.LedgerUpgradeTypeLedgerUpgradeMaxTxSetSize)),
+		Value:          null.IntFrom(500),
+		UpgradeXDR:     "AAAAAwAAAfQ=",
+	}, {
+		TotalOrderID:   history.TotalOrderID{ID: 42},
+		LedgerSequence: 5,
+		Order:          3,
+		UpgradeXDR:     "AAAAYwAAAAE=",
+	}}, proposals)
+
+	// a header without upgrades adds no rows
+	tt.Require.NoError(ingestion.Start())
+	tt.Require.NoError(ingestion.UpgradeProposals(43, &core.LedgerHeader{Sequence: 6}))
+	tt.Require.NoError(ingestion.Close())
+
+	var n int
+	tt.Require.NoError(tt.HorizonSession().GetRaw(&n, `SELECT COUNT(*) FROM history_upgrade_proposals`))
+	tt.Assert.Equal(len(proposals), n)
+}
@@ -0,0 +1,37 @@
+package ingest
+
+import (
+	"database/sql"
+
+	"github.com/stellar/go/support/errors"
+)
+
+// isolationLevels maps the isolation levels supported by IsolationLevel onto
+// their sql.  Postgres runs read uncommitted transactions as read committed,
+// so that level is left out.
+var isolationLevels = map[sql.IsolationLevel]string{
+	sql.LevelReadCommitted:  "READ COMMITTED",
+	sql.LevelRepeatableRead: "REPEATABLE READ",
+	sql.LevelSerializable:   "SERIALIZABLE",
+}
+
+// setIsolationLevel applies IsolationLevel to the transaction just opened on
+// DB.  It must run before any other statement of the transaction, so it does
+// nothing when WithinTransaction is set: the level is the outer transaction's.
+func (ingest *Ingestion) setIsolationLevel() error {
+	if ingest.IsolationLevel == sql.LevelDefault || ingest.WithinTransaction {
+		return nil
+	}
+
+	level, ok := isolationLevels[ingest.IsolationLevel]
+	if !ok {
+		return errors.Errorf("unsupported isolation level: %s", ingest.IsolationLevel)
+	}
+
+	_, err := ingest.DB.ExecRaw("SET TRANSACTION ISOLATION LEVEL " + level)
+	if err != nil {
+		return errors.Wrap(err, "failed to set isolation level")
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package ingest
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/test"
+)
+
+func TestIngestion_IsolationLevel(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	level := func(ingestion *Ingestion) string {
+		tt.Require.NoError(ingestion.Start())
+		defer ingestion.Rollback()
+
+		var level string
+		tt.Require.NoError(ingestion.DB.GetRaw(&level, "SHOW transaction_isolation"))
+		return level
+	}
+
+	// the session's default is unchanged
+	tt.Assert.Equal("read committed", level(&Ingestion{DB: tt.HorizonSession()}))
+
+	cases := map[sql.IsolationLevel]string{
+		sql.LevelReadCommitted:  "read committed",
+		sql.LevelRepeatableRead: "repeatable read",
+		sql.LevelSerializable:   "serializable",
+	}
+	for isolation, expected := range cases {
+		ingestion := &Ingestion{DB: tt.HorizonSession(), IngestionOptions: IngestionOptions{IsolationLevel: isolation}}
+		tt.Assert.Equal(expected, level(ingestion))
+	}
+
+	// applied again to the transaction started by each flush
+	ingestion := &Ingestion{DB: tt.HorizonSession(), IngestionOptions: IngestionOptions{IsolationLevel: sql.LevelSerializable}}
+	tt.Require.NoError(ingestion.Start())
+	tt.Require.NoError(ingestion.Flush())
+	var flushed string
+	tt.Require.NoError(ingestion.DB.GetRaw(&flushed, "SHOW transaction_isolation"))
+	tt.Assert.Equal("serializable", flushed)
+	tt.Require.NoError(ingestion.Rollback())
+
+	ingestion = &Ingestion{DB: tt.HorizonSession(), IngestionOptions: IngestionOptions{IsolationLevel: sql.LevelSnapshot}}
+	tt.Assert.Error(ingestion.Start())
+}
@@ -1,9 +1,12 @@
 package ingest
 
 import (
+	sq "github.com/Masterminds/squirrel"
 	"github.com/stellar/go/services/horizon/internal/db2/core"
+	"github.com/stellar/go/services/horizon/internal/log"
 	"github.com/stellar/go/support/db"
 	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
 )
 
 // Load runs queries against `core` to fill in the records of the bundle.
@@ -15,6 +18,10 @@ func (lb *LedgerBundle) Load(db *db.Session) error {
 		return errors.Wrap(err, "failed to load header")
 	}
 
+	if lb.XDRErrorPolicy == XDRErrorSkipTransaction {
+		return lb.loadSkippingInvalid(q)
+	}
+
 	// Load transactions
 	err = q.TransactionsByLedger(&lb.Transactions, lb.Sequence)
 	if err != nil {
@@ -28,3 +35,115 @@ func (lb *LedgerBundle) Load(db *db.Session) error {
 
 	return nil
 }
+
+// rawTransaction is a row of the `txhistory` table, joined with its row of
+// the `txfeehistory` table, before its xdr is decoded.
+type rawTransaction struct {
+	TransactionHash string `db:"txid"`
+	LedgerSequence  int32  `db:"ledgerseq"`
+	Index           int32  `db:"txindex"`
+	Envelope        string `db:"txbody"`
+	Result          string `db:"txresult"`
+	ResultMeta      string `db:"txmeta"`
+	FeeChanges      string `db:"txchanges"`
+}
+
+// decode decodes the xdr of the row into `tx` and `fee`.
+func (raw *rawTransaction) decode(tx *core.Transaction, fee *core.TransactionFee) error {
+	tx.TransactionHash = raw.TransactionHash
+	tx.LedgerSequence = raw.LedgerSequence
+	tx.Index = raw.Index
+	fee.TransactionHash = raw.TransactionHash
+	fee.LedgerSequence = raw.LedgerSequence
+	fee.Index = raw.Index
+
+	err := xdr.SafeUnmarshalBase64(raw.Envelope, &tx.Envelope)
+	if err != nil {
+		return errors.Wrap(err, "invalid envelope")
+	}
+	err = xdr.SafeUnmarshalBase64(raw.Result, &tx.Result)
+	if err != nil {
+		return errors.Wrap(err, "invalid result")
+	}
+	err = xdr.SafeUnmarshalBase64(raw.ResultMeta, &tx.ResultMeta)
+	if err != nil {
+		return errors.Wrap(err, "invalid meta")
+	}
+	err = xdr.SafeUnmarshalBase64(raw.FeeChanges, &fee.Changes)
+	if err != nil {
+		return errors.Wrap(err, "invalid fee meta")
+	}
+
+	return nil
+}
+
+// loadSkippingInvalid loads the transactions of the bundle, decoding each
+// transaction separately so that one whose xdr cannot be decoded is recorded
+// as skipped rather than failing the load.
+func (lb *LedgerBundle) loadSkippingInvalid(q *core.Q) error {
+	var rows []rawTransaction
+	sql := sq.Select(
+		"ctxh.txid",
+		"ctxh.ledgerseq",
+		"ctxh.txindex",
+		"ctxh.txbody",
+		"ctxh.txresult",
+		"ctxh.txmeta",
+		"COALESCE(ctxfh.txchanges, '') AS txchanges",
+	).
+		From("txhistory ctxh").
+		LeftJoin("txfeehistory ctxfh ON ctxfh.ledgerseq = ctxh.ledgerseq AND ctxfh.txindex = ctxh.txindex").
+		Where("ctxh.ledgerseq = ?", lb.Sequence).
+		OrderBy("ctxh.txindex ASC")
+
+	err := q.Select(&rows, sql)
+	if err != nil {
+		return errors.Wrap(err, "failed to load transactions")
+	}
+
+	lb.Transactions = make([]core.Transaction, len(rows))
+	lb.TransactionFees = make([]core.TransactionFee, len(rows))
+	lb.Skipped = nil
+
+	for i := range rows {
+		err = rows[i].decode(&lb.Transactions[i], &lb.TransactionFees[i])
+		if err == nil {
+			continue
+		}
+
+		skipped := SkippedTransaction{
+			LedgerSequence: rows[i].LedgerSequence,
+			Index:          rows[i].Index,
+			Hash:           rows[i].TransactionHash,
+			Reason:         err.Error(),
+			position:       i,
+		}
+		lb.Skipped = append(lb.Skipped, skipped)
+
+		// leave only an empty placeholder in the transaction's position
+		lb.Transactions[i] = core.Transaction{
+			TransactionHash: skipped.Hash,
+			LedgerSequence:  skipped.LedgerSequence,
+			Index:           skipped.Index,
+		}
+		lb.TransactionFees[i] = core.TransactionFee{}
+
+		log.WithField("ledger", skipped.LedgerSequence).
+			WithField("tx", skipped.Hash).
+			WithField("reason", skipped.Reason).
+			Warn("ingest: skipping transaction with invalid xdr")
+	}
+
+	return nil
+}
+
+// isSkipped returns true if the transaction at position `i` of the bundle's
+// Transactions was skipped.
+func (lb *LedgerBundle) isSkipped(i int) bool {
+	for _, skipped := range lb.Skipped {
+		if skipped.position == i {
+			return true
+		}
+	}
+	return false
+}
@@ -3,7 +3,14 @@ package ingest
 import (
 	"testing"
 
+	"github.com/guregu/null"
+	"github.com/stellar/go/services/horizon/internal/db2/core"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
 	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/services/horizon/internal/toid"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLedgerBundleLoad(t *testing.T) {
@@ -19,3 +26,111 @@ func TestLedgerBundleLoad(t *testing.T) {
 		tt.Assert.Len(bundle.TransactionFees, 3)
 	}
 }
+
+func TestLedgerBundleLoad_InvalidXDR(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	// corrupt the envelope of the second transaction of ledger 2
+	var hash string
+	err := tt.CoreSession().GetRaw(&hash,
+		`SELECT txid FROM txhistory WHERE ledgerseq = 2 ORDER BY txindex LIMIT 1 OFFSET 1`,
+	)
+	tt.Require.NoError(err)
+	_, err = tt.CoreSession().ExecRaw(`UPDATE txhistory SET txbody = 'AAAA' WHERE txid = ?`, hash)
+	tt.Require.NoError(err)
+
+	// the default policy fails the load
+	bundle := &LedgerBundle{Sequence: 2}
+	tt.Assert.Error(bundle.Load(tt.CoreSession()))
+
+	bundle = &LedgerBundle{Sequence: 2, XDRErrorPolicy: XDRErrorSkipTransaction}
+	err = bundle.Load(tt.CoreSession())
+	tt.Require.NoError(err)
+	tt.Assert.Len(bundle.Transactions, 3)
+	tt.Assert.Len(bundle.TransactionFees, 3)
+	tt.Require.Len(bundle.Skipped, 1)
+	tt.Assert.Equal(hash, bundle.Skipped[0].Hash)
+	tt.Assert.Contains(bundle.Skipped[0].Reason, "invalid envelope")
+	tt.Assert.True(bundle.isSkipped(1))
+	tt.Assert.False(bundle.isSkipped(0))
+
+	// the rest of the ledger is ingested, keeping the ids of the transactions
+	// that follow the skipped one
+	sys := sys(tt)
+	sys.XDRErrorPolicy = XDRErrorSkipTransaction
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, 2, sys)
+	s.Run()
+	tt.Require.NoError(s.Err)
+	tt.Require.Len(s.Skipped, 1)
+	tt.Assert.Equal(hash, s.Skipped[0].Hash)
+
+	var ids []int64
+	err = tt.HorizonSession().SelectRaw(&ids, `SELECT id FROM history_transactions WHERE ledger_sequence = 2 ORDER BY id`)
+	tt.Require.NoError(err)
+	tt.Assert.Equal([]int64{toid.New(2, 1, 0).ToInt64(), toid.New(2, 3, 0).ToInt64()}, ids)
+
+	var txCount int
+	err = tt.HorizonSession().GetRaw(&txCount, `SELECT transaction_count FROM history_ledgers WHERE sequence = 2`)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(2, txCount)
+}
+
+func TestLedgerBundle_Stats(t *testing.T) {
+	addresses := []string{
+		"GDFHTQSNHSYOO5KVISZZMX5FZJHPNOW4QM2S5SKPGVWTKYA5EHH763AK",
+		"GDBAV5OYYLXE56OOEKHCZFZPGABACG53M4DOUHRDPYY7R6F2Z2TRTCV4",
+	}
+	sources := make([]xdr.AccountId, len(addresses))
+	for i, address := range addresses {
+		require.NoError(t, sources[i].SetAddress(address))
+	}
+
+	tx := func(source int, fee uint32, ops int, code xdr.TransactionResultCode) core.Transaction {
+		var tx core.Transaction
+		tx.Envelope.Tx.SourceAccount = sources[source]
+		tx.Envelope.Tx.Fee = xdr.Uint32(fee)
+		tx.Envelope.Tx.Operations = make([]xdr.Operation, ops)
+		tx.Result.Result.Result.Code = code
+		return tx
+	}
+
+	bundle := &LedgerBundle{
+		Sequence: 7,
+		Transactions: []core.Transaction{
+			tx(0, 100, 1, xdr.TransactionResultCodeTxSuccess),
+			tx(0, 400, 3, xdr.TransactionResultCodeTxFailed),
+			// skipped, so never counted
+			tx(1, 10000, 5, xdr.TransactionResultCodeTxSuccess),
+			tx(1, 1000, 2, xdr.TransactionResultCodeTxSuccess),
+			tx(0, 200, 1, xdr.TransactionResultCodeTxBadSeq),
+		},
+		Skipped: []SkippedTransaction{{position: 2}},
+	}
+
+	// fees of 100, 200, 400 and 1000 have a median of (200 + 400) / 2
+	assert.Equal(t, history.LedgerStats{
+		TotalOrderID:           history.TotalOrderID{ID: 42},
+		LedgerSequence:         7,
+		SourceAccountCount:     2,
+		MinFee:                 null.IntFrom(100),
+		MedianFee:              null.IntFrom(300),
+		MaxFee:                 null.IntFrom(1000),
+		OperationCount:         7,
+		FailedTransactionCount: 2,
+	}, bundle.stats(42))
+
+	// the median of an odd number of fees is the middle one
+	bundle.Skipped = nil
+	stats := bundle.stats(42)
+	assert.Equal(t, null.IntFrom(400), stats.MedianFee)
+	assert.Equal(t, null.IntFrom(10000), stats.MaxFee)
+
+	// a ledger without transactions has no fees
+	stats = (&LedgerBundle{Sequence: 8}).stats(43)
+	assert.Equal(t, history.LedgerStats{
+		TotalOrderID:   history.TotalOrderID{ID: 43},
+		LedgerSequence: 8,
+	}, stats)
+}
@@ -0,0 +1,195 @@
+package ingest
+
+import (
+	"github.com/guregu/null"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// LedgerChanges records the ledger entry changes caused by an operation into
+// the history_ledger_changes table.
+func (ingest *Ingestion) LedgerChanges(
+	opid int64,
+	changes xdr.LedgerEntryChanges,
+) error {
+	rows, err := ingest.ledgerChangeRows(opid, changes)
+	if err != nil {
+		return err
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	sql := ingest.ledgerChanges
+	added := 0
+	for _, row := range rows {
+		var ok bool
+		sql, ok, err = ingest.appendRow(sql, "history_ledger_changes",
+			row.HistoryOperationID,
+			row.Order,
+			row.ChangeType,
+			row.EntryType,
+			row.Account,
+			row.Asset,
+			row.BalanceBefore,
+			row.BalanceAfter,
+			row.EntryBefore,
+			row.EntryAfter,
+		)
+		if err != nil {
+			return err
+		}
+		if ok {
+			added++
+		}
+	}
+
+	if added == 0 {
+		return nil
+	}
+
+	return ingest.exec(sql)
+}
+
+// ledgerChangeRows converts the changes caused by an operation into rows for
+// the history_ledger_changes table.
+func (ingest *Ingestion) ledgerChangeRows(
+	opid int64,
+	changes xdr.LedgerEntryChanges,
+) ([]history.LedgerChange, error) {
+	var rows []history.LedgerChange
+
+	for _, change := range entryChanges(changes) {
+		account := ledgerKeyAccount(change.Key)
+		row := history.LedgerChange{
+			HistoryOperationID: opid,
+			Order:              int32(len(rows)),
+			ChangeType:         change.Type,
+			EntryType:          change.Key.Type,
+			Account:            ingest.address(account),
+		}
+
+		if change.Key.Type == xdr.LedgerEntryTypeTrustline {
+			row.Asset = null.StringFrom(ingest.assetString(change.Key.MustTrustLine().Asset))
+		}
+
+		var err error
+		row.BalanceBefore, row.EntryBefore, err = ledgerChangeEntry(change.Before)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encode entry before change")
+		}
+
+		row.BalanceAfter, row.EntryAfter, err = ledgerChangeEntry(change.After)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encode entry after change")
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// entryChange is a change to a single ledger entry, holding the entry's state
+// before and after the change.  Before is nil for created entries and After is
+// nil for removed entries.
+type entryChange struct {
+	Key    xdr.LedgerKey
+	Type   xdr.LedgerEntryChangeType
+	Before *xdr.LedgerEntry
+	After  *xdr.LedgerEntry
+}
+
+// entryChanges pairs up the states of the entries modified by `changes`.
+// stellar-core emits a STATE change holding the prior value of an entry
+// directly before each UPDATED or REMOVED change to it; these are folded into
+// the change that follows them.
+func entryChanges(changes xdr.LedgerEntryChanges) []entryChange {
+	var (
+		result []entryChange
+		state  *xdr.LedgerEntry
+	)
+
+	for i := range changes {
+		change := &changes[i]
+
+		if change.Type == xdr.LedgerEntryChangeTypeLedgerEntryState {
+			entry := change.MustState()
+			state = &entry
+			continue
+		}
+
+		key := change.LedgerKey()
+		before := state
+		state = nil
+
+		// only use the preceding state if it belongs to this change's entry
+		if before != nil {
+			bkey := before.LedgerKey()
+			if !bkey.Equals(key) {
+				before = nil
+			}
+		}
+
+		var after *xdr.LedgerEntry
+		switch change.Type {
+		case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
+			entry := change.MustCreated()
+			after = &entry
+		case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
+			entry := change.MustUpdated()
+			after = &entry
+		}
+
+		result = append(result, entryChange{
+			Key:    key,
+			Type:   change.Type,
+			Before: before,
+			After:  after,
+		})
+	}
+
+	return result
+}
+
+// ledgerChangeEntry returns the balance (for account and trustline entries)
+// and the base64 encoded xdr of `entry`, which may be nil.
+func ledgerChangeEntry(entry *xdr.LedgerEntry) (null.Int, null.String, error) {
+	if entry == nil {
+		return null.Int{}, null.String{}, nil
+	}
+
+	var balance null.Int
+	switch entry.Data.Type {
+	case xdr.LedgerEntryTypeAccount:
+		balance = null.IntFrom(int64(entry.Data.MustAccount().Balance))
+	case xdr.LedgerEntryTypeTrustline:
+		balance = null.IntFrom(int64(entry.Data.MustTrustLine().Balance))
+	}
+
+	raw, err := xdr.MarshalBase64(entry)
+	if err != nil {
+		return null.Int{}, null.String{}, err
+	}
+
+	return balance, null.StringFrom(raw), nil
+}
+
+// ledgerKeyAccount returns the account that owns the ledger entry identified
+// by `key`.
+func ledgerKeyAccount(key xdr.LedgerKey) xdr.AccountId {
+	switch key.Type {
+	case xdr.LedgerEntryTypeAccount:
+		return key.MustAccount().AccountId
+	case xdr.LedgerEntryTypeTrustline:
+		return key.MustTrustLine().AccountId
+	case xdr.LedgerEntryTypeOffer:
+		return key.MustOffer().SellerId
+	case xdr.LedgerEntryTypeData:
+		return key.MustData().AccountId
+	default:
+		panic(errors.Errorf("Unknown ledger entry type: %v", key.Type))
+	}
+}
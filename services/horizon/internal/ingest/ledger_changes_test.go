@@ -0,0 +1,97 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedgerChangeRows(t *testing.T) {
+	meta := xdr.TransactionMeta{}
+	err := xdr.SafeUnmarshalBase64("AAAAAAAAAAEAAAADAAAAAQAZphoAAAAAAAAAAMIK9djC7k75ziKOLJcvMAIBG7tnBuoeI34x+Pi6zqcZAAAAF0h255wAGaYWAAAAAQAAAAMAAAAAAAAAAAAAAAADBQUFAAAAAwAAAAAtkqVYLPLYhqNMmQLPc+T9eTWp8LIE8eFlR5K4wNJKTQAAAAMAAAAAynnCTTyw53VVRLOWX6XKTva63IM1LslPNW01YB0hz/8AAAADAAAAAuOwxEKY/BwUmvv0yJlvuSQnrkHkZJuTTKSVmRt4UrhVAAAAAwAAAAAAAAAAAAAAAwAZphYAAAAAAAAAAMp5wk08sOd1VUSzll+lyk72utyDNS7JTzVtNWAdIc//AAAAF0h26AAAGaYWAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAAAAAAAAAAQAZphoAAAAAAAAAAMp5wk08sOd1VUSzll+lyk72utyDNS7JTzVtNWAdIc//AAAAGZyCzAAAGaYWAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAAAAA", &meta)
+	require.NoError(t, err)
+
+	rows, err := (&Ingestion{}).ledgerChangeRows(10, meta.MustOperations()[0].Changes)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	// an update without a preceding state has no before values
+	assert.Equal(t, int64(10), rows[0].HistoryOperationID)
+	assert.Equal(t, int32(0), rows[0].Order)
+	assert.Equal(t, xdr.LedgerEntryChangeTypeLedgerEntryUpdated, rows[0].ChangeType)
+	assert.Equal(t, xdr.LedgerEntryTypeAccount, rows[0].EntryType)
+	assert.Equal(t, "GDBAV5OYYLXE56OOEKHCZFZPGABACG53M4DOUHRDPYY7R6F2Z2TRTCV4", rows[0].Account)
+	assert.False(t, rows[0].Asset.Valid)
+	assert.False(t, rows[0].BalanceBefore.Valid)
+	assert.False(t, rows[0].EntryBefore.Valid)
+	assert.Equal(t, int64(99999999900), rows[0].BalanceAfter.Int64)
+	assert.True(t, rows[0].EntryAfter.Valid)
+
+	// the state change is folded into the update that follows it
+	assert.Equal(t, int32(1), rows[1].Order)
+	assert.Equal(t, "GDFHTQSNHSYOO5KVISZZMX5FZJHPNOW4QM2S5SKPGVWTKYA5EHH763AK", rows[1].Account)
+	assert.Equal(t, int64(100000000000), rows[1].BalanceBefore.Int64)
+	assert.Equal(t, int64(110000000000), rows[1].BalanceAfter.Int64)
+
+	var before xdr.LedgerEntry
+	err = xdr.SafeUnmarshalBase64(rows[1].EntryBefore.String, &before)
+	require.NoError(t, err)
+	assert.Equal(t, xdr.Int64(100000000000), before.Data.MustAccount().Balance)
+}
+
+func TestLedgerChangeRows_Trustlines(t *testing.T) {
+	var account, issuer xdr.AccountId
+	require.NoError(t, account.SetAddress("GDFHTQSNHSYOO5KVISZZMX5FZJHPNOW4QM2S5SKPGVWTKYA5EHH763AK"))
+	require.NoError(t, issuer.SetAddress("GDBAV5OYYLXE56OOEKHCZFZPGABACG53M4DOUHRDPYY7R6F2Z2TRTCV4"))
+
+	var usd, eur xdr.Asset
+	require.NoError(t, usd.SetCredit("USD", issuer))
+	require.NoError(t, eur.SetCredit("EUR", issuer))
+
+	trustline := func(asset xdr.Asset, balance xdr.Int64) xdr.LedgerEntry {
+		return xdr.LedgerEntry{
+			Data: xdr.LedgerEntryData{
+				Type: xdr.LedgerEntryTypeTrustline,
+				TrustLine: &xdr.TrustLineEntry{
+					AccountId: account,
+					Asset:     asset,
+					Balance:   balance,
+					Limit:     1000,
+				},
+			},
+		}
+	}
+
+	eurState := trustline(eur, 5)
+	var usdKey xdr.LedgerKey
+	require.NoError(t, usdKey.SetTrustline(account, usd))
+
+	created := trustline(usd, 0)
+	changes := xdr.LedgerEntryChanges{
+		{Type: xdr.LedgerEntryChangeTypeLedgerEntryCreated, Created: &created},
+		// a state for a different entry must not be used as the removed
+		// entry's before value
+		{Type: xdr.LedgerEntryChangeTypeLedgerEntryState, State: &eurState},
+		{Type: xdr.LedgerEntryChangeTypeLedgerEntryRemoved, Removed: &usdKey},
+	}
+
+	rows, err := (&Ingestion{}).ledgerChangeRows(20, changes)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	assert.Equal(t, xdr.LedgerEntryChangeTypeLedgerEntryCreated, rows[0].ChangeType)
+	assert.Equal(t, xdr.LedgerEntryTypeTrustline, rows[0].EntryType)
+	assert.Equal(t, usd.String(), rows[0].Asset.String)
+	assert.False(t, rows[0].BalanceBefore.Valid)
+	assert.True(t, rows[0].BalanceAfter.Valid)
+	assert.Equal(t, int64(0), rows[0].BalanceAfter.Int64)
+
+	assert.Equal(t, xdr.LedgerEntryChangeTypeLedgerEntryRemoved, rows[1].ChangeType)
+	assert.Equal(t, usd.String(), rows[1].Asset.String)
+	assert.Equal(t, int32(1), rows[1].Order)
+	assert.False(t, rows[1].EntryBefore.Valid)
+	assert.False(t, rows[1].EntryAfter.Valid)
+	assert.False(t, rows[1].BalanceAfter.Valid)
+}
@@ -0,0 +1,58 @@
+package ingest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LedgerError is the failure to ingest a single ledger.
+type LedgerError struct {
+	Sequence int32
+	Err      error
+}
+
+func (e LedgerError) Error() string {
+	return fmt.Sprintf("ledger %d: %s", e.Sequence, e.Err)
+}
+
+// LedgerErrors aggregates the failures of the ledgers that could not be
+// ingested by a session run with ContinueOnLedgerError, in the order the
+// ledgers were visited.
+type LedgerErrors []LedgerError
+
+func (e LedgerErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+
+	return fmt.Sprintf("%d ledgers failed to ingest: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// Sequences returns the sequences of the ledgers that failed.
+func (e LedgerErrors) Sequences() []int32 {
+	seqs := make([]int32, len(e))
+	for i, err := range e {
+		seqs[i] = err.Sequence
+	}
+	return seqs
+}
+
+// skipFailedLedger records the failure of the ledger being ingested when
+// ContinueOnLedgerError is set, rolling back its rows and starting a new
+// transaction for the ledgers that follow.  It returns false, leaving the
+// error as the session's, when the session should stop instead.
+func (is *Session) skipFailedLedger() bool {
+	if is.Err == nil || !is.ContinueOnLedgerError {
+		return false
+	}
+
+	is.ledgerErrors = append(is.ledgerErrors, LedgerError{
+		Sequence: is.Cursor.LedgerSequence(),
+		Err:      is.Err,
+	})
+
+	is.Ingestion.Rollback()
+	is.Err = is.Ingestion.Start()
+	return is.Err == nil
+}
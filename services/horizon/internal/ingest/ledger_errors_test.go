@@ -0,0 +1,84 @@
+package ingest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLedgerErrors(t *testing.T) {
+	errs := LedgerErrors{
+		{Sequence: 3, Err: errors.New("boom")},
+		{Sequence: 5, Err: errors.New("bang")},
+	}
+
+	assert.Equal(t, "2 ledgers failed to ingest: ledger 3: boom; ledger 5: bang", errs.Error())
+	assert.Equal(t, []int32{3, 5}, errs.Sequences())
+}
+
+func TestIngest_ContinueOnLedgerError(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	// the ledgers containing trades are made to fail, by failing on the
+	// effects of trades
+	types := NewEffectTypeRegistry()
+	for typ := range DefaultEffectTypes {
+		if typ != history.EffectTrade {
+			types[typ] = true
+		}
+	}
+
+	sys := sys(tt)
+	sys.EffectTypes = types
+	sys.UnknownEffects = UnknownEffectFail
+	sys.ContinueOnLedgerError = true
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.Run()
+
+	errs, ok := s.Err.(LedgerErrors)
+	tt.Require.True(ok, "unexpected error: %v", s.Err)
+
+	var ingested []int32
+	tt.Require.NoError(tt.HorizonSession().SelectRaw(&ingested, `SELECT sequence FROM history_ledgers`))
+
+	// without the option the session stops at the first failure
+	sys.ContinueOnLedgerError = false
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Assert.Error(s.Err)
+	_, ok = s.Err.(LedgerErrors)
+	tt.Assert.False(ok)
+
+	// ingest every ledger to find those that should have failed
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.ClearExisting = true
+	s.Ingestion.EffectTypes = nil
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	var failing []int32
+	tt.Require.NoError(tt.HorizonSession().SelectRaw(&failing, `
+		SELECT DISTINCT (history_operation_id >> 32)::integer AS seq
+		FROM history_effects WHERE type = ? ORDER BY seq`,
+		history.EffectTrade,
+	))
+	tt.Require.NotEmpty(failing)
+	tt.Assert.Equal(failing, errs.Sequences())
+
+	// every other ledger was committed, and the failed ones rolled back
+	var all []int32
+	tt.Require.NoError(tt.HorizonSession().SelectRaw(&all, `SELECT sequence FROM history_ledgers`))
+	tt.Assert.Len(ingested, len(all)-len(failing))
+	for _, seq := range failing {
+		tt.Assert.NotContains(ingested, seq)
+	}
+}
@@ -0,0 +1,57 @@
+package ingest
+
+import (
+	"sort"
+
+	"github.com/guregu/null"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+)
+
+// LedgerStats returns the stats of the transaction set of the current ledger.
+// See Ingestion.IngestLedgerStats.
+func (c *Cursor) LedgerStats() history.LedgerStats {
+	return c.data.stats(c.LedgerID())
+}
+
+// stats returns the stats of the bundle's transaction set, recorded against
+// the ledger id `id`.  Skipped transactions are not counted.  The median fee
+// of an even number of transactions is the mean of the two middle fees,
+// rounded down.
+func (lb *LedgerBundle) stats(id int64) history.LedgerStats {
+	stats := history.LedgerStats{
+		TotalOrderID:   history.TotalOrderID{ID: id},
+		LedgerSequence: lb.Sequence,
+	}
+
+	sources := map[string]bool{}
+	var fees []int
+	for i := range lb.Transactions {
+		if lb.isSkipped(i) {
+			continue
+		}
+
+		tx := &lb.Transactions[i]
+		sources[tx.SourceAddress()] = true
+		fees = append(fees, int(tx.Fee()))
+		stats.OperationCount += int32(len(tx.Envelope.Tx.Operations))
+		if !tx.IsSuccessful() {
+			stats.FailedTransactionCount++
+		}
+	}
+	stats.SourceAccountCount = int32(len(sources))
+
+	if len(fees) > 0 {
+		sort.Ints(fees)
+		mid := len(fees) / 2
+		median := fees[mid]
+		if len(fees)%2 == 0 {
+			median = (fees[mid-1] + fees[mid]) / 2
+		}
+
+		stats.MinFee = null.IntFrom(int64(fees[0]))
+		stats.MedianFee = null.IntFrom(int64(median))
+		stats.MaxFee = null.IntFrom(int64(fees[len(fees)-1]))
+	}
+
+	return stats
+}
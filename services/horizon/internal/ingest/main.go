@@ -4,11 +4,15 @@
 package ingest
 
 import (
+	"database/sql"
 	"sync"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	metrics "github.com/rcrowley/go-metrics"
+	"github.com/stellar/go/clients/stellartoml"
 	"github.com/stellar/go/services/horizon/internal/db2/core"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
 	"github.com/stellar/go/support/db"
 	"github.com/stellar/go/xdr"
 )
@@ -22,7 +26,7 @@ const (
 	// Scripts, that have yet to be ported to this codebase can then be leveraged
 	// to re-ingest old data with the new algorithm, providing a seamless
 	// transition when the ingested data's structure changes.
-	CurrentVersion = 11
+	CurrentVersion = 15
 )
 
 // Cursor iterates through a stellar core database's ledgers
@@ -36,6 +40,26 @@ type Cursor struct {
 	// DB is the stellar-core db that data is ingested from.
 	DB *db.Session
 
+	// LoadRetries is the number of additional attempts the cursor will make to
+	// load a ledger from stellar-core when the load fails with an error.  0
+	// disables retries.
+	LoadRetries int
+	// LoadRetryBackoff is the delay before the first retry of a failed ledger
+	// load.  The delay doubles with each subsequent attempt.
+	LoadRetryBackoff time.Duration
+	// IDScheme encodes the ids of the ledgers, transactions and operations
+	// visited by the cursor.  TOIDScheme is used when nil.
+	IDScheme IDScheme
+	// XDRErrorPolicy controls how transactions whose xdr cannot be decoded are
+	// handled.  See XDRErrorPolicy for details.
+	XDRErrorPolicy XDRErrorPolicy
+	// PrefetchDepth is the number of ledgers the cursor loads ahead of the
+	// iteration, in a background goroutine, overlapping the loading of ledgers
+	// from stellar-core with their ingestion.  Ledgers are still visited in
+	// order.  0 disables prefetching.  A prefetching cursor must be closed
+	// when its iteration is abandoned; see Close.
+	PrefetchDepth int
+
 	Metrics        *IngesterMetrics
 	AssetsModified AssetsModified
 
@@ -46,14 +70,54 @@ type Cursor struct {
 	tx   int
 	op   int
 	data *LedgerBundle
+
+	// bundles, when non-nil, are iterated over in place of loading ledgers from
+	// the stellar-core db.
+	bundles   []LedgerBundle
+	bundleIdx int
+
+	// prefetch receives the ledgers loaded by the prefetching goroutine, which
+	// stops once stopPrefetch is closed.
+	prefetch     chan prefetchedLedger
+	stopPrefetch chan struct{}
+
+	// load loads `bundle` from `db`, using LedgerBundle.Load when nil.  Tests
+	// replace it to simulate failing loads.
+	load func(bundle *LedgerBundle, db *db.Session) error
 }
 
+// IDScheme encodes the position of a ledger, transaction or operation into the
+// int64 id used to identify it in the history database.
+//
+// Ids are stored in, and joined across, every history table, so switching a
+// deployment to a different scheme requires clearing and reingesting all
+// history.  Parts of horizon outside of ingestion, such as the reaper and the
+// paging of some endpoints, assume the standard TOID encoding.
+type IDScheme interface {
+	// ID returns the id of the operation `op` of transaction `tx` within
+	// ledger `ledger`.  Transactions and operations are numbered from 1; the
+	// id of a transaction uses an `op` of 0 and the id of a ledger uses a
+	// `tx` and `op` of 0.  Ids must sort in the same order as the positions
+	// they encode.
+	ID(ledger int32, tx int32, op int32) int64
+
+	// Parse is the inverse of ID.
+	Parse(id int64) (ledger int32, tx int32, op int32)
+}
+
+// TOIDScheme is the default IDScheme, encoding ids as described by the toid
+// package.
+type TOIDScheme struct{}
+
 // EffectIngestion is a helper struct to smooth the ingestion of effects.  this
 // struct will track what the correct operation to use and order to use when
 // adding effects into an ingestion.
 type EffectIngestion struct {
 	Dest        *Ingestion
 	OperationID int64
+	// BaseReserve is the base reserve, in stroops, of the ledger in which the
+	// effects occurred.
+	BaseReserve xdr.Uint32
 	err         error
 	added       int
 	parent      *Ingestion
@@ -66,6 +130,45 @@ type LedgerBundle struct {
 	Header          core.LedgerHeader
 	TransactionFees []core.TransactionFee
 	Transactions    []core.Transaction
+
+	// XDRErrorPolicy controls how Load handles transactions whose xdr cannot
+	// be decoded.
+	XDRErrorPolicy XDRErrorPolicy
+
+	// Skipped are the transactions of the ledger that are not ingested because
+	// their xdr could not be decoded.  Each keeps its position within
+	// Transactions, holding a placeholder, so that the ids of the transactions
+	// that follow it are unaffected.
+	Skipped []SkippedTransaction
+}
+
+// XDRErrorPolicy controls how a transaction whose xdr, as stored by
+// stellar-core, cannot be decoded is handled.
+type XDRErrorPolicy int
+
+const (
+	// XDRErrorFail fails the ingestion of the ledger containing the
+	// transaction.  This is the default.
+	XDRErrorFail XDRErrorPolicy = iota
+
+	// XDRErrorSkipTransaction skips the transaction, recording it as a
+	// SkippedTransaction, and ingests the rest of its ledger.  This allows
+	// reingesting old or partially corrupt stellar-core databases, at the cost
+	// of silently incomplete history for the affected ledgers.
+	XDRErrorSkipTransaction
+)
+
+// SkippedTransaction is a transaction that was not ingested because its xdr
+// could not be decoded.
+type SkippedTransaction struct {
+	LedgerSequence int32
+	// Index is the transaction's application order within its ledger.
+	Index  int32
+	Hash   string
+	Reason string
+
+	// position is the transaction's position within its bundle's Transactions.
+	position int
 }
 
 // System represents the data ingestion subsystem of horizon.
@@ -77,6 +180,14 @@ type System struct {
 	// CoreDB is the stellar-core db that data is ingested from.
 	CoreDB *db.Session
 
+	// SecondaryHorizonDB is an optional second horizon database that ingested
+	// data will also be written to.  See Ingestion.SecondaryDB for details.
+	SecondaryHorizonDB *db.Session
+
+	// IngestionOptions are the options of the ingestions created by the
+	// system, each of which is handed a copy.  See IngestionOptions.
+	IngestionOptions
+
 	Metrics IngesterMetrics
 
 	// Network is the passphrase for the network being imported
@@ -91,40 +202,639 @@ type System struct {
 	// stellar-core
 	SkipCursorUpdate bool
 
+	// ReserveDetails causes ingested effects to include reserve change details.
+	// See Session.ReserveDetails for details.
+	ReserveDetails bool
+
+	// VerifyTxSetSize causes ingestion sessions to check the transaction count
+	// of every ledger.  See Session.VerifyTxSetSize for details.
+	VerifyTxSetSize bool
+
+	// EffectsFromMeta causes ingestion sessions to derive effects from ledger
+	// entry changes.  See Session.EffectsFromMeta for details.
+	EffectsFromMeta bool
+
+	// IngestGenesis causes the root account's creation to be recorded.  See
+	// Session.IngestGenesis for details.
+	IngestGenesis bool
+
+	// CheckpointEvery causes sessions to record checkpoints of their progress.
+	// See Session.CheckpointEvery for details.
+	CheckpointEvery int32
+
+	// ContinueOnLedgerError causes sessions to ingest the rest of their range
+	// when a ledger fails.  See Session.ContinueOnLedgerError for details.
+	ContinueOnLedgerError bool
+
+	// AmountFormat is how the amounts recorded in details are represented.
+	// See Session.AmountFormat for details.
+	AmountFormat AmountFormat
+
+	// AssetDetailsCacheSize bounds the cache of the asset fields recorded in
+	// details.  See Session.AssetDetailsCacheSize for details.
+	AssetDetailsCacheSize int
+
+	// TomlFetcher, when set, fetches the stellar.toml files of assets whose
+	// stats are updated by ingestion.  See TomlFetcher for details.
+	TomlFetcher *TomlFetcher
+
+	// MaxReplicationLag, when positive, causes sessions to pause between
+	// ledgers while the replication lag of the horizon database exceeds it.
+	// See ReplicationLagMonitor for details.
+	MaxReplicationLag time.Duration
+
+	// ReplicationLagQuery overrides the query used to measure replication lag.
+	// See ReplicationLagMonitor.Query for details.
+	ReplicationLagQuery string
+
+	// SessionMaxOpenConns, when positive, limits the number of open connections
+	// to the horizon database(s) used by sessions created with NewSession.
+	// The sessions then write through a connection pool of their own, opened
+	// from HorizonDBURL (and SecondaryHorizonDBURL for the secondary db) and
+	// shared by every session of the system, so that the limit never applies
+	// to HorizonDB, whose pool is shared with the rest of horizon, such as its
+	// request handlers.  The limit bounds the combined connections of the
+	// sessions rather than each session individually.  Each running session
+	// holds one connection for its transaction, so when running N sessions in
+	// parallel the limit should be at least N.  The connections of the pool
+	// come in addition to those of HorizonDB, so the limit must be kept below
+	// the database's `max_connections` less the connections needed by other
+	// clients, such as horizon's request handlers or other horizon processes.
+	SessionMaxOpenConns int
+
+	// SessionMaxIdleConns, when positive, limits the number of idle
+	// connections kept in the pool described by SessionMaxOpenConns.
+	SessionMaxIdleConns int
+
+	// HorizonDBURL is the url of the horizon database, from which the
+	// connection pool of sessions is opened when SessionMaxOpenConns or
+	// SessionMaxIdleConns is set.  The pool is opened by the first session and
+	// kept open for the life of the system.  Without a url, sessions use
+	// HorizonDB and the limits are not applied.
+	HorizonDBURL string
+
+	// SecondaryHorizonDBURL is the url of SecondaryHorizonDB, from which the
+	// connection pool of sessions writing to it is opened.  See HorizonDBURL.
+	SecondaryHorizonDBURL string
+
+	// IDScheme encodes the ids of ingested rows.  TOIDScheme is used when nil.
+	// See IDScheme for details.
+	IDScheme IDScheme
+
+	// XDRErrorPolicy controls how transactions whose xdr cannot be decoded are
+	// handled.  See XDRErrorPolicy for details.
+	XDRErrorPolicy XDRErrorPolicy
+
+	// PrefetchDepth is the number of ledgers ingestion cursors load ahead of
+	// the ledger being ingested.  See Cursor.PrefetchDepth for details.
+	PrefetchDepth int
+
+	// LoadRetries is the number of additional attempts ingestion cursors make
+	// to load a ledger from stellar-core when the load fails.  See
+	// Cursor.LoadRetries for details.
+	LoadRetries int
+
+	// LoadRetryBackoff is the delay before the first retry of a failed ledger
+	// load.  See Cursor.LoadRetryBackoff for details.
+	LoadRetryBackoff time.Duration
+
 	// HistoryRetentionCount is the desired minimum number of ledgers to
 	// keep in the history database, working backwards from the latest core
 	// ledger.  0 represents "all ledgers".
 	HistoryRetentionCount uint
 
+	// BulkReingestDropIndexes causes BulkReingest to drop the indexes of the
+	// history tables it loads, and recreate them once done.  See
+	// BulkReingest for details.
+	BulkReingestDropIndexes bool
+
+	// AnalyzeAfterReingest causes ReingestRange, BulkReingest and
+	// ReingestOutdated to run ANALYZE on the history tables once the reingest
+	// completes, a single time per table, so that queries are planned using
+	// statistics of the reingested history rather than stale ones until
+	// autovacuum catches up.  Analyzing takes a few seconds per table on large
+	// dbs.
+	AnalyzeAfterReingest bool
+
 	lock    sync.Mutex
 	current *Session
+
+	// sessionPools are the connection pools of sessions, by database url,
+	// guarded by poolLock.  See SessionMaxOpenConns.
+	poolLock     sync.Mutex
+	sessionPools map[string]*db.Session
+
+	// lastCommit and lastErr are the last commit time and the error of the
+	// most recent live session, guarded by lock.  See DebugState.
+	lastCommit time.Time
+	lastErr    error
 }
 
+// MetaStorage controls where the result, meta and fee meta xdr of ingested
+// transactions is stored.  Together these are usually much larger than the
+// rest of a transaction's row.
+type MetaStorage int
+
+const (
+	// MetaStoreInline stores the xdr in the history_transactions table.  This
+	// is the default.
+	MetaStoreInline MetaStorage = iota
+
+	// MetaStoreSeparate stores the xdr in the history_transaction_xdr table,
+	// keyed by transaction id, keeping the rows of history_transactions small.
+	// The xdr is still served by the transaction endpoints, at the cost of a
+	// join.
+	MetaStoreSeparate
+
+	// MetaStoreNone does not store the meta or fee meta xdr at all; empty
+	// strings are stored in their place.  The result xdr is stored inline.
+	// Anything derived from the stored meta cannot work on transactions
+	// ingested this way: the transaction resources have an empty
+	// `result_meta_xdr`, and System.RebuildParticipants fails.  Use it only on
+	// deployments that serve no meta-dependent data, and reingest to recover
+	// the meta.
+	MetaStoreNone
+)
+
+// DuplicateTransactionPolicy controls how a transaction whose hash has already
+// been ingested is handled.  Transaction hashes are unique on a healthy
+// network, but a malformed stellar-core database or a reset test network can
+// present duplicates.
+type DuplicateTransactionPolicy int
+
+const (
+	// DuplicateTransactionFail fails the commit of the ingestion transaction
+	// containing the duplicate, which is rolled back.  This is the default,
+	// and adds no query per transaction: the duplicates of a whole
+	// transaction are found by one query when it commits.
+	DuplicateTransactionFail DuplicateTransactionPolicy = iota
+
+	// DuplicateTransactionSkip skips the duplicate, along with its operations,
+	// effects and participants, and ingests the rest of its ledger.
+	DuplicateTransactionSkip
+
+	// DuplicateTransactionLog logs a warning and ingests the duplicate
+	// alongside the transaction it duplicates.
+	DuplicateTransactionLog
+)
+
+// EffectTypeRegistry is the set of effect types that ingestion recognizes.
+// DefaultEffectTypes holds every history.EffectType.
+type EffectTypeRegistry map[history.EffectType]bool
+
+// UnknownEffectPolicy controls how an effect whose type is not in the
+// ingestion's EffectTypeRegistry is handled.  Such an effect can only be
+// produced when the code deriving effects is newer than the effect types known
+// to the running ingestion.
+type UnknownEffectPolicy int
+
+const (
+	// UnknownEffectStore stores the effect with its numeric type.  This is the
+	// default.
+	UnknownEffectStore UnknownEffectPolicy = iota
+
+	// UnknownEffectSkip logs a warning and does not store the effect.
+	UnknownEffectSkip
+
+	// UnknownEffectFail fails the ingestion of the ledger containing the
+	// effect.
+	UnknownEffectFail
+)
+
 // IngesterMetrics tracks all the metrics for the ingestion subsystem
 type IngesterMetrics struct {
 	ClearLedgerTimer  metrics.Timer
 	IngestLedgerTimer metrics.Timer
 	LoadLedgerTimer   metrics.Timer
+
+	// DetailsSizeHistogram tracks the size, in bytes, of the details json
+	// written for each ingested operation and effect.
+	DetailsSizeHistogram metrics.Histogram
+
+	// LargeDetailsCounter counts the details blobs larger than the ingestion's
+	// LargeDetailsThreshold.
+	LargeDetailsCounter metrics.Counter
+
+	// AssetCacheHitCounter and AssetCacheMissCounter count the asset ids found
+	// and not found in the ingestion's AssetIDCache.
+	AssetCacheHitCounter  metrics.Counter
+	AssetCacheMissCounter metrics.Counter
 }
 
 // AssetsModified tracks all the assets modified during a cycle of ingestion
 type AssetsModified map[string]xdr.Asset
 
+// IngestionOptions are the options of an Ingestion.  They are embedded in
+// both Ingestion and System, whose ingestions are handed a copy of the
+// system's options, so that an option is declared, and copied, once.  DB,
+// SecondaryDB and Metrics are the fields of the Ingestion the options are
+// applied to.
+type IngestionOptions struct {
+	// SecondaryStrict controls how failures writing to SecondaryDB are handled.
+	// When true, a secondary failure aborts the ingestion just as a primary
+	// failure would.  When false (the default), the failure is logged and
+	// writes to SecondaryDB are skipped until the next call to Start.
+	SecondaryStrict bool
+
+	// Sink is an optional analytics sink that every ledger, transaction,
+	// operation, effect and trade written to DB is also handed to.  The sink is
+	// committed directly after DB (and SecondaryDB) are, so, as with the
+	// secondary db, a failure to commit the sink leaves it missing rows that
+	// horizon has committed.
+	Sink Sink
+
+	// SinkStrict controls how failures writing to Sink are handled.  When true,
+	// a sink failure aborts the ingestion.  When false (the default), the
+	// failure is logged and writes to Sink are skipped until the next call to
+	// Start, so that the sink can never hold up the horizon database.
+	SinkStrict bool
+
+	// OutboxEnabled causes an event to be written to the ingestion_outbox table
+	// for every ingested ledger, within the same transaction as the ledger's
+	// data, so that a relay draining the outbox observes exactly the ledgers
+	// that were committed.  Delivery is at-least-once: reingesting a ledger
+	// writes a new event for it.
+	OutboxEnabled bool
+
+	// OutboxEncoder encodes the payload of a ledger's outbox event.
+	// DefaultOutboxEncoder is used when nil.
+	OutboxEncoder func(history.Ledger) []byte
+
+	// LargeDetailsThreshold is the size, in bytes, above which a details blob
+	// is considered large.  Large blobs increment Metrics.LargeDetailsCounter
+	// and are logged, to surface operations that use the history tables for
+	// bulk storage.  0 disables the check.
+	LargeDetailsThreshold int
+
+	// IngestLedgerChanges causes the ledger entry changes of every operation to
+	// be decoded from the transaction meta and recorded into the
+	// history_ledger_changes table.  This produces several rows per operation,
+	// so it is disabled by default.
+	IngestLedgerChanges bool
+
+	// CountTrustlineChanges causes the number of trustline entries created,
+	// updated or removed by the successful transactions of each ledger, as
+	// recorded in their meta, to be stored in the trustlines_changed column of
+	// history_ledgers.  The column is null when it is not set.
+	CountTrustlineChanges bool
+
+	// IngestLedgerStats causes the composition of the transaction set of each
+	// ledger to be recorded into the history_ledger_stats table: the number of
+	// distinct source accounts, the minimum, median and maximum fees bid, and
+	// the number of operations and of failed transactions.  The stats cover
+	// every transaction of the ledger, whether or not it is ingested, except
+	// those skipped by the XDRErrorPolicy.  See history.LedgerStats.
+	IngestLedgerStats bool
+
+	// IngestUpgradeProposals causes the upgrades carried by the scp value of
+	// each ledger's header, the upgrades the network voted on when closing the
+	// ledger, to be recorded into the history_upgrade_proposals table, one row
+	// per upgrade in order.  The settings in effect are recorded by
+	// history_ledgers regardless.  See history.UpgradeProposal.
+	IngestUpgradeProposals bool
+
+	// IngestFailedTransactions causes transactions that failed to be ingested
+	// along with their operations, which are recorded as unsuccessful, and
+	// their transaction and operation participants.  Failed operations have no
+	// effects, trades or ledger changes.  By default failed transactions are
+	// skipped entirely, which substantially reduces the volume of history on
+	// networks with many failed transactions.
+	IngestFailedTransactions bool
+
+	// IngestAccountFlags causes every change to an account's flags made by a
+	// set options operation to be recorded into the history_account_flags
+	// table, along with the flags before and after the change, allowing the
+	// flag history of an account to be queried directly rather than derived
+	// from its effects.
+	IngestAccountFlags bool
+
+	// IngestOfferRemaining causes the amount left in the crossed offer after
+	// each trade to be recorded into the offer_remaining_amount column of
+	// history_trades, where a zero denotes a fully filled offer.  When
+	// disabled the column is left null.
+	IngestOfferRemaining bool
+
+	// StoreMeta controls where the result, meta and fee meta xdr of ingested
+	// transactions are stored.  See MetaStorage for details.
+	StoreMeta MetaStorage
+
+	// SpoolDir, when set, is the directory of the spool to which every ledger
+	// loaded from stellar-core is appended before it is written to the horizon
+	// db, and from which it is removed once committed.  Should the horizon db
+	// be unavailable, sessions continue to load and spool their ledgers, and
+	// the spooled ledgers are ingested by the next run of the ingestion system
+	// once the db recovers, without reading them from stellar-core again.
+	// Ledgers are spooled as loaded, rather than as rows, since ingesting them
+	// requires resolving account and asset ids from the horizon db.
+	SpoolDir string
+
+	// InstanceID identifies this ingestion instance.  When set, it is stamped
+	// into the ingested_by column of every ledger written, making it possible
+	// to tell which instance wrote a ledger should several ingest the same
+	// database.
+	InstanceID string
+
+	// CompressXDR causes the envelope, result, meta and fee meta xdr of
+	// transactions, and the header xdr of ledgers, to be stored gzip
+	// compressed, in the self-describing format produced by CompressXDR.
+	// Readers of these columns must pass them through DecompressXDR.  By
+	// default the xdr is stored as plain base64.
+	//
+	// The horizon API does not decompress these columns: its transaction and
+	// ledger resources serve the stored, "gzip:" prefixed values as is, so
+	// enable this only on dbs whose xdr is read by other systems.
+	CompressXDR bool
+
+	// OnDuplicateTransaction controls how transactions whose hash has already
+	// been ingested are handled.  See DuplicateTransactionPolicy for details.
+	OnDuplicateTransaction DuplicateTransactionPolicy
+
+	// EffectTypes are the effect types recognized when writing effects.
+	// DefaultEffectTypes is used when nil.
+	EffectTypes EffectTypeRegistry
+
+	// UnknownEffects controls how effects whose type is not in EffectTypes are
+	// handled.  See UnknownEffectPolicy for details.
+	UnknownEffects UnknownEffectPolicy
+
+	// VerifyFailedCommits causes a failed commit of DB to be checked, over a
+	// new connection, for whether the ingestion's ledgers landed regardless,
+	// as a commit interrupted by a dropped connection may or may not have been
+	// applied.  When every ledger landed the commit is treated as successful;
+	// when none did the commit error is returned; otherwise
+	// ErrCommitIndeterminate is.  By default the commit error is returned
+	// without verification.
+	VerifyFailedCommits bool
+
+	// NormalizeAssetsInDetails causes the assets embedded in the details of
+	// ingested operations to be stored as references to history_assets
+	// rather than as code and issuer strings, reducing the size of the
+	// details and allowing operations to be queried by asset id.  Each
+	// asset's `asset_type`, `asset_code` and `asset_issuer` keys (with their
+	// prefix, such as `selling_`) are replaced by an `asset_id` key.  Readers
+	// of the details must expand the ids using history.Q.ExpandAssetIDs.
+	NormalizeAssetsInDetails bool
+
+	// AccountIDStrategy, when set, assigns the history ids of new accounts in
+	// place of the history_accounts id sequence, for example so that an
+	// address maps to the same id in every db it is ingested into.  Ids that
+	// are already assigned to another account are retried with the next
+	// attempt of the strategy.  See HashAccountIDs.  When nil, the sequence
+	// assigns ids.
+	AccountIDStrategy AccountIDStrategy
+
+	// AssetIDCache, when set, is consulted for the history id of an asset
+	// before history_assets is, such as for the assets of every trade.  The
+	// ids resolved by a transaction are only added to the cache once it
+	// commits, so that the cache never holds the id of an asset whose creation
+	// was rolled back.  A cache shared by several ingestions must only be
+	// used with a single horizon db.  See MemoryAssetIDCache.  Hits and misses
+	// are counted by Metrics.
+	AssetIDCache AssetIDCache
+
+	// Clock, when set, returns the current time in place of time.Now, such as
+	// for the created_at and updated_at columns of the rows written.
+	Clock func() time.Time
+
+	// TimeLocation is the time zone the timestamps written are represented
+	// in, such as the close time of ledgers.  The columns have no time zone,
+	// so a timestamp is stored as its wall clock time in TimeLocation.  UTC is
+	// used when nil, which readers of the history tables expect: other zones
+	// are only meant for downstream systems requiring them.  The trades
+	// written through history.Q are always stored in UTC.
+	TimeLocation *time.Location
+
+	// AddressRewriter, when set, rewrites every account address stored by the
+	// ingestion, such as to remap a test issuer when reingesting into a test
+	// environment.  It is applied to the addresses of history_accounts, and so
+	// to every row referencing accounts by id, as well as to the addresses
+	// stored in rows and in the details of operations and effects, and to the
+	// issuers of assets, so that the rows remain consistent with each other.
+	// Stellar-core is always queried with the original addresses, which
+	// AccountWhitelist is matched against too.  Signer keys are passed to the
+	// rewriter as well, since the master key of an account is its address,
+	// and should be returned unchanged when not remapped.  Hashes, signatures
+	// and the xdr stored are left as is.  The rewriter must return the same
+	// address for a given address every time, and should never map distinct
+	// addresses to the same one.  Asset stats cannot be recomputed by
+	// System.RecomputeAssetStats while a rewriter is set.
+	AddressRewriter func(string) string
+
+	// TrackTradePairStats causes the cumulative trade count and volume of
+	// every asset pair to be maintained in history_trade_pair_stats, sparing
+	// consumers the aggregation of history_trades.  The stats of the trades
+	// ingested are accumulated in memory and added to the table when the
+	// transaction is committed, atomically with the trades themselves.
+	// Volumes are exact sums of the trades' amounts, in stroops.  Clearing a
+	// range of history subtracts its trades from the stats.
+	TrackTradePairStats bool
+
+	// AccountWhitelist, when not empty, restricts the history ingested to
+	// that of the accounts whose addresses it contains, for deployments
+	// serving a known set of accounts.  An operation, its participants,
+	// effects and trades are only ingested when at least one of its
+	// participants is whitelisted, and a transaction only when at least one
+	// of its operations is.  Ledgers are always ingested in full, so their
+	// transaction and operation counts include the history skipped.
+	AccountWhitelist map[string]bool
+
+	// IndexMemos causes the memo of every transaction that has one to be
+	// added to history_transaction_memos, indexed by memo type and memo, so
+	// that transactions can be found by exact memo, for example to match
+	// deposits, without indexing the wide history_transactions table.  Memos
+	// are stored as they are in history_transactions: ids in decimal and
+	// hashes in base64.
+	IndexMemos bool
+
+	// IngestInclusionDelay causes the number of seconds between the min time
+	// bound of every transaction that has one and the close of the ledger
+	// that included it to be stored in the indexed inclusion_delay column of
+	// history_transactions, for analytics of how long valid transactions take
+	// to be included.  The column is null for transactions without a min time
+	// bound, and when it is not set.
+	IngestInclusionDelay bool
+
+	// StrictAssetValidation causes the ingestion to fail when an asset whose
+	// code is malformed, such as one containing bytes other than letters and
+	// digits or zero bytes before its end, is about to be written to
+	// history_assets.  Without it such codes are canonicalized, and a warning
+	// logged, so that they do not create junk rows.  See validateAsset.
+	StrictAssetValidation bool
+
+	// DetailFieldFilter, when set, is handed the details of every operation
+	// and effect before they are stored, along with the table they are stored
+	// in, and returns the details to store in their place, so that fields that
+	// must not be retained, such as memo-like data, can be removed or redacted
+	// at ingest time.  The filter may modify the map it is handed.  It sees the
+	// details as they would be stored, with json numbers as json.Number, and
+	// the assets of operations already normalized when
+	// NormalizeAssetsInDetails is set.  Returning nil stores empty details.
+	DetailFieldFilter func(table TableName, details map[string]interface{}) map[string]interface{}
+
+	// DetailsWhitelist, when it has an entry for the type of an operation,
+	// restricts the details stored for the operation to the keys listed, for
+	// example keeping only `amount` and `to` for payments, to save storage on
+	// deployments that only need some of the details.  Keys are matched as they
+	// would be stored, so assets are referenced by their `asset_id` keys when
+	// NormalizeAssetsInDetails is set.  Operations of types without an entry
+	// keep all of their details.  DetailFieldFilter is applied afterwards.
+	DetailsWhitelist map[xdr.OperationType][]string
+
+	// RowTransformers are handed, in order, every row about to be inserted
+	// into a history table, such as history_operations or history_effects,
+	// and may redact its values, add computed columns or drop it.  A row whose
+	// columns are changed is inserted on its own rather than batched with the
+	// rows of its kind, so the columns added must exist.  Transformers see the
+	// rows after every other option has been applied, and are not handed the
+	// rows of history_accounts and asset_stats, which other rows depend on.
+	// Dropping a row does not drop the rows referencing it, such as the
+	// operations of a dropped transaction, and sinks are still handed the
+	// rows as ingested.
+	RowTransformers []RowTransformer
+
+	// ParticipantRoles causes the role of every operation participant, such
+	// as the source or the destination of a payment, to be stored in the
+	// role column of history_operation_participants, so that the payments
+	// an account sent can be told from those it received without decoding
+	// the operations.  The roles are null when it is not set.  See
+	// participants.Role.
+	ParticipantRoles bool
+
+	// HashEncoding controls how the hashes of transactions and ledgers are
+	// stored.  See HashEncoding.
+	HashEncoding HashEncoding
+
+	// StoreFullHeaderFields causes the transaction set hash, transaction set
+	// result hash and scp value of ledger headers to be stored in their own
+	// columns of history_ledgers, for ledger verification and archival.  The
+	// columns are null when it is not set.  The bucket list hash, which allows
+	// horizon's view of the ledger state to be checked against the history
+	// archives, is always stored.
+	StoreFullHeaderFields bool
+
+	// MaxTransactionDuration is the longest a transaction of DB may remain
+	// open.  A transaction open for longer, such as one whose statements are
+	// blocked on a lock, has its running statement cancelled and is rolled
+	// back, the pending write or commit returning ErrTransactionTimeout, so
+	// that a stalled ingestion does not pin database resources indefinitely.
+	// Zero disables the watchdog.
+	MaxTransactionDuration time.Duration
+
+	// IsolationLevel is the isolation level of the transactions of DB, set at
+	// the start of each, including those started by Flush.  The default,
+	// sql.LevelDefault, leaves the level of the db's sessions unchanged, read
+	// committed unless configured otherwise.  sql.LevelReadCommitted,
+	// sql.LevelRepeatableRead and sql.LevelSerializable are supported.  The
+	// stronger levels protect concurrent ingestions and reconciliations from
+	// each other's writes at the cost of more serialization failures, which
+	// fail the flush and must be retried by rerunning the session.
+	IsolationLevel sql.IsolationLevel
+}
+
 // Ingestion receives write requests from a Session
 type Ingestion struct {
+	// IngestionOptions are the options of the ingestion.  See
+	// IngestionOptions.
+	IngestionOptions
+
 	// DB is the sql connection to be used for writing any rows into the horizon
 	// database.
 	DB *db.Session
 
+	// SecondaryDB is an optional connection to a second horizon database.  When
+	// set, every row written to DB is also written to SecondaryDB, and the
+	// secondary transaction is committed directly after the primary one.
+	//
+	// Dual-writing is not a replacement for database replication. Commits to
+	// the two databases are not atomic: if the secondary commit fails after the
+	// primary commit succeeds, the databases diverge until the affected ledgers
+	// are reingested into the secondary.  Account and asset ids are always
+	// allocated by the primary and copied to the secondary, so the secondary
+	// must not be written to by any other process.
+	SecondaryDB *db.Session
+
+	// OrderBase is added to the application order of every transaction and
+	// operation written by this ingestion, allowing the ingested rows to be
+	// interleaved with those of another ingestion stream.  The default of 0
+	// preserves the natural ordering.
+	OrderBase int32
+
+	// Metrics, when set, receives the size of every details blob written for
+	// an operation or effect.
+	Metrics *IngesterMetrics
+
+	// PlaceholderFormat is the placeholder format of the statements built by
+	// the insert builders, sq.Dollar when nil.  It allows the ingestion to be
+	// pointed at dbs, or mocks, expecting another format.
+	PlaceholderFormat sq.PlaceholderFormat
+
+	// WithinTransaction causes the ingestion to run within the transaction DB
+	// is already bound to, which is left to the caller: a savepoint stands in
+	// for each transaction of the ingestion, so that commits, including those
+	// of Flush, release the savepoint and rollbacks roll back to it, leaving
+	// the rows committed visible to the outer transaction alone.  Tests use it
+	// to roll back everything ingested once done.  IsolationLevel is ignored,
+	// the level being the outer transaction's.  SecondaryDB and Sink are
+	// unaffected.
+	WithinTransaction bool
+
+	// secondaryErr and sinkErr are the failures that suspended writes to
+	// SecondaryDB and Sink until the next call to Start.  See SecondaryStrict
+	// and SinkStrict.
+	secondaryErr error
+	sinkErr      error
+
+	// txStarted and txLedgers are the start time and the ledgers written by
+	// the current transaction, used to verify failed commits.
+	txStarted time.Time
+	txLedgers []int32
+
+	// watchdog enforces MaxTransactionDuration on the current transaction.
+	watchdog *txWatchdog
+
+	// tradePairStats are the trade pair stats accumulated by the current
+	// transaction.  See TrackTradePairStats.
+	tradePairStats map[tradePair]*tradePairStat
+
+	// debug is the state reported by System.DebugState.
+	debug debugState
+
+	// rollbackOnly causes commits to roll back the transaction instead.  See
+	// System.EstimateReingest.
+	rollbackOnly bool
+
+	// insertColumns are the columns of the insert builders, by table.  See
+	// RowTransformers.
+	insertColumns map[string][]string
+
+	// pendingAssetIDs are the asset ids resolved by the current transaction,
+	// by asset.  See AssetIDCache.
+	pendingAssetIDs map[string]pendingAssetID
+
+	// pendingTxHashes are the hashes of the transactions ingested by the
+	// current transaction, with their ledgers, under DuplicateTransactionFail.
+	// See checkDuplicateTransactions.
+	pendingTxHashes map[string]int32
+
+	// hashColumnsChecked is set once the hash columns have been found to suit
+	// HashEncoding.  See checkHashColumns.
+	hashColumnsChecked bool
+
 	ledgers                  sq.InsertBuilder
 	transactions             sq.InsertBuilder
 	transaction_participants sq.InsertBuilder
+	transactionXDR           sq.InsertBuilder
+	transactionMemos         sq.InsertBuilder
 	operations               sq.InsertBuilder
 	operation_participants   sq.InsertBuilder
 	effects                  sq.InsertBuilder
 	accounts                 sq.InsertBuilder
 	trades                   sq.InsertBuilder
 	assetStats               sq.InsertBuilder
+	ledgerChanges            sq.InsertBuilder
+	accountFlags             sq.InsertBuilder
+	ledgerStats              sq.InsertBuilder
+	upgradeProposals         sq.InsertBuilder
 }
 
 // Session represents a single attempt at ingesting data into the history
@@ -148,9 +858,103 @@ type Session struct {
 	// stellar-core
 	SkipCursorUpdate bool
 
+	// ReserveDetails causes effects that add or remove an account subentry to
+	// include a `reserve_change` detail: the resulting change in the account's
+	// minimum balance, computed using the base reserve of the ledger in which
+	// the effect occurred.
+	ReserveDetails bool
+
+	// VerifyTxSetSize causes the session to fail when a ledger contains more
+	// transactions than the max tx set size of its header allows, which can
+	// only happen when the ledger data in stellar-core's database is corrupt.
+	VerifyTxSetSize bool
+
+	// EffectsFromMeta causes the session to derive the effects of each
+	// operation from the ledger entry changes recorded in its meta rather than
+	// from the operation's inputs, so that the effects always reflect the state
+	// transitions that actually occurred.  See ingestEffectsFromMeta for how
+	// changes map onto effects.
+	EffectsFromMeta bool
+
+	// IngestGenesis causes the creation of the network's root account to be
+	// recorded when ingesting the genesis ledger, with the account_created and
+	// signer_created effects a create account operation would produce,
+	// attributed to the ledger's id.  Without it the history of the root
+	// account starts at its first operation.
+	IngestGenesis bool
+
+	// SweepChecks are the checks run by IntegritySweep, all of them when
+	// zero.
+	SweepChecks SweepCheck
+
+	// CheckpointEvery causes the session to record the ledger it is ingesting
+	// in history_ingest_checkpoints every CheckpointEvery ledgers, keyed by the
+	// range of its cursor, so that a session rerun over the same range after a
+	// crash resumes after the last checkpoint rather than from the start of
+	// the range.  The checkpoint of a range is removed once its last ledger
+	// has been ingested.  The ledgers committed after the last checkpoint are
+	// ingested again on resumption, so checkpointing sessions should also set
+	// ClearExisting unless they checkpoint every ledger.  0 disables
+	// checkpointing.
+	CheckpointEvery int32
+
+	// ContinueOnLedgerError causes a ledger that fails to be ingested to be
+	// rolled back and skipped, rather than stopping the session, so that a
+	// backfill ingests every ledger it can and reports every failure at once.
+	// The ledgers ingested are committed as usual, and Err is set to the
+	// LedgerErrors of the ledgers skipped once the run completes.  Failures to
+	// load ledgers from stellar-core, or to start a new transaction, still stop
+	// the session.  stellar-core's cursor is not advanced by a run that skipped
+	// ledgers.
+	ContinueOnLedgerError bool
+
+	// AmountFormat is how the amounts recorded in the details of operations
+	// and effects, such as a payment's amount or a trustline's limit, are
+	// represented.  The zero value records every amount with its 7 fractional
+	// digits.  A format with a reduced precision loses the amounts' smallest
+	// digits, and a history ingested with different formats represents
+	// identical amounts differently; ParseAmount reads any of them back.
+	AmountFormat AmountFormat
+
+	// AssetDetailsCacheSize, when positive, is the number of assets whose
+	// fields recorded in details, such as the asset_issuer of a payment, are
+	// cached by the session, sparing the encoding of the issuer's address for
+	// every operation and effect involving the asset.  The details are
+	// identical with or without the cache.  Assets beyond the first
+	// AssetDetailsCacheSize seen by the session are never cached.
+	AssetDetailsCacheSize int
+
+	// TomlFetcher, when set, is handed the stellar.toml urls of the assets whose
+	// stats were updated once the session has been committed.
+	TomlFetcher *TomlFetcher
+
+	// ReplicationLag, when set, pauses the session between ledgers while the
+	// horizon database's replicas lag too far behind.
+	ReplicationLag *ReplicationLagMonitor
+
 	// Metrics is a reference to where the session should record its metric information
 	Metrics *IngesterMetrics
 
+	// tomlRequests maps the asset ids whose stats were updated to the url of
+	// the asset issuer's stellar.toml file.
+	tomlRequests map[int64]string
+
+	// assetDetailsCache holds the fields recorded in details of the assets
+	// seen by the session.  See AssetDetailsCacheSize.
+	assetDetailsCache map[assetKey]assetFields
+
+	// partial, when non-nil, holds the hashes of the only transactions to be
+	// ingested.  See IngestPartialLedger.
+	partial map[string]bool
+
+	// checkpoints, when non-nil, is the range the session records checkpoints
+	// against.  See CheckpointEvery.
+	checkpoints *checkpointRange
+
+	// ledgerErrors are the failures of the ledgers skipped by the current run.
+	// See ContinueOnLedgerError.
+	ledgerErrors LedgerErrors
+
 	//
 	// Results fields
 	//
@@ -161,6 +965,81 @@ type Session struct {
 	// Ingested is the number of ledgers that were successfully ingested during
 	// this session.
 	Ingested int
+
+	// Skipped are the transactions that were skipped during this session
+	// because their xdr could not be decoded.  See XDRErrorSkipTransaction.
+	Skipped []SkippedTransaction
+}
+
+// ReplicationLagMonitor pauses ingestion while the read replicas of the
+// horizon database lag too far behind it, so that write-heavy catch-up does not
+// leave replica-backed requests serving stale data or push replicas past the
+// point where they can recover.  Ingestion is only ever paused between ledgers.
+type ReplicationLagMonitor struct {
+	// DB is the connection to the primary horizon database the lag is measured
+	// on.  It should not be the connection used by the ingestion, whose open
+	// transaction may cache the replication statistics.
+	DB *db.Session
+
+	// MaxLag is the replication lag above which ingestion is paused.
+	MaxLag time.Duration
+
+	// Query measures the replication lag.  It must return a single number: the
+	// lag in seconds.  DefaultReplicationLagQuery is used when empty.
+	Query string
+
+	// PollInterval is the time waited between measurements while ingestion is
+	// paused.  DefaultReplicationLagPollInterval is used when zero.
+	PollInterval time.Duration
+}
+
+// TomlFailurePolicy controls how a failure to fetch an asset issuer's
+// stellar.toml file is recorded.
+type TomlFailurePolicy int
+
+const (
+	// TomlFailureStoreNull stores a null toml for the asset.  This is the
+	// default policy.
+	TomlFailureStoreNull TomlFailurePolicy = iota
+
+	// TomlFailureRetry leaves the asset's stored toml unchanged and retries the
+	// fetch once TomlFetcher.RetryDelay has passed.
+	TomlFailureRetry
+
+	// TomlFailureFlag stores a null toml for the asset and sets its
+	// `toml_error` flag.
+	TomlFailureFlag
+)
+
+// TomlFetcher fetches the stellar.toml files of assets and stores them in the
+// asset_stats table.  Fetches run in the background, outside of any ingestion
+// transaction, so that anchors with slow or broken stellar.toml files cannot
+// stall ingestion.
+type TomlFetcher struct {
+	// DB is the horizon database that fetched toml files are written to.
+	DB *db.Session
+
+	// HTTP is the client used to fetch toml files.  http.DefaultClient is used
+	// when nil.
+	HTTP stellartoml.HTTP
+
+	// Timeout is the hard limit on the time taken to fetch a single toml file,
+	// regardless of any timeout configured on HTTP.  DefaultTomlTimeout is used
+	// when zero.
+	Timeout time.Duration
+
+	// FailurePolicy controls how failed fetches are recorded.
+	FailurePolicy TomlFailurePolicy
+
+	// RetryDelay is the minimum delay before a failed fetch is retried when
+	// FailurePolicy is TomlFailureRetry.  DefaultTomlRetryDelay is used when
+	// zero.
+	RetryDelay time.Duration
+
+	lock     sync.Mutex
+	running  bool
+	pending  map[int64]string
+	deferred map[int64]deferredToml
 }
 
 // New initializes the ingester, causing it to begin polling the stellar-core
@@ -176,31 +1055,51 @@ func New(network string, coreURL string, core, horizon *db.Session) *System {
 	i.Metrics.ClearLedgerTimer = metrics.NewTimer()
 	i.Metrics.IngestLedgerTimer = metrics.NewTimer()
 	i.Metrics.LoadLedgerTimer = metrics.NewTimer()
+	i.Metrics.DetailsSizeHistogram = metrics.NewHistogram(metrics.NewExpDecaySample(1028, 0.015))
+	i.Metrics.LargeDetailsCounter = metrics.NewCounter()
+	i.Metrics.AssetCacheHitCounter = metrics.NewCounter()
+	i.Metrics.AssetCacheMissCounter = metrics.NewCounter()
 	return i
 }
 
 // NewCursor initializes a new ingestion cursor
 func NewCursor(first, last int32, i *System) *Cursor {
 	return &Cursor{
-		FirstLedger:    first,
-		LastLedger:     last,
-		DB:             i.CoreDB,
-		Metrics:        &i.Metrics,
-		AssetsModified: AssetsModified(make(map[string]xdr.Asset)),
+		FirstLedger:      first,
+		LastLedger:       last,
+		DB:               i.CoreDB,
+		IDScheme:         i.IDScheme,
+		XDRErrorPolicy:   i.XDRErrorPolicy,
+		PrefetchDepth:    i.PrefetchDepth,
+		LoadRetries:      i.LoadRetries,
+		LoadRetryBackoff: i.LoadRetryBackoff,
+		Metrics:          &i.Metrics,
+		AssetsModified:   AssetsModified(make(map[string]xdr.Asset)),
 	}
 }
 
 // NewSession initialize a new ingestion session
 func NewSession(i *System) *Session {
-	hdb := i.HorizonDB.Clone()
+	ingestion := i.newIngestion()
+	ingestion.DB = i.sessionPool(ingestion.DB, i.HorizonDBURL)
+	ingestion.SecondaryDB = i.sessionPool(ingestion.SecondaryDB, i.SecondaryHorizonDBURL)
 
 	return &Session{
-		Ingestion: &Ingestion{
-			DB: hdb,
-		},
+		Ingestion:        ingestion,
 		Network:          i.Network,
 		StellarCoreURL:   i.StellarCoreURL,
 		SkipCursorUpdate: i.SkipCursorUpdate,
+		ReserveDetails:   i.ReserveDetails,
+		VerifyTxSetSize:  i.VerifyTxSetSize,
+		EffectsFromMeta:  i.EffectsFromMeta,
+		IngestGenesis:    i.IngestGenesis,
+		CheckpointEvery:  i.CheckpointEvery,
+		TomlFetcher:      i.TomlFetcher,
+		ReplicationLag:   i.replicationLagMonitor(),
 		Metrics:          &i.Metrics,
+
+		ContinueOnLedgerError: i.ContinueOnLedgerError,
+		AmountFormat:          i.AmountFormat,
+		AssetDetailsCacheSize: i.AssetDetailsCacheSize,
 	}
 }
@@ -1,11 +1,19 @@
 package ingest
 
 import (
+	"encoding/hex"
 	"testing"
 
+	"github.com/guregu/null"
+	"github.com/stellar/go/keypair"
 	"github.com/stellar/go/network"
+	"github.com/stellar/go/services/horizon/internal/db2/core"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
 	"github.com/stellar/go/services/horizon/internal/ledger"
 	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/services/horizon/internal/toid"
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/xdr"
 )
 
 func TestIngest(t *testing.T) {
@@ -29,6 +37,503 @@ func TestIngest(t *testing.T) {
 	tt.Require.NoError(s.Err, "Couldn't re-import, even with clear allowed")
 }
 
+func TestIngest_StoreMeta(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	hq := tt.HorizonSession()
+	count := func(query string) (found int) {
+		tt.Require.NoError(hq.GetRaw(&found, query))
+		return
+	}
+
+	sys := sys(tt)
+	sys.StoreMeta = MetaStoreSeparate
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	txs := count("SELECT COUNT(*) FROM history_transactions")
+	tt.Assert.Equal(txs, count("SELECT COUNT(*) FROM history_transaction_xdr WHERE tx_meta <> ''"))
+	tt.Assert.Equal(txs, count("SELECT COUNT(*) FROM history_transactions WHERE tx_meta = '' AND tx_result = ''"))
+
+	// transactions are still loaded with their xdr
+	var tx history.Transaction
+	q := &history.Q{Session: hq}
+	tt.Require.NoError(q.TransactionByHash(&tx, "f5e0d1f500b2d0c4b42fb8a438d5ed764bc58d1392f4328f4713af407b1968ca"))
+	tt.Assert.NotEmpty(tx.TxMeta)
+	tt.Assert.NotEmpty(tx.TxResult)
+
+	// reingesting without stored meta
+	sys.StoreMeta = MetaStoreNone
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	tt.Assert.Equal(0, count("SELECT COUNT(*) FROM history_transaction_xdr"))
+	tt.Assert.Equal(txs, count("SELECT COUNT(*) FROM history_transactions WHERE tx_meta = '' AND tx_fee_meta = '' AND tx_result <> ''"))
+	tt.Assert.Error(sys.RebuildParticipants(1, ledger.CurrentState().CoreLatest))
+}
+
+func TestIngest_InstanceID(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	hq := tt.HorizonSession()
+	q := &history.Q{Session: hq}
+	stamped := func(id string) (found int) {
+		err := hq.GetRaw(&found, `SELECT COUNT(*) FROM history_ledgers WHERE ingested_by = ?`, id)
+		tt.Require.NoError(err)
+		return
+	}
+
+	// unset by default
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	var l history.Ledger
+	tt.Require.NoError(q.LedgerBySequence(&l, 2))
+	tt.Assert.False(l.IngestedBy.Valid)
+
+	sys := sys(tt)
+	sys.InstanceID = "ingester-1"
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	tt.Assert.Equal(s.Ingested, stamped("ingester-1"))
+	tt.Require.NoError(q.LedgerBySequence(&l, 2))
+	tt.Assert.Equal("ingester-1", l.IngestedBy.String)
+}
+
+func TestIngest_StoreFullHeaderFields(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	q := &history.Q{Session: tt.HorizonSession()}
+	cq := &core.Q{Session: tt.CoreSession()}
+
+	var header core.LedgerHeader
+	tt.Require.NoError(cq.LedgerHeaderBySequence(&header, 2))
+
+	// null by default, other than the bucket list hash
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	var l history.Ledger
+	tt.Require.NoError(q.LedgerBySequence(&l, 2))
+	tt.Assert.True(l.BucketListHash.Valid)
+	tt.Assert.Equal(header.BucketListHash, l.BucketListHash.String)
+	tt.Assert.Equal(hex.EncodeToString(header.Data.BucketListHash[:]), l.BucketListHash.String)
+	tt.Assert.False(l.TxSetHash.Valid)
+	tt.Assert.False(l.TxSetResultHash.Valid)
+	tt.Assert.False(l.ScpValue.Valid)
+
+	sys := sys(tt)
+	sys.StoreFullHeaderFields = true
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	tt.Require.NoError(q.LedgerBySequence(&l, 2))
+
+	tt.Assert.Equal(hex.EncodeToString(header.Data.BucketListHash[:]), l.BucketListHash.String)
+	tt.Assert.Equal(hex.EncodeToString(header.Data.ScpValue.TxSetHash[:]), l.TxSetHash.String)
+	tt.Assert.Equal(hex.EncodeToString(header.Data.TxSetResultHash[:]), l.TxSetResultHash.String)
+
+	var scp xdr.StellarValue
+	tt.Require.NoError(xdr.SafeUnmarshalBase64(l.ScpValue.String, &scp))
+	tt.Assert.Equal(header.Data.ScpValue.TxSetHash, scp.TxSetHash)
+	tt.Assert.Equal(xdr.Uint64(header.CloseTime), scp.CloseTime)
+}
+
+func TestIngest_SignatureCount(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	// kahuna's multisig account signs with both of its signers
+	var tx history.Transaction
+	q := &history.Q{Session: tt.HorizonSession()}
+	tt.Require.NoError(q.TransactionByHash(&tx, "a9085e13fbe9f84e07e320a0d445536de1afc2cfd8c7e4186687807edd2b4897"))
+	tt.Assert.Equal(int32(2), tx.SignatureCount)
+
+	var mismatched int
+	err := tt.HorizonSession().GetRaw(&mismatched, `
+		SELECT COUNT(*) FROM history_transactions
+		WHERE signature_count IS DISTINCT FROM COALESCE(array_length(signatures, 1), 0)`,
+	)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(0, mismatched)
+}
+
+func TestIngest_FailedTransactions(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	hash := "f5e0d1f500b2d0c4b42fb8a438d5ed764bc58d1392f4328f4713af407b1968ca"
+	txid := toid.New(3, 1, 0).ToInt64()
+
+	// mark the transaction as failed in stellar-core
+	var result xdr.TransactionResultPair
+	rawHash, err := hex.DecodeString(hash)
+	tt.Require.NoError(err)
+	copy(result.TransactionHash[:], rawHash)
+	result.Result.FeeCharged = 100
+	result.Result.Result.Code = xdr.TransactionResultCodeTxBadSeq
+	raw, err := xdr.MarshalBase64(result)
+	tt.Require.NoError(err)
+	_, err = tt.CoreSession().ExecRaw(`UPDATE txhistory SET txresult = ? WHERE txid = ?`, raw, hash)
+	tt.Require.NoError(err)
+
+	hq := tt.HorizonSession()
+	count := func(query string, args ...interface{}) (found int) {
+		tt.Require.NoError(hq.GetRaw(&found, query, args...))
+		return
+	}
+
+	// failed transactions are skipped by default
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+	tt.Assert.Equal(0, count("SELECT COUNT(*) FROM history_transactions WHERE id = ?", txid))
+	tt.Assert.Equal(0, count("SELECT COUNT(*) FROM history_transaction_participants WHERE history_transaction_id = ?", txid))
+
+	sys := sys(tt)
+	sys.IngestFailedTransactions = true
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	tt.Assert.Equal(1, count("SELECT COUNT(*) FROM history_transactions WHERE id = ?", txid))
+	tt.Assert.NotZero(count("SELECT COUNT(*) FROM history_transaction_participants WHERE history_transaction_id = ?", txid))
+	tt.Assert.Equal(1, count("SELECT COUNT(*) FROM history_operations WHERE transaction_id = ? AND NOT successful", txid))
+	tt.Assert.NotZero(count("SELECT COUNT(*) FROM history_operation_participants WHERE history_operation_id = ?", txid+1))
+	tt.Assert.Equal(0, count("SELECT COUNT(*) FROM history_effects WHERE history_operation_id = ?", txid+1))
+
+	// the ledger's operation count excludes the operations of the failed
+	// transaction
+	start, end := toid.New(3, 0, 0).ToInt64(), toid.New(4, 0, 0).ToInt64()
+	ops := `SELECT COUNT(*) FROM history_operations WHERE id >= ? AND id < ?`
+	opCount := count("SELECT operation_count FROM history_ledgers WHERE sequence = 3")
+	tt.Assert.Equal(count(ops+" AND successful", start, end), opCount)
+	tt.Assert.True(count(ops, start, end) > opCount)
+}
+
+func TestIngest_PassiveOffers(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	// kahuna's passive trader creates a buy and a sell offer at the same price
+	// which, being passive, do not cross each other.
+	var ops []history.Operation
+	err := tt.HorizonSession().SelectRaw(&ops,
+		`SELECT id, type, details FROM history_operations WHERE type = ? ORDER BY id`,
+		xdr.OperationTypeCreatePassiveOffer,
+	)
+	tt.Require.NoError(err)
+	tt.Require.Len(ops, 2)
+
+	for _, op := range ops {
+		var details struct {
+			Passive bool   `json:"passive"`
+			Price   string `json:"price"`
+		}
+		tt.Require.NoError(op.UnmarshalDetails(&details))
+		tt.Assert.True(details.Passive)
+		tt.Assert.Equal("1.0000000", details.Price)
+
+		var trades int
+		err = tt.HorizonSession().GetRaw(&trades,
+			`SELECT COUNT(*) FROM history_trades WHERE history_operation_id = ?`, op.ID,
+		)
+		tt.Require.NoError(err)
+		tt.Assert.Equal(0, trades)
+	}
+}
+
+func TestIngest_PathPaymentResultDetails(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	var ops []history.Operation
+	err := tt.HorizonSession().SelectRaw(&ops,
+		`SELECT id, type, details FROM history_operations WHERE type = ? ORDER BY id`,
+		xdr.OperationTypePathPayment,
+	)
+	tt.Require.NoError(err)
+	tt.Require.NotEmpty(ops)
+
+	for _, op := range ops {
+		var details struct {
+			Amount                  string `json:"amount"`
+			DestinationAmountActual string `json:"destination_amount_actual"`
+			OffersClaimed           *int   `json:"offers_claimed"`
+		}
+		tt.Require.NoError(op.UnmarshalDetails(&details))
+		tt.Assert.Equal(details.Amount, details.DestinationAmountActual)
+		tt.Assert.NotNil(details.OffersClaimed)
+	}
+}
+
+// TestIngest_OptionalTables covers the options recording history into tables
+// of their own.  Each table is left empty by default, and is filled, then
+// checked by its case, once the ledgers are reingested with its option set.
+func TestIngest_OptionalTables(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Scenario string
+		Table    string
+		Enable   func(sys *System)
+		Check    func(tt *test.T, sys *System)
+	}{
+		{
+			Name:     "account flags",
+			Scenario: "kahuna",
+			Table:    "history_account_flags",
+			Enable:   func(sys *System) { sys.IngestAccountFlags = true },
+			Check:    checkAccountFlags,
+		},
+		{
+			Name:     "trade pair stats",
+			Scenario: "trades",
+			Table:    "history_trade_pair_stats",
+			Enable:   func(sys *System) { sys.TrackTradePairStats = true },
+			Check:    checkTradePairStats,
+		},
+		{
+			Name:     "memos",
+			Scenario: "kahuna",
+			Table:    "history_transaction_memos",
+			Enable:   func(sys *System) { sys.IndexMemos = true },
+			Check:    checkMemos,
+		},
+		{
+			Name:     "ledger stats",
+			Scenario: "kahuna",
+			Table:    "history_ledger_stats",
+			Enable:   func(sys *System) { sys.IngestLedgerStats = true },
+			Check:    checkLedgerStats,
+		},
+	}
+
+	for _, kase := range cases {
+		t.Run(kase.Name, func(t *testing.T) {
+			tt := test.Start(t).ScenarioWithoutHorizon(kase.Scenario)
+			defer tt.Finish()
+
+			count := func() (n int) {
+				tt.Require.NoError(tt.HorizonSession().GetRaw(&n, "SELECT COUNT(*) FROM "+kase.Table))
+				return
+			}
+
+			s := ingest(tt)
+			tt.Require.NoError(s.Err)
+			tt.Assert.Equal(0, count())
+
+			sys := sys(tt)
+			kase.Enable(sys)
+			s = NewSession(sys)
+			s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+			s.ClearExisting = true
+			s.Run()
+			tt.Require.NoError(s.Err)
+			tt.Require.NotZero(count())
+
+			kase.Check(tt, sys)
+		})
+	}
+}
+
+func checkAccountFlags(tt *test.T, sys *System) {
+	hq := tt.HorizonSession()
+	count := func(before, after xdr.AccountFlags) (found int) {
+		err := hq.GetRaw(&found,
+			`SELECT COUNT(*) FROM history_account_flags WHERE flags_before = ? AND flags_after = ?`,
+			int32(before), int32(after),
+		)
+		tt.Require.NoError(err)
+		return
+	}
+
+	required := xdr.AccountFlagsAuthRequiredFlag
+	revocable := xdr.AccountFlagsAuthRevocableFlag
+
+	// kahuna's optioneer sets the auth required and revocable flags one at a
+	// time, then clears both with a single operation, while its allow trustee
+	// sets both at once.
+	tt.Assert.NotZero(count(0, required))
+	tt.Assert.NotZero(count(required, required|revocable))
+	tt.Assert.NotZero(count(required|revocable, 0))
+	tt.Assert.NotZero(count(0, required|revocable))
+
+	// every row is tied to a set options operation within its ledger
+	var mismatched int
+	err := hq.GetRaw(&mismatched, `
+		SELECT COUNT(*) FROM history_account_flags haf
+		LEFT JOIN history_operations hop ON hop.id = haf.history_operation_id
+		LEFT JOIN history_transactions ht ON ht.id = hop.transaction_id
+		WHERE hop.type IS DISTINCT FROM ? OR ht.ledger_sequence IS DISTINCT FROM haf.ledger_sequence`,
+		xdr.OperationTypeSetOptions,
+	)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(0, mismatched)
+}
+
+func checkTradePairStats(tt *test.T, sys *System) {
+	type stat struct {
+		Base          int64  `db:"base_asset_id"`
+		Counter       int64  `db:"counter_asset_id"`
+		Count         int64  `db:"trade_count"`
+		BaseVolume    string `db:"base_volume"`
+		CounterVolume string `db:"counter_volume"`
+	}
+
+	load := func(query string) (stats []stat) {
+		tt.Require.NoError(tt.HorizonSession().SelectRaw(&stats, query))
+		return
+	}
+	tracked := func() []stat {
+		return load(`
+			SELECT base_asset_id, counter_asset_id, trade_count,
+				base_volume::text, counter_volume::text
+			FROM history_trade_pair_stats
+			WHERE trade_count > 0
+			ORDER BY base_asset_id, counter_asset_id`)
+	}
+	expected := func() []stat {
+		return load(`
+			SELECT base_asset_id, counter_asset_id, COUNT(*) AS trade_count,
+				SUM(base_amount)::text AS base_volume,
+				SUM(counter_amount)::text AS counter_volume
+			FROM history_trades
+			GROUP BY base_asset_id, counter_asset_id
+			ORDER BY base_asset_id, counter_asset_id`)
+	}
+	run := func(first, last int32) {
+		s := NewSession(sys)
+		s.Cursor = NewCursor(first, last, sys)
+		s.ClearExisting = true
+		s.Run()
+		tt.Require.NoError(s.Err)
+	}
+
+	tt.Require.NotEmpty(expected())
+	tt.Assert.Equal(expected(), tracked())
+
+	// reingesting does not count trades twice, and stats accumulate across
+	// commits
+	latest := ledger.CurrentState().CoreLatest
+	mid := latest / 2
+	run(1, mid)
+	run(mid+1, latest)
+	tt.Assert.Equal(expected(), tracked())
+}
+
+func checkMemos(tt *test.T, sys *System) {
+	// kahuna pays with one memo of each type, every other transaction having
+	// none
+	var count int
+	tt.Require.NoError(tt.HorizonSession().GetRaw(&count, `SELECT COUNT(*) FROM history_transaction_memos`))
+	tt.Assert.Equal(4, count)
+
+	cases := []struct {
+		Type string
+		Memo string
+	}{
+		{"id", "123"},
+		{"text", "hello"},
+		{"hash", "AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE="},
+		{"return", "AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI="},
+	}
+
+	for _, kase := range cases {
+		var ids []int64
+		err := tt.HorizonSession().SelectRaw(&ids, `
+			SELECT htm.history_transaction_id
+			FROM history_transaction_memos htm
+			JOIN history_transactions ht ON ht.id = htm.history_transaction_id
+			WHERE htm.memo_type = ? AND htm.memo = ?
+			AND ht.memo_type = htm.memo_type AND ht.memo = htm.memo`,
+			kase.Type, kase.Memo,
+		)
+		tt.Require.NoError(err)
+		tt.Assert.Len(ids, 1, "memo %s %s", kase.Type, kase.Memo)
+	}
+}
+
+func checkLedgerStats(tt *test.T, sys *System) {
+	hq := tt.HorizonSession()
+
+	var ledgers, count int
+	tt.Require.NoError(hq.GetRaw(&ledgers, `SELECT COUNT(*) FROM history_ledgers`))
+	tt.Require.NoError(hq.GetRaw(&count, `SELECT COUNT(*) FROM history_ledger_stats`))
+	tt.Assert.Equal(ledgers, count)
+
+	// ledger 28 of kahuna holds 7 single operation transactions of
+	// GCIFFRQKHMH6JD7CK5OI4XVCYCMNRNF6PYA7JTCR3FPHPJZQTYYFB5ES, each bidding a
+	// fee of 100
+	var stats history.LedgerStats
+	tt.Require.NoError(hq.GetRaw(&stats, `SELECT * FROM history_ledger_stats WHERE ledger_sequence = 28`))
+	tt.Assert.Equal(int32(1), stats.SourceAccountCount)
+	tt.Assert.Equal(null.IntFrom(100), stats.MinFee)
+	tt.Assert.Equal(null.IntFrom(100), stats.MedianFee)
+	tt.Assert.Equal(null.IntFrom(100), stats.MaxFee)
+	tt.Assert.Equal(int32(7), stats.OperationCount)
+	tt.Assert.Equal(int32(0), stats.FailedTransactionCount)
+
+	// the genesis ledger has no transactions, so no fees
+	tt.Require.NoError(hq.GetRaw(&stats, `SELECT * FROM history_ledger_stats WHERE ledger_sequence = 1`))
+	tt.Assert.Equal(int32(0), stats.SourceAccountCount)
+	tt.Assert.False(stats.MinFee.Valid)
+}
+
+func TestIngest_OfferRemaining(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("trades")
+	defer tt.Finish()
+
+	remaining := func() (found null.Int) {
+		err := tt.HorizonSession().GetRaw(&found,
+			`SELECT offer_remaining_amount FROM history_trades WHERE offer_id = 1`,
+		)
+		tt.Require.NoError(err)
+		return
+	}
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+	tt.Assert.False(remaining().Valid)
+
+	sys := sys(tt)
+	sys.IngestOfferRemaining = true
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	// scott sells 50 USD into bartek's offer of 100 EUR, leaving 50 EUR
+	found := remaining()
+	tt.Assert.True(found.Valid)
+	tt.Assert.Equal(int64(500000000), found.Int64)
+}
+
 func TestTick(t *testing.T) {
 	tt := test.Start(t).ScenarioWithoutHorizon("base")
 	defer tt.Finish()
@@ -37,7 +542,7 @@ func TestTick(t *testing.T) {
 	// ingest by tick
 	s := sys.Tick()
 	tt.Require.NoError(s.Err)
-	tt.Require.Nil(sys.current)
+	tt.Require.Nil(sys.CurrentSession())
 
 	tt.UpdateLedgerState()
 	s = sys.Tick()
@@ -54,6 +559,25 @@ func ingest(tt *test.T) *Session {
 	return s
 }
 
+// rolledBackIngest ingests the test's scenario within a transaction of the
+// horizon db, returning the session along with a function rolling the
+// transaction back, so that a test leaves no rows behind.  The rows ingested
+// are only visible through the session's Ingestion.DB until then.
+func rolledBackIngest(tt *test.T) (*Session, func()) {
+	sys := sys(tt)
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.Ingestion.WithinTransaction = true
+
+	tt.Require.NoError(s.Ingestion.DB.Begin())
+	rollback := func() {
+		tt.Require.NoError(s.Ingestion.DB.Rollback())
+	}
+
+	s.Run()
+	return s, rollback
+}
+
 func sys(tt *test.T) *System {
 	return New(
 		network.TestNetworkPassphrase,
@@ -62,3 +586,506 @@ func sys(tt *test.T) *System {
 		tt.HorizonSession(),
 	)
 }
+
+// historyDigestQueries compute digests of the ingested history, excluding
+// the timestamps of the rows.
+var historyDigestQueries = []string{
+	`SELECT md5(string_agg(t::text, ',' ORDER BY t.id)) FROM (
+		SELECT id, sequence, ledger_hash, transaction_count, operation_count
+		FROM history_ledgers) t`,
+	`SELECT md5(string_agg(t::text, ',' ORDER BY t.id)) FROM (
+		SELECT id, transaction_hash, account, successful FROM history_transactions) t`,
+	`SELECT md5(string_agg(t::text, ',' ORDER BY t.id)) FROM (
+		SELECT id, transaction_id, type, details::text, source_account
+		FROM history_operations) t`,
+	`SELECT md5(string_agg(t::text, ',' ORDER BY t.history_operation_id, t."order")) FROM (
+		SELECT history_operation_id, "order", history_account_id, type, details::text
+		FROM history_effects) t`,
+	`SELECT md5(string_agg(t::text, ',' ORDER BY t.history_operation_id, t."order")) FROM (
+		SELECT history_operation_id, "order", offer_id, base_amount, counter_amount
+		FROM history_trades) t`,
+	`SELECT COUNT(*)::text FROM history_operation_participants`,
+	`SELECT COUNT(*)::text FROM history_transaction_participants`,
+}
+
+// historyDigest returns the digests of the history in the horizon db, for
+// comparing the results of two ingestions of the same ledgers.
+func historyDigest(tt *test.T) []string {
+	return sessionDigest(tt, tt.HorizonSession())
+}
+
+// sessionDigest returns the digests of the history in the horizon db of
+// `session`.
+func sessionDigest(tt *test.T, session *db.Session) []string {
+	var ret []string
+	for _, q := range historyDigestQueries {
+		var d string
+		tt.Require.NoError(session.GetRaw(&d, q))
+		ret = append(ret, d)
+	}
+	return ret
+}
+
+func TestIngestBundles(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	sys := sys(tt)
+	sys.XDRErrorPolicy = XDRErrorSkipTransaction
+	latest := ledger.CurrentState().CoreLatest
+
+	// the cursor-driven ingestion the bundles are compared against
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, latest, sys)
+	s.Run()
+	tt.Require.NoError(s.Err)
+	want := historyDigest(tt)
+
+	var bundles []LedgerBundle
+	for seq := int32(1); seq <= latest; seq++ {
+		bundle := LedgerBundle{Sequence: seq}
+		tt.Require.NoError(bundle.Load(tt.CoreSession()))
+		bundles = append(bundles, bundle)
+	}
+
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, latest, sys)
+	s.ClearExisting = true
+	err := s.IngestBundles(bundles)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(len(bundles), s.Ingested)
+	tt.Assert.Equal(XDRErrorSkipTransaction, s.Cursor.XDRErrorPolicy)
+
+	tt.Assert.Equal(want, historyDigest(tt))
+}
+
+func TestIngestPartialLedger(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+	sys := sys(tt)
+	hq := tt.HorizonSession()
+	q := &history.Q{Session: hq}
+
+	txs := func() (found int) {
+		err := hq.GetRaw(&found, `SELECT COUNT(*) FROM history_transactions WHERE ledger_sequence = 2`)
+		tt.Require.NoError(err)
+		return
+	}
+	partial := func() bool {
+		var l history.Ledger
+		tt.Require.NoError(q.LedgerBySequence(&l, 2))
+		return l.Partial
+	}
+	latest := func() (seq int32) {
+		tt.Require.NoError(q.LatestLedger(&seq))
+		return
+	}
+
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, 1, sys)
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	var hash string
+	err := tt.CoreSession().GetRaw(&hash, `SELECT txid FROM txhistory WHERE ledgerseq = 2 ORDER BY txindex LIMIT 1`)
+	tt.Require.NoError(err)
+
+	// ledger 2 contains 3 transactions
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, 1, sys)
+	tt.Require.NoError(s.IngestPartialLedger(2, []string{hash}))
+	tt.Assert.Equal(1, txs())
+	tt.Assert.True(partial())
+	tt.Assert.Equal(int32(1), latest())
+
+	var tx history.Transaction
+	tt.Assert.NoError(q.TransactionByHash(&tx, hash))
+
+	// regular ingestion completes the ledger
+	s = NewSession(sys)
+	s.Cursor = NewCursor(2, 2, sys)
+	s.Run()
+	tt.Require.NoError(s.Err)
+	tt.Assert.Equal(3, txs())
+	tt.Assert.False(partial())
+	tt.Assert.Equal(int32(2), latest())
+
+	// complete ledgers are left untouched
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, 1, sys)
+	tt.Require.NoError(s.IngestPartialLedger(2, []string{hash}))
+	tt.Assert.Equal(3, txs())
+	tt.Assert.False(partial())
+}
+
+func TestIngestBundles_DuplicateTransaction(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+	sys := sys(tt)
+
+	bundle := LedgerBundle{Sequence: 2}
+	tt.Require.NoError(bundle.Load(tt.CoreSession()))
+	hash := bundle.Transactions[0].TransactionHash
+	bundle.Transactions[1].TransactionHash = hash
+
+	count := func() (found int) {
+		err := tt.HorizonSession().GetRaw(&found,
+			`SELECT COUNT(*) FROM history_transactions WHERE transaction_hash = ?`, hash,
+		)
+		tt.Require.NoError(err)
+		return
+	}
+
+	// fails by default, when the ingestion transaction commits
+	s := NewSession(sys)
+	err := s.IngestBundles([]LedgerBundle{bundle})
+	if tt.Assert.Error(err) {
+		tt.Assert.Contains(err.Error(), "transaction "+hash+" in ledger 2 has already been ingested")
+	}
+	tt.Assert.Equal(0, count())
+
+	sys.OnDuplicateTransaction = DuplicateTransactionSkip
+	s = NewSession(sys)
+	tt.Require.NoError(s.IngestBundles([]LedgerBundle{bundle}))
+	tt.Assert.Equal(1, count())
+
+	var txs int
+	err = tt.HorizonSession().GetRaw(&txs, `SELECT COUNT(*) FROM history_transactions`)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(2, txs)
+
+	sys.OnDuplicateTransaction = DuplicateTransactionLog
+	s = NewSession(sys)
+	s.ClearExisting = true
+	tt.Require.NoError(s.IngestBundles([]LedgerBundle{bundle}))
+	tt.Assert.Equal(2, count())
+}
+
+func TestIngestBundles_VerifyTxSetSize(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+	sys := sys(tt)
+
+	load := func() []LedgerBundle {
+		bundle := LedgerBundle{Sequence: 2}
+		tt.Require.NoError(bundle.Load(tt.CoreSession()))
+
+		// ledger 2 contains 3 transactions
+		bundle.Header.Data.MaxTxSetSize = 2
+		return []LedgerBundle{bundle}
+	}
+
+	// unchecked by default
+	s := NewSession(sys)
+	tt.Require.NoError(s.IngestBundles(load()))
+
+	sys.VerifyTxSetSize = true
+	s = NewSession(sys)
+	s.ClearExisting = true
+	err := s.IngestBundles(load())
+	if tt.Assert.Error(err) {
+		tt.Assert.Contains(err.Error(), "ledger 2 contains 3 transactions, exceeding its max tx set size of 2")
+	}
+}
+
+func TestReingestTransaction(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	hq := tt.HorizonSession()
+
+	var tx struct {
+		ID   int64  `db:"id"`
+		Hash string `db:"transaction_hash"`
+	}
+	err := hq.GetRaw(&tx, `
+		SELECT id, transaction_hash
+		FROM history_transactions
+		WHERE operation_count > 1
+		ORDER BY id
+		LIMIT 1
+	`)
+	tt.Require.NoError(err)
+
+	countRows := func(query string) (count int) {
+		err := hq.GetRaw(&count, query, tx.ID)
+		tt.Require.NoError(err)
+		return
+	}
+	opsQuery := `SELECT COUNT(*) FROM history_operations WHERE transaction_id = ?`
+	totalQuery := `SELECT COUNT(*) FROM history_operations WHERE transaction_id <> ?`
+
+	ops := countRows(opsQuery)
+	others := countRows(totalQuery)
+	tt.Require.True(ops > 1)
+
+	// corrupt the transaction by removing one of its operations
+	_, err = hq.ExecRaw(`
+		DELETE FROM history_operations
+		WHERE id = (SELECT MAX(id) FROM history_operations WHERE transaction_id = ?)
+	`, tx.ID)
+	tt.Require.NoError(err)
+	tt.Require.Equal(ops-1, countRows(opsQuery))
+
+	s = NewSession(sys(tt))
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys(tt))
+	err = s.ReingestTransaction(tx.Hash)
+	tt.Require.NoError(err)
+
+	tt.Assert.Equal(ops, countRows(opsQuery))
+	tt.Assert.Equal(others, countRows(totalQuery))
+}
+
+func TestIngest_Genesis(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	root := keypair.Master(network.TestNetworkPassphrase).Address()
+	rootEffects := func() (types []int) {
+		err := tt.HorizonSession().SelectRaw(&types, `
+			SELECT e.type FROM history_effects e
+			JOIN history_accounts ha ON ha.id = e.history_account_id
+			WHERE ha.address = ? AND e.history_operation_id = ?
+			ORDER BY e.order`,
+			root, toid.New(1, 0, 0).ToInt64(),
+		)
+		tt.Require.NoError(err)
+		return
+	}
+
+	// disabled by default
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+	tt.Assert.Empty(rootEffects())
+
+	sys := sys(tt)
+	sys.IngestGenesis = true
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, 1, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	tt.Assert.Equal([]int{
+		int(history.EffectAccountCreated),
+		int(history.EffectSignerCreated),
+	}, rootEffects())
+
+	var account history.Account
+	q := &history.Q{Session: tt.HorizonSession()}
+	tt.Require.NoError(q.AccountByAddress(&account, root))
+}
+
+func TestIngest_NormalizeAssetsInDetails(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	loadDetails := func() (all []map[string]interface{}) {
+		var ops []history.Operation
+		err := tt.HorizonSession().SelectRaw(&ops,
+			`SELECT id, type, details FROM history_operations ORDER BY id`,
+		)
+		tt.Require.NoError(err)
+
+		for _, op := range ops {
+			var details map[string]interface{}
+			tt.Require.NoError(op.UnmarshalDetails(&details))
+			all = append(all, details)
+		}
+		return
+	}
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+	expected := loadDetails()
+
+	sys := sys(tt)
+	sys.NormalizeAssetsInDetails = true
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+	normalized := loadDetails()
+	tt.Require.Len(normalized, len(expected))
+
+	q := &history.Q{Session: tt.HorizonSession()}
+	found := false
+	for i, details := range normalized {
+		for key := range details {
+			tt.Assert.NotContains(key, "asset_type")
+			tt.Assert.NotContains(key, "asset_code")
+			tt.Assert.NotContains(key, "asset_issuer")
+			if key == "asset_id" || key == "buying_asset_id" {
+				found = true
+			}
+		}
+
+		tt.Require.NoError(q.ExpandAssetIDs(details))
+		tt.Assert.Equal(expected[i], details)
+	}
+	tt.Assert.True(found)
+}
+
+func TestIngest_HomeDomainEffect(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("set_options")
+	defer tt.Finish()
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	var effects []history.Effect
+	err := tt.HorizonSession().SelectRaw(&effects,
+		`SELECT * FROM history_effects WHERE type = ?`,
+		history.EffectAccountHomeDomainUpdated,
+	)
+	tt.Require.NoError(err)
+	tt.Require.Len(effects, 1)
+
+	var details struct {
+		HomeDomain    string  `json:"home_domain"`
+		OldHomeDomain *string `json:"old_home_domain"`
+	}
+	tt.Require.NoError(effects[0].UnmarshalDetails(&details))
+	tt.Assert.Equal("nullstyle.com", details.HomeDomain)
+	if tt.Assert.NotNil(details.OldHomeDomain) {
+		tt.Assert.Equal("", *details.OldHomeDomain)
+	}
+}
+
+func TestIngest_CountTrustlineChanges(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("change_trust")
+	defer tt.Finish()
+
+	q := &history.Q{Session: tt.HorizonSession()}
+
+	// null by default
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	var l history.Ledger
+	tt.Require.NoError(q.LedgerBySequence(&l, 3))
+	tt.Assert.False(l.TrustlinesChanged.Valid)
+
+	sys := sys(tt)
+	sys.CountTrustlineChanges = true
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	// the trustline is created, updated and removed in ledgers 3 to 5
+	for seq, expected := range map[int32]int64{2: 0, 3: 1, 4: 1, 5: 1} {
+		tt.Require.NoError(q.LedgerBySequence(&l, seq))
+		tt.Assert.True(l.TrustlinesChanged.Valid)
+		tt.Assert.Equal(expected, l.TrustlinesChanged.Int64, "ledger %d", seq)
+	}
+}
+
+func TestIngest_AccountWhitelist(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	// ledger 2 creates scott, bartek and andrew, and ledger 3 pays andrew
+	// from scott, so only the creation of bartek involves bartek
+	bartek := "GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2"
+
+	sys := sys(tt)
+	sys.AccountWhitelist = map[string]bool{bartek: true}
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	hq := tt.HorizonSession()
+
+	var ledgers int
+	tt.Require.NoError(hq.GetRaw(&ledgers, `SELECT COUNT(*) FROM history_ledgers`))
+	tt.Assert.Equal(3, ledgers)
+
+	var txs, ops []int64
+	tt.Require.NoError(hq.SelectRaw(&txs, `SELECT id FROM history_transactions ORDER BY id`))
+	tt.Require.NoError(hq.SelectRaw(&ops, `SELECT id FROM history_operations ORDER BY id`))
+	tt.Assert.Equal([]int64{8589942784}, txs)
+	tt.Assert.Equal([]int64{8589942785}, ops)
+
+	var other int
+	tt.Require.NoError(hq.GetRaw(&other, `
+		SELECT
+			(SELECT COUNT(*) FROM history_effects WHERE history_operation_id <> 8589942785) +
+			(SELECT COUNT(*) FROM history_operation_participants WHERE history_operation_id <> 8589942785) +
+			(SELECT COUNT(*) FROM history_transaction_participants WHERE history_transaction_id <> 8589942784)
+	`))
+	tt.Assert.Equal(0, other)
+
+	var effects int
+	tt.Require.NoError(hq.GetRaw(&effects, `SELECT COUNT(*) FROM history_effects`))
+	tt.Assert.NotZero(effects)
+
+	// rebuilding the participants of the whitelisted ledger writes none for
+	// the operations that were not ingested
+	var participants int
+	tt.Require.NoError(hq.GetRaw(&participants, `SELECT COUNT(*) FROM history_operation_participants`))
+	tt.Require.NoError(sys.RebuildParticipants(2, 2))
+
+	var rebuilt []int64
+	tt.Require.NoError(hq.SelectRaw(&rebuilt, `
+		SELECT DISTINCT history_operation_id FROM history_operation_participants
+	`))
+	tt.Assert.Equal(ops, rebuilt)
+	tt.Require.NoError(hq.GetRaw(&other, `SELECT COUNT(*) FROM history_operation_participants`))
+	tt.Assert.Equal(participants, other)
+}
+
+func TestIngest_ParticipantRoles(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	// ledger 3 pays andrew from scott
+	scott := "GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU"
+	andrew := "GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON"
+
+	hq := tt.HorizonSession()
+	roles := func() map[string]null.String {
+		var rows []struct {
+			Address string      `db:"address"`
+			Role    null.String `db:"role"`
+		}
+		tt.Require.NoError(hq.SelectRaw(&rows, `
+			SELECT ha.address, hop.role
+			FROM history_operation_participants hop
+			JOIN history_accounts ha ON ha.id = hop.history_account_id
+			WHERE hop.history_operation_id = 12884905985
+		`))
+
+		ret := map[string]null.String{}
+		for _, row := range rows {
+			ret[row.Address] = row.Role
+		}
+		return ret
+	}
+
+	// null by default
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+	tt.Assert.Equal(map[string]null.String{
+		scott:  {},
+		andrew: {},
+	}, roles())
+
+	sys := sys(tt)
+	sys.ParticipantRoles = true
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	tt.Assert.Equal(map[string]null.String{
+		scott:  null.StringFrom("source"),
+		andrew: null.StringFrom("destination"),
+	}, roles())
+}
@@ -0,0 +1,23 @@
+package ingest
+
+import (
+	"github.com/stellar/go/services/horizon/internal/db2/core"
+)
+
+// transactionMemo adds the memo of `tx`, the transaction with id `id`, to
+// history_transaction_memos when IndexMemos is set.  Transactions without a
+// memo have no row.
+func (ingest *Ingestion) transactionMemo(id int64, tx *core.Transaction) error {
+	if !ingest.IndexMemos {
+		return nil
+	}
+
+	memo := tx.Memo()
+	if !memo.Valid {
+		return nil
+	}
+
+	return ingest.insertRow(ingest.transactionMemos, "history_transaction_memos",
+		id, tx.MemoType(), memo.String,
+	)
+}
@@ -0,0 +1,259 @@
+package ingest
+
+import (
+	"encoding/base64"
+
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/xdr"
+)
+
+// ingestEffectsFromMeta adds the effects of the current operation, deriving
+// them from the ledger entry changes recorded in the operation's meta.  See
+// Session.EffectsFromMeta.
+//
+// Each changed entry produces effects for the differences between its state
+// before and after the operation, in the order stellar-core recorded the
+// changes:
+//
+//   - accounts: account_created (with the account's signer_created effects),
+//     account_credited/account_debited for native balance changes,
+//     home_domain_updated, thresholds_updated, flags_updated and the signer
+//     effects, and account_removed (preceded by the debit of any remaining
+//     balance).
+//   - trustlines: trustline_created, trustline_updated for limit changes,
+//     trustline_authorized/trustline_deauthorized (for the issuer),
+//     account_credited/account_debited for balance changes and
+//     trustline_removed.
+//   - offers: offer_created, offer_updated and offer_removed.
+//   - data: data_created, data_updated and data_removed.
+//
+// Unlike effects derived from the operation's inputs, every balance change is
+// reported, including those of the sellers whose offers were crossed.  Trades
+// have no ledger entry of their own, so trade effects are still taken from the
+// offers claimed in the operation's result.
+func (is *Session) ingestEffectsFromMeta(effects *EffectIngestion) {
+	for _, change := range entryChanges(is.Cursor.OperationChanges()) {
+		if is.Err != nil {
+			return
+		}
+
+		switch change.Key.Type {
+		case xdr.LedgerEntryTypeAccount:
+			is.accountMetaEffects(effects, change)
+		case xdr.LedgerEntryTypeTrustline:
+			is.trustlineMetaEffects(effects, change)
+		case xdr.LedgerEntryTypeOffer:
+			is.offerMetaEffects(effects, change)
+		case xdr.LedgerEntryTypeData:
+			is.dataMetaEffects(effects, change)
+		}
+	}
+
+	is.ingestTradeEffects(effects, is.Cursor.OperationSourceAccount(), is.operationClaims())
+}
+
+func (is *Session) accountMetaEffects(effects *EffectIngestion, change entryChange) {
+	aid := change.Key.MustAccount().AccountId
+
+	switch {
+	case change.Before == nil && change.After != nil:
+		after := change.After.Data.MustAccount()
+		effects.Add(aid, history.EffectAccountCreated,
+			map[string]interface{}{
+				"starting_balance": is.formatAmount(after.Balance),
+			},
+		)
+		is.signerEffects(effects, aid, xdr.AccountEntry{}, after)
+	case change.Before != nil && change.After == nil:
+		before := change.Before.Data.MustAccount()
+		is.balanceMetaEffects(effects, aid, xdr.Asset{Type: xdr.AssetTypeAssetTypeNative}, before.Balance, 0)
+		effects.Add(aid, history.EffectAccountRemoved, map[string]interface{}{})
+	case change.Before != nil && change.After != nil:
+		before := change.Before.Data.MustAccount()
+		after := change.After.Data.MustAccount()
+
+		is.balanceMetaEffects(effects, aid, xdr.Asset{Type: xdr.AssetTypeAssetTypeNative}, before.Balance, after.Balance)
+
+		if before.HomeDomain != after.HomeDomain {
+			effects.Add(aid, history.EffectAccountHomeDomainUpdated,
+				map[string]interface{}{
+					"home_domain":     string(after.HomeDomain),
+					"old_home_domain": string(before.HomeDomain),
+				},
+			)
+		}
+
+		thresholdDetails := map[string]interface{}{}
+		if before.Thresholds[1] != after.Thresholds[1] {
+			thresholdDetails["low_threshold"] = after.Thresholds[1]
+		}
+		if before.Thresholds[2] != after.Thresholds[2] {
+			thresholdDetails["med_threshold"] = after.Thresholds[2]
+		}
+		if before.Thresholds[3] != after.Thresholds[3] {
+			thresholdDetails["high_threshold"] = after.Thresholds[3]
+		}
+		if len(thresholdDetails) > 0 {
+			effects.Add(aid, history.EffectAccountThresholdsUpdated, thresholdDetails)
+		}
+
+		set := after.Flags &^ before.Flags
+		cleared := before.Flags &^ after.Flags
+		flagDetails := map[string]bool{}
+		is.effectFlagDetails(flagDetails, &set, true)
+		is.effectFlagDetails(flagDetails, &cleared, false)
+		if len(flagDetails) > 0 {
+			effects.Add(aid, history.EffectAccountFlagsUpdated, flagDetails)
+		}
+
+		if !signersEqual(before.SignerSummary(), after.SignerSummary()) {
+			is.signerEffects(effects, aid, before, after)
+		}
+	}
+}
+
+func (is *Session) trustlineMetaEffects(effects *EffectIngestion, change entryChange) {
+	key := change.Key.MustTrustLine()
+	dets := map[string]interface{}{}
+	is.assetDetails(dets, key.Asset, "")
+
+	switch {
+	case change.Before == nil && change.After != nil:
+		dets["limit"] = is.formatAmount(change.After.Data.MustTrustLine().Limit)
+		is.reserveDetails(effects, dets, 1)
+		effects.Add(key.AccountId, history.EffectTrustlineCreated, dets)
+	case change.Before != nil && change.After == nil:
+		// trustlines are removed by setting their limit to zero
+		dets["limit"] = is.formatAmount(0)
+		is.reserveDetails(effects, dets, -1)
+		effects.Add(key.AccountId, history.EffectTrustlineRemoved, dets)
+	case change.Before != nil && change.After != nil:
+		before := change.Before.Data.MustTrustLine()
+		after := change.After.Data.MustTrustLine()
+
+		if before.Limit != after.Limit {
+			dets["limit"] = is.formatAmount(after.Limit)
+			effects.Add(key.AccountId, history.EffectTrustlineUpdated, dets)
+		}
+
+		wasAuthorized := before.Flags&xdr.Uint32(xdr.TrustLineFlagsAuthorizedFlag) != 0
+		isAuthorized := after.Flags&xdr.Uint32(xdr.TrustLineFlagsAuthorizedFlag) != 0
+		if wasAuthorized != isAuthorized {
+			var issuer xdr.AccountId
+			is.Err = key.Asset.Extract(nil, nil, &issuer)
+			if is.Err != nil {
+				return
+			}
+
+			authDets := map[string]interface{}{"trustor": is.Ingestion.address(key.AccountId)}
+			is.assetDetails(authDets, key.Asset, "")
+
+			effect := history.EffectTrustlineDeauthorized
+			if isAuthorized {
+				effect = history.EffectTrustlineAuthorized
+			}
+			effects.Add(issuer, effect, authDets)
+		}
+
+		is.balanceMetaEffects(effects, key.AccountId, key.Asset, before.Balance, after.Balance)
+	}
+}
+
+func (is *Session) offerMetaEffects(effects *EffectIngestion, change entryChange) {
+	key := change.Key.MustOffer()
+
+	entry := change.After
+	effect := history.EffectOfferUpdated
+	switch {
+	case change.Before == nil:
+		effect = history.EffectOfferCreated
+	case change.After == nil:
+		entry = change.Before
+		effect = history.EffectOfferRemoved
+	}
+
+	// the prior state of a removed offer may be missing from the meta
+	if entry == nil {
+		return
+	}
+
+	offer := entry.Data.MustOffer()
+	dets := map[string]interface{}{
+		"offer_id": offer.OfferId,
+		"price":    offer.Price.String(),
+		"price_r": map[string]interface{}{
+			"n": offer.Price.N,
+			"d": offer.Price.D,
+		},
+	}
+	if change.After != nil {
+		dets["amount"] = is.formatAmount(offer.Amount)
+	} else {
+		dets["amount"] = is.formatAmount(0)
+	}
+	is.assetDetails(dets, offer.Selling, "selling_")
+	is.assetDetails(dets, offer.Buying, "buying_")
+
+	effects.Add(key.SellerId, effect, dets)
+}
+
+func (is *Session) dataMetaEffects(effects *EffectIngestion, change entryChange) {
+	key := change.Key.MustData()
+	dets := map[string]interface{}{"name": key.DataName}
+
+	if change.After != nil {
+		raw := change.After.Data.MustData().DataValue
+		dets["value"] = base64.StdEncoding.EncodeToString(raw)
+	}
+
+	switch {
+	case change.Before == nil && change.After != nil:
+		is.reserveDetails(effects, dets, 1)
+		effects.Add(key.AccountId, history.EffectDataCreated, dets)
+	case change.Before != nil && change.After == nil:
+		is.reserveDetails(effects, dets, -1)
+		effects.Add(key.AccountId, history.EffectDataRemoved, dets)
+	case change.Before != nil && change.After != nil:
+		effects.Add(key.AccountId, history.EffectDataUpdated, dets)
+	}
+}
+
+// balanceMetaEffects adds a credit or debit effect to `aid` for the change of
+// its balance of `asset` from `before` to `after`, if any.
+func (is *Session) balanceMetaEffects(
+	effects *EffectIngestion,
+	aid xdr.AccountId,
+	asset xdr.Asset,
+	before xdr.Int64,
+	after xdr.Int64,
+) {
+	if before == after {
+		return
+	}
+
+	effect := history.EffectAccountCredited
+	delta := after - before
+	if delta < 0 {
+		effect = history.EffectAccountDebited
+		delta = -delta
+	}
+
+	dets := map[string]interface{}{"amount": is.formatAmount(delta)}
+	is.assetDetails(dets, asset, "")
+	effects.Add(aid, effect, dets)
+}
+
+// signersEqual returns true if the signer summaries `a` and `b` are the same.
+func signersEqual(a, b map[string]int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for addy, weight := range a {
+		if w, ok := b[addy]; !ok || w != weight {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,74 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngest_EffectsFromMeta(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	type effect struct {
+		OperationID int64  `db:"history_operation_id"`
+		Type        int    `db:"type"`
+		Account     string `db:"address"`
+		Details     string `db:"details"`
+	}
+
+	// native payments between distinct accounts and trustline changes are the
+	// operations whose input-derived effects exactly describe their changes
+	load := func() []effect {
+		var effects []effect
+		err := tt.HorizonSession().SelectRaw(&effects, `
+			SELECT e.history_operation_id, e.type, ha.address, e.details::text AS details
+			FROM history_effects e
+			JOIN history_operations o ON o.id = e.history_operation_id
+			JOIN history_accounts ha ON ha.id = e.history_account_id
+			WHERE (
+				o.type = 1
+				AND o.details->>'asset_type' = 'native'
+				AND o.details->>'from' <> o.details->>'to'
+			) OR o.type = 6
+			ORDER BY 1, 2, 3, 4
+		`)
+		tt.Require.NoError(err)
+		return effects
+	}
+
+	sys := sys(tt)
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.Run()
+	tt.Require.NoError(s.Err)
+	fromInputs := load()
+	tt.Require.NotEmpty(fromInputs)
+
+	sys.EffectsFromMeta = true
+	s = NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+	tt.Assert.Equal(fromInputs, load())
+
+	// offer changes are only reported by the effects derived from meta
+	var offerEffects int
+	err := tt.HorizonSession().GetRaw(&offerEffects, `
+		SELECT COUNT(*) FROM history_effects WHERE type IN (30, 31, 32)
+	`)
+	tt.Require.NoError(err)
+	tt.Assert.NotZero(offerEffects)
+}
+
+func TestSignersEqual(t *testing.T) {
+	a := map[string]int32{"GA": 1, "GB": 2}
+
+	assert.True(t, signersEqual(a, map[string]int32{"GA": 1, "GB": 2}))
+	assert.False(t, signersEqual(a, map[string]int32{"GA": 1, "GB": 3}))
+	assert.False(t, signersEqual(a, map[string]int32{"GA": 1, "GC": 2}))
+	assert.False(t, signersEqual(a, map[string]int32{"GA": 1}))
+}
@@ -0,0 +1,87 @@
+package ingest
+
+import (
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/guregu/null"
+	"github.com/stellar/go/services/horizon/internal/db2/core"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+)
+
+// DefaultOutboxEncoder encodes a ledger as the json payload of an outbox
+// event.
+func DefaultOutboxEncoder(ledger history.Ledger) []byte {
+	payload, err := json.Marshal(struct {
+		ID               int64     `json:"id"`
+		Sequence         int32     `json:"sequence"`
+		Hash             string    `json:"hash"`
+		PrevHash         string    `json:"prev_hash,omitempty"`
+		ClosedAt         time.Time `json:"closed_at"`
+		TransactionCount int32     `json:"transaction_count"`
+		OperationCount   int32     `json:"operation_count"`
+		ProtocolVersion  int32     `json:"protocol_version"`
+	}{
+		ID:               ledger.ID,
+		Sequence:         ledger.Sequence,
+		Hash:             ledger.LedgerHash,
+		PrevHash:         ledger.PreviousLedgerHash.String,
+		ClosedAt:         ledger.ClosedAt,
+		TransactionCount: ledger.TransactionCount,
+		OperationCount:   ledger.OperationCount,
+		ProtocolVersion:  ledger.ProtocolVersion,
+	})
+
+	// the struct above contains nothing that can fail to marshal
+	if err != nil {
+		panic(err)
+	}
+
+	return payload
+}
+
+// outbox writes the outbox event for a ledger into the ingestion_outbox table
+// of the primary db.  The event is written within the ingestion's transaction
+// so that it is committed if, and only if, the ledger's data is.
+func (ingest *Ingestion) outbox(
+	id int64,
+	header *core.LedgerHeader,
+	txs int,
+	ops int,
+	now time.Time,
+) error {
+	encode := ingest.OutboxEncoder
+	if encode == nil {
+		encode = DefaultOutboxEncoder
+	}
+
+	payload := encode(history.Ledger{
+		TotalOrderID:       history.TotalOrderID{ID: id},
+		Sequence:           int32(header.Sequence),
+		ImporterVersion:    CurrentVersion,
+		LedgerHash:         header.LedgerHash,
+		PreviousLedgerHash: null.NewString(header.PrevHash, header.Sequence > 1),
+		TransactionCount:   int32(txs),
+		OperationCount:     int32(ops),
+		ClosedAt:           ingest.closeTime(header.CloseTime),
+		CreatedAt:          now,
+		UpdatedAt:          now,
+		TotalCoins:         int64(header.Data.TotalCoins),
+		FeePool:            int64(header.Data.FeePool),
+		BaseFee:            int32(header.Data.BaseFee),
+		BaseReserve:        int32(header.Data.BaseReserve),
+		MaxTxSetSize:       int32(header.Data.MaxTxSetSize),
+		ProtocolVersion:    int32(header.Data.LedgerVersion),
+		LedgerHeaderXDR:    null.StringFrom(header.DataXDR()),
+	})
+
+	sql := sq.Insert("ingestion_outbox").
+		Columns("ledger_id", "payload", "created_at").
+		Values(id, payload, now)
+
+	// NOTE: the outbox is drained from the primary db only, so the event is not
+	// mirrored to the secondary.
+	_, err := ingest.DB.Exec(sql)
+	return err
+}
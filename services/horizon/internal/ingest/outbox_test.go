@@ -0,0 +1,62 @@
+package ingest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultOutboxEncoder(t *testing.T) {
+	payload := DefaultOutboxEncoder(history.Ledger{
+		TotalOrderID:     history.TotalOrderID{ID: 8589934592},
+		Sequence:         2,
+		LedgerHash:       "abc",
+		TransactionCount: 3,
+		OperationCount:   4,
+		ClosedAt:         time.Unix(1500000000, 0).UTC(),
+		ProtocolVersion:  9,
+	})
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(payload, &decoded))
+	assert.Equal(t, float64(8589934592), decoded["id"])
+	assert.Equal(t, float64(2), decoded["sequence"])
+	assert.Equal(t, "abc", decoded["hash"])
+	assert.Equal(t, "2017-07-14T02:40:00Z", decoded["closed_at"])
+	assert.NotContains(t, decoded, "prev_hash")
+}
+
+func TestOutbox(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	sys := sys(tt)
+	sys.OutboxEnabled = true
+	sys.OutboxEncoder = func(l history.Ledger) []byte {
+		return []byte(l.LedgerHash)
+	}
+
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	var events []struct {
+		LedgerID int64  `db:"ledger_id"`
+		Payload  []byte `db:"payload"`
+	}
+	err := tt.HorizonSession().SelectRaw(&events, "SELECT ledger_id, payload FROM ingestion_outbox ORDER BY id")
+	tt.Require.NoError(err)
+	tt.Require.Len(events, s.Ingested)
+
+	var hash string
+	err = tt.HorizonSession().GetRaw(&hash, "SELECT ledger_hash FROM history_ledgers WHERE id = ?", events[0].LedgerID)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(hash, string(events[0].Payload))
+}
@@ -8,26 +8,67 @@ import (
 	"github.com/stellar/go/xdr"
 )
 
+// Role is the part an account plays in an operation.
+type Role string
+
+const (
+	// RoleSource is the role of the operation's source account.
+	RoleSource Role = "source"
+
+	// RoleTransactionSource is the role of the source account of the
+	// operation's transaction, when the operation overrides its source
+	// account.
+	RoleTransactionSource Role = "transaction_source"
+
+	// RoleDestination is the role of the account receiving the new account,
+	// payment or merged balance of the operation.
+	RoleDestination Role = "destination"
+
+	// RoleTrustor is the role of the account whose trustline is authorized or
+	// deauthorized by an allow trust operation.
+	RoleTrustor Role = "trustor"
+)
+
+// Participant is an account participating in an operation, and its role.
+type Participant struct {
+	Account xdr.AccountId
+	Role    Role
+}
+
 // ForOperation returns all the participating accounts from the
-// provided operation.
+// provided operation.  The source account of the transaction is always a
+// participant, even when the operation overrides its source account.
 func ForOperation(
 	tx *xdr.Transaction,
 	op *xdr.Operation,
 ) (result []xdr.AccountId, err error) {
 
-	if op.SourceAccount != nil {
-		result = append(result, *op.SourceAccount)
-	} else {
-		result = append(result, tx.SourceAccount)
-	}
+	p, err := ForOperationWithRoles(tx, op)
+	result = Accounts(p)
+	return
+}
+
+// ForOperationWithRoles returns the participants of the provided operation,
+// the accounts returned by ForOperation, along with their roles.  An account
+// playing several roles is returned once, with the first of RoleSource,
+// RoleTransactionSource and the role specific to the operation's type.
+func ForOperationWithRoles(
+	tx *xdr.Transaction,
+	op *xdr.Operation,
+) (result []Participant, err error) {
+
+	result = append(result,
+		Participant{OperationSource(tx, op), RoleSource},
+		Participant{tx.SourceAccount, RoleTransactionSource},
+	)
 
 	switch op.Body.Type {
 	case xdr.OperationTypeCreateAccount:
-		result = append(result, op.Body.MustCreateAccountOp().Destination)
+		result = append(result, Participant{op.Body.MustCreateAccountOp().Destination, RoleDestination})
 	case xdr.OperationTypePayment:
-		result = append(result, op.Body.MustPaymentOp().Destination)
+		result = append(result, Participant{op.Body.MustPaymentOp().Destination, RoleDestination})
 	case xdr.OperationTypePathPayment:
-		result = append(result, op.Body.MustPathPaymentOp().Destination)
+		result = append(result, Participant{op.Body.MustPathPaymentOp().Destination, RoleDestination})
 	case xdr.OperationTypeManageOffer:
 		// the only direct participant is the source_account
 	case xdr.OperationTypeCreatePassiveOffer:
@@ -37,9 +78,9 @@ func ForOperation(
 	case xdr.OperationTypeChangeTrust:
 		// the only direct participant is the source_account
 	case xdr.OperationTypeAllowTrust:
-		result = append(result, op.Body.MustAllowTrustOp().Trustor)
+		result = append(result, Participant{op.Body.MustAllowTrustOp().Trustor, RoleTrustor})
 	case xdr.OperationTypeAccountMerge:
-		result = append(result, op.Body.MustDestination())
+		result = append(result, Participant{op.Body.MustDestination(), RoleDestination})
 	case xdr.OperationTypeInflation:
 		// the only direct participant is the source_account
 	case xdr.OperationTypeManageData:
@@ -48,10 +89,21 @@ func ForOperation(
 		err = fmt.Errorf("Unknown operation type: %s", op.Body.Type)
 	}
 
-	result = dedupe(result)
+	result = dedupeRoles(result)
 	return
 }
 
+// OperationSource returns the effective source account of `op`, a member of
+// `tx`: the operation's own source account when it has one, and the
+// transaction's source account otherwise.
+func OperationSource(tx *xdr.Transaction, op *xdr.Operation) xdr.AccountId {
+	if op.SourceAccount != nil {
+		return *op.SourceAccount
+	}
+
+	return tx.SourceAccount
+}
+
 // ForTransaction returns all the participating accounts from the provided
 // transaction.
 func ForTransaction(
@@ -86,6 +138,14 @@ func ForTransaction(
 	return
 }
 
+// Accounts returns the accounts of the participants `p`, in order.
+func Accounts(p []Participant) (result []xdr.AccountId) {
+	for _, participant := range p {
+		result = append(result, participant.Account)
+	}
+	return
+}
+
 // dedupe remove any duplicate ids from `in`
 func dedupe(in []xdr.AccountId) (out []xdr.AccountId) {
 	set := map[string]xdr.AccountId{}
@@ -99,6 +159,21 @@ func dedupe(in []xdr.AccountId) (out []xdr.AccountId) {
 	return
 }
 
+// dedupeRoles removes any participant from `in` whose account is that of an
+// earlier participant.
+func dedupeRoles(in []Participant) (out []Participant) {
+	seen := map[string]bool{}
+	for _, p := range in {
+		address := p.Account.Address()
+		if seen[address] {
+			continue
+		}
+		seen[address] = true
+		out = append(out, p)
+	}
+	return
+}
+
 func forChanges(
 	changes *xdr.LedgerEntryChanges,
 ) (result []xdr.AccountId, err error) {
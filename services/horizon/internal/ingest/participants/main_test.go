@@ -6,6 +6,8 @@ import (
 	"github.com/stellar/go/services/horizon/internal/db2/core"
 	"github.com/stellar/go/services/horizon/internal/test"
 	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestForOperation(t *testing.T) {
@@ -84,6 +86,86 @@ func TestForOperation(t *testing.T) {
 	p = load(49, 0, 0)
 	tt.Assert.Len(p, 1)
 	tt.Assert.Contains(p, aid("GAYSCMKQY6EYLXOPTT6JPPOXDMVNBWITPTSZIVWW4LWARVBOTH5RTLAD"))
+
+	// test payment with a different source account than its transaction
+	p = load(54, 0, 1)
+	tt.Require.Len(p, 2)
+	tt.Assert.Contains(p, aid("GACJPE4YUR22VP4CM2BDFDAHY3DLEF3H7NENKUQ53DT5TEI2GAHT5N4X"))
+	tt.Assert.Contains(p, aid("GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"))
+}
+
+func TestOperationSource(t *testing.T) {
+	txSource := aid("GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H")
+	opSource := aid("GACJPE4YUR22VP4CM2BDFDAHY3DLEF3H7NENKUQ53DT5TEI2GAHT5N4X")
+
+	tx := xdr.Transaction{SourceAccount: txSource}
+	op := xdr.Operation{Body: xdr.OperationBody{
+		Type:         xdr.OperationTypeManageData,
+		ManageDataOp: &xdr.ManageDataOp{DataName: "name"},
+	}}
+
+	// without an explicit source the transaction's source is used
+	assert.Equal(t, txSource, OperationSource(&tx, &op))
+
+	p, err := ForOperation(&tx, &op)
+	require.NoError(t, err)
+	require.Len(t, p, 1)
+	assert.Equal(t, txSource.Address(), p[0].Address())
+
+	// an explicit source overrides the transaction's, but the transaction's
+	// source remains a participant
+	op.SourceAccount = &opSource
+	assert.Equal(t, opSource, OperationSource(&tx, &op))
+
+	p, err = ForOperation(&tx, &op)
+	require.NoError(t, err)
+	assert.Len(t, p, 2)
+	assert.Contains(t, p, txSource)
+	assert.Contains(t, p, opSource)
+}
+
+func TestForOperationWithRoles(t *testing.T) {
+	txSource := aid("GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H")
+	opSource := aid("GACJPE4YUR22VP4CM2BDFDAHY3DLEF3H7NENKUQ53DT5TEI2GAHT5N4X")
+	dest := aid("GA46VRKBCLI2X6DXLX7AIEVRFLH3UA7XBE3NGNP6O74HQ5LXHMGTV2JB")
+
+	tx := xdr.Transaction{SourceAccount: txSource}
+	op := xdr.Operation{Body: xdr.OperationBody{
+		Type: xdr.OperationTypePayment,
+		PaymentOp: &xdr.PaymentOp{
+			Destination: dest,
+			Asset:       xdr.Asset{Type: xdr.AssetTypeAssetTypeNative},
+			Amount:      100,
+		},
+	}}
+
+	p, err := ForOperationWithRoles(&tx, &op)
+	require.NoError(t, err)
+	assert.Equal(t, []Participant{
+		{txSource, RoleSource},
+		{dest, RoleDestination},
+	}, p)
+
+	// the transaction's source keeps its own role when the operation
+	// overrides the source account
+	op.SourceAccount = &opSource
+	p, err = ForOperationWithRoles(&tx, &op)
+	require.NoError(t, err)
+	assert.Equal(t, []Participant{
+		{opSource, RoleSource},
+		{txSource, RoleTransactionSource},
+		{dest, RoleDestination},
+	}, p)
+
+	// a payment to its own source is a single participant
+	op.Body.PaymentOp.Destination = opSource
+	p, err = ForOperationWithRoles(&tx, &op)
+	require.NoError(t, err)
+	assert.Equal(t, []Participant{
+		{opSource, RoleSource},
+		{txSource, RoleTransactionSource},
+	}, p)
+	assert.Equal(t, []xdr.AccountId{opSource, txSource}, Accounts(p))
 }
 
 func TestForTransaction(t *testing.T) {
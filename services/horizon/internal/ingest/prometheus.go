@@ -0,0 +1,171 @@
+package ingest
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// PrometheusNamespace is the prefix of every metric name written by
+// WritePrometheus.
+const PrometheusNamespace = "horizon_ingester"
+
+// prometheusQuantiles are the quantiles reported for timers and histograms.
+var prometheusQuantiles = []float64{0.5, 0.75, 0.95, 0.99}
+
+// WritePrometheus writes a snapshot of the ingester's metrics to `w` in the
+// prometheus text exposition format.
+//
+// Every field of IngesterMetrics is written, named after the field: timers
+// and histograms become summaries (timers in seconds), counters become
+// counters and meters become counters of their total count.  Fields of type
+// map[string]<metric> are written as a single metric with one series per map
+// key, labeled using the field's `prometheus` tag (or "name" when untagged).
+func (i *System) WritePrometheus(w io.Writer) error {
+	pw := &prometheusWriter{w: w}
+
+	v := reflect.ValueOf(i.Metrics)
+	t := v.Type()
+	for f := 0; f < t.NumField(); f++ {
+		field := t.Field(f)
+		value := v.Field(f)
+
+		if value.Kind() != reflect.Map {
+			pw.metric(field.Name, nil, value.Interface())
+			continue
+		}
+
+		label := field.Tag.Get("prometheus")
+		if label == "" {
+			label = "name"
+		}
+
+		keys := value.MapKeys()
+		sort.Slice(keys, func(a, b int) bool { return keys[a].String() < keys[b].String() })
+		for _, key := range keys {
+			pw.metric(field.Name, []string{label, key.String()}, value.MapIndex(key).Interface())
+		}
+	}
+
+	return pw.err
+}
+
+// prometheusWriter writes metrics in the prometheus text format, remembering
+// the first write error.  TYPE lines are written once per metric name, so
+// labeled series of the same metric must be written consecutively.
+type prometheusWriter struct {
+	w    io.Writer
+	err  error
+	last string
+}
+
+// metric writes the series for `m`, a go-metrics value, under the name
+// derived from the IngesterMetrics field `field`.  Unset metrics are skipped.
+func (pw *prometheusWriter) metric(field string, labels []string, m interface{}) {
+	switch m := m.(type) {
+	case metrics.Timer:
+		s := m.Snapshot()
+		pw.summary(
+			prometheusName(field, "Timer")+"_seconds",
+			labels,
+			s.Percentiles(prometheusQuantiles),
+			s.Sum(),
+			s.Count(),
+			float64(time.Second),
+		)
+	case metrics.Histogram:
+		s := m.Snapshot()
+		pw.summary(
+			prometheusName(field, "Histogram"),
+			labels,
+			s.Percentiles(prometheusQuantiles),
+			s.Sum(),
+			s.Count(),
+			1,
+		)
+	case metrics.Counter:
+		name := prometheusName(field, "Counter") + "_total"
+		pw.header(name, "counter")
+		pw.printf("%s%s %d\n", name, prometheusLabels(labels), m.Snapshot().Count())
+	case metrics.Meter:
+		name := prometheusName(field, "Meter") + "_total"
+		pw.header(name, "counter")
+		pw.printf("%s%s %d\n", name, prometheusLabels(labels), m.Snapshot().Count())
+	case metrics.Gauge:
+		name := prometheusName(field, "Gauge")
+		pw.header(name, "gauge")
+		pw.printf("%s%s %d\n", name, prometheusLabels(labels), m.Snapshot().Value())
+	}
+}
+
+// summary writes a prometheus summary, dividing every value by `scale`.
+func (pw *prometheusWriter) summary(
+	name string,
+	labels []string,
+	percentiles []float64,
+	sum int64,
+	count int64,
+	scale float64,
+) {
+	pw.header(name, "summary")
+	for i, q := range prometheusQuantiles {
+		ql := append(append([]string{}, labels...), "quantile", fmt.Sprint(q))
+		pw.printf("%s%s %g\n", name, prometheusLabels(ql), percentiles[i]/scale)
+	}
+	pw.printf("%s_sum%s %g\n", name, prometheusLabels(labels), float64(sum)/scale)
+	pw.printf("%s_count%s %d\n", name, prometheusLabels(labels), count)
+}
+
+func (pw *prometheusWriter) header(name, typ string) {
+	if pw.last == name {
+		return
+	}
+	pw.last = name
+	pw.printf("# TYPE %s %s\n", name, typ)
+}
+
+func (pw *prometheusWriter) printf(format string, args ...interface{}) {
+	if pw.err != nil {
+		return
+	}
+	_, pw.err = fmt.Fprintf(pw.w, format, args...)
+}
+
+// prometheusName converts an IngesterMetrics field name such as
+// "IngestLedgerTimer" into a metric name such as
+// "horizon_ingester_ingest_ledger", dropping the metric type `suffix`.
+func prometheusName(field, suffix string) string {
+	field = strings.TrimSuffix(field, suffix)
+
+	var name strings.Builder
+	name.WriteString(PrometheusNamespace)
+	name.WriteByte('_')
+	for i, r := range field {
+		if i > 0 && unicode.IsUpper(r) {
+			name.WriteByte('_')
+		}
+		name.WriteRune(unicode.ToLower(r))
+	}
+	return name.String()
+}
+
+// prometheusLabels renders the key/value pairs `labels` as a prometheus label
+// set, returning the empty string when there are none.
+func prometheusLabels(labels []string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	pairs := make([]string, 0, len(labels)/2)
+	for i := 0; i+1 < len(labels); i += 2 {
+		value := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(labels[i+1])
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, labels[i], value))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
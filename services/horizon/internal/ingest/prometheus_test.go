@@ -0,0 +1,46 @@
+package ingest
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWritePrometheus(t *testing.T) {
+	sys := New("", "", nil, nil)
+	sys.Metrics.IngestLedgerTimer.Update(2 * time.Second)
+	sys.Metrics.LargeDetailsCounter.Inc(3)
+	sys.Metrics.DetailsSizeHistogram.Update(100)
+	sys.Metrics.LoadLedgerTimer = nil
+
+	var buf bytes.Buffer
+	require.NoError(t, sys.WritePrometheus(&buf))
+	out := buf.String()
+
+	assert.Contains(t, out, "# TYPE horizon_ingester_ingest_ledger_seconds summary\n")
+	assert.Contains(t, out, "horizon_ingester_ingest_ledger_seconds{quantile=\"0.5\"} 2\n")
+	assert.Contains(t, out, "horizon_ingester_ingest_ledger_seconds_sum 2\n")
+	assert.Contains(t, out, "horizon_ingester_ingest_ledger_seconds_count 1\n")
+	assert.Contains(t, out, "horizon_ingester_clear_ledger_seconds_count 0\n")
+	assert.Contains(t, out, "horizon_ingester_details_size_sum 100\n")
+	assert.Contains(t, out, "# TYPE horizon_ingester_large_details_total counter\n")
+	assert.Contains(t, out, "horizon_ingester_large_details_total 3\n")
+	assert.NotContains(t, out, "load_ledger")
+}
+
+func TestPrometheusLabels(t *testing.T) {
+	var buf bytes.Buffer
+	pw := &prometheusWriter{w: &buf}
+	c := metrics.NewCounter()
+	c.Inc(1)
+	pw.metric("InsertCounter", []string{"table", "history_effects"}, c)
+	pw.metric("InsertCounter", []string{"table", `odd"name`}, c)
+
+	assert.Equal(t, "# TYPE horizon_ingester_insert_total counter\n"+
+		"horizon_ingester_insert_total{table=\"history_effects\"} 1\n"+
+		"horizon_ingester_insert_total{table=\"odd\\\"name\"} 1\n", buf.String())
+}
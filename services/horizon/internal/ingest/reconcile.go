@@ -0,0 +1,216 @@
+package ingest
+
+import (
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// reconcileBatchSize is the number of ledgers whose transactions are counted
+// at a time by System.Reconcile.
+const reconcileBatchSize = 1000
+
+// ReconcileReport is the result of System.Reconcile.
+type ReconcileReport struct {
+	First int32
+	Last  int32
+
+	// Ledgers is the number of ledgers of the range found in the core db, the
+	// ledgers compared.
+	Ledgers int
+
+	// Mismatches are the ledgers whose history disagrees with the core db, in
+	// ascending order.
+	Mismatches []ReconcileMismatch
+}
+
+// OK returns true if no mismatches were found.
+func (r *ReconcileReport) OK() bool {
+	return len(r.Mismatches) == 0
+}
+
+// ReconcileMismatch is a ledger whose transactions in the history db disagree
+// with the core db.  Missing is true when the ledger has not been ingested at
+// all.
+type ReconcileMismatch struct {
+	Ledger  int32
+	Core    int
+	History int
+	Missing bool
+}
+
+// reconcileLedger is a ledger's transaction count.
+type reconcileLedger struct {
+	Sequence int32 `db:"sequence"`
+	Count    int   `db:"count"`
+}
+
+// Reconcile audits the history of the ledgers `first` through `last` against
+// the core db, the source of truth, reporting every ledger whose number of
+// transactions in history_transactions differs from the number core recorded.
+// Unlike the counts check of Session.IntegritySweep, this catches ledgers
+// whose header counts are themselves wrong.  Ledgers missing from the core db
+// are not compared, nor are partially ingested ledgers.  Failed transactions
+// are only counted when IngestFailedTransactions is set.  The range is
+// compared a batch of ledgers at a time, so it may be arbitrarily large.  An
+// error is only returned if the comparison could not be run.
+func (i *System) Reconcile(first, last int32) (*ReconcileReport, error) {
+	if first < 1 || first > last {
+		return nil, errors.Errorf("invalid ledger range: %d to %d", first, last)
+	}
+
+	report := &ReconcileReport{First: first, Last: last}
+
+	for lo := first; lo <= last; lo += reconcileBatchSize {
+		hi := lo + reconcileBatchSize - 1
+		if hi > last || hi < lo {
+			hi = last
+		}
+
+		err := i.reconcileBatch(report, lo, hi)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to reconcile ledgers %d to %d", lo, hi)
+		}
+
+		if hi == last {
+			break
+		}
+	}
+
+	return report, nil
+}
+
+// reconcileBatch compares the ledgers `lo` through `hi`, adding the
+// mismatches found to `report`.
+func (i *System) reconcileBatch(report *ReconcileReport, lo, hi int32) error {
+	coreCounts, err := i.coreTransactionCounts(lo, hi)
+	if err != nil {
+		return errors.Wrap(err, "failed to count core transactions")
+	}
+
+	var ledgers []struct {
+		Sequence int32 `db:"sequence"`
+		Partial  bool  `db:"partial"`
+	}
+	err = i.HorizonDB.SelectRaw(&ledgers, `
+		SELECT sequence, partial FROM history_ledgers
+		WHERE sequence BETWEEN ? AND ?`,
+		lo, hi,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to load ledgers")
+	}
+
+	ingested := map[int32]bool{}
+	for _, l := range ledgers {
+		ingested[l.Sequence] = !l.Partial
+	}
+
+	successful := ""
+	if !i.IngestFailedTransactions {
+		successful = "AND successful IS NOT FALSE"
+	}
+
+	var counts []reconcileLedger
+	err = i.HorizonDB.SelectRaw(&counts, `
+		SELECT ledger_sequence AS sequence, COUNT(*) AS count
+		FROM history_transactions
+		WHERE ledger_sequence BETWEEN ? AND ? `+successful+`
+		GROUP BY ledger_sequence`,
+		lo, hi,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to count history transactions")
+	}
+
+	historyCounts := map[int32]int{}
+	for _, c := range counts {
+		historyCounts[c.Sequence] = c.Count
+	}
+
+	for _, l := range coreCounts {
+		report.Ledgers++
+
+		full, found := ingested[l.Sequence]
+		switch {
+		case !found:
+			report.Mismatches = append(report.Mismatches, ReconcileMismatch{
+				Ledger:  l.Sequence,
+				Core:    l.Count,
+				Missing: true,
+			})
+		case full && historyCounts[l.Sequence] != l.Count:
+			report.Mismatches = append(report.Mismatches, ReconcileMismatch{
+				Ledger:  l.Sequence,
+				Core:    l.Count,
+				History: historyCounts[l.Sequence],
+			})
+		}
+	}
+
+	return nil
+}
+
+// coreTransactionCounts returns the number of transactions of every ledger
+// `lo` through `hi` found in the core db, in ascending order.  Failed
+// transactions are only counted when IngestFailedTransactions is set, which
+// requires the results of the ledgers' transactions to be decoded.
+func (i *System) coreTransactionCounts(lo, hi int32) ([]reconcileLedger, error) {
+	var ledgers []reconcileLedger
+	err := i.CoreDB.SelectRaw(&ledgers, `
+		SELECT ledgerseq AS sequence, 0 AS count FROM ledgerheaders
+		WHERE ledgerseq BETWEEN ? AND ?
+		ORDER BY ledgerseq`,
+		lo, hi,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	index := map[int32]int{}
+	for n, l := range ledgers {
+		index[l.Sequence] = n
+	}
+
+	if i.IngestFailedTransactions {
+		var counts []reconcileLedger
+		err = i.CoreDB.SelectRaw(&counts, `
+			SELECT ledgerseq AS sequence, COUNT(*) AS count FROM txhistory
+			WHERE ledgerseq BETWEEN ? AND ?
+			GROUP BY ledgerseq`,
+			lo, hi,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range counts {
+			if n, ok := index[c.Sequence]; ok {
+				ledgers[n].Count = c.Count
+			}
+		}
+
+		return ledgers, nil
+	}
+
+	var results []struct {
+		Sequence int32                     `db:"ledgerseq"`
+		Result   xdr.TransactionResultPair `db:"txresult"`
+	}
+	err = i.CoreDB.SelectRaw(&results, `
+		SELECT ledgerseq, txresult FROM txhistory
+		WHERE ledgerseq BETWEEN ? AND ?`,
+		lo, hi,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range results {
+		n, ok := index[r.Sequence]
+		if ok && r.Result.Result.Result.Code == xdr.TransactionResultCodeTxSuccess {
+			ledgers[n].Count++
+		}
+	}
+
+	return ledgers, nil
+}
@@ -0,0 +1,67 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+)
+
+func TestReconcile(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	is := sys(tt)
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+	latest := ledger.CurrentState().CoreLatest
+
+	_, err := is.Reconcile(5, 2)
+	tt.Assert.Error(err)
+
+	report, err := is.Reconcile(1, latest)
+	tt.Require.NoError(err)
+	tt.Assert.True(report.OK(), "%v", report.Mismatches)
+	tt.Assert.Equal(int(latest), report.Ledgers)
+
+	// lose a transaction of one ledger, leaving its header counts untouched,
+	// and the whole of another
+	hq := tt.HorizonSession()
+	var lossy int32
+	tt.Require.NoError(hq.GetRaw(&lossy, `
+		SELECT ledger_sequence FROM history_transactions
+		GROUP BY ledger_sequence ORDER BY COUNT(*) DESC, ledger_sequence LIMIT 1`,
+	))
+	var txs int
+	tt.Require.NoError(hq.GetRaw(&txs, `SELECT COUNT(*) FROM history_transactions WHERE ledger_sequence = ?`, lossy))
+	_, err = hq.ExecRaw(`
+		DELETE FROM history_transactions WHERE id = (
+			SELECT MAX(id) FROM history_transactions WHERE ledger_sequence = ?
+		)`, lossy,
+	)
+	tt.Require.NoError(err)
+
+	missing := latest
+	if missing == lossy {
+		missing--
+	}
+	_, err = hq.ExecRaw(`DELETE FROM history_ledgers WHERE sequence = ?`, missing)
+	tt.Require.NoError(err)
+
+	report, err = is.Reconcile(1, latest)
+	tt.Require.NoError(err)
+	tt.Require.Len(report.Mismatches, 2)
+
+	for _, m := range report.Mismatches {
+		switch m.Ledger {
+		case lossy:
+			tt.Assert.False(m.Missing)
+			tt.Assert.Equal(txs, m.Core)
+			tt.Assert.Equal(txs-1, m.History)
+		case missing:
+			tt.Assert.True(m.Missing)
+		default:
+			t.Errorf("unexpected mismatch: %v", m)
+		}
+	}
+}
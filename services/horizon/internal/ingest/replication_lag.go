@@ -0,0 +1,68 @@
+package ingest
+
+import (
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/log"
+	"github.com/stellar/go/support/errors"
+)
+
+const (
+	// DefaultReplicationLagQuery measures the replay lag of the slowest
+	// streaming replica of the database.  Lag is reported as 0 when there are
+	// no replicas.
+	DefaultReplicationLagQuery = `SELECT COALESCE(EXTRACT(EPOCH FROM MAX(replay_lag)), 0) FROM pg_stat_replication`
+
+	// DefaultReplicationLagPollInterval is the default time waited between
+	// measurements of the replication lag while ingestion is paused.
+	DefaultReplicationLagPollInterval = 5 * time.Second
+)
+
+// Lag measures the current replication lag.
+func (m *ReplicationLagMonitor) Lag() (time.Duration, error) {
+	query := m.Query
+	if query == "" {
+		query = DefaultReplicationLagQuery
+	}
+
+	var seconds float64
+	err := m.DB.GetRaw(&seconds, query)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to measure replication lag")
+	}
+
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// Wait blocks until the replication lag is at or below MaxLag.  A failure to
+// measure the lag is logged and treated as no lag, so that a misconfigured
+// query cannot stall ingestion indefinitely.
+func (m *ReplicationLagMonitor) Wait() {
+	interval := m.PollInterval
+	if interval == 0 {
+		interval = DefaultReplicationLagPollInterval
+	}
+
+	paused := false
+	for {
+		lag, err := m.Lag()
+		if err != nil {
+			log.WithField("err", err).Warn("ingest: failed to check replication lag")
+			return
+		}
+
+		if lag <= m.MaxLag {
+			if paused {
+				log.WithField("lag", lag).Info("ingest: replication caught up, resuming ingestion")
+			}
+			return
+		}
+
+		if !paused {
+			log.WithField("lag", lag).WithField("max_lag", m.MaxLag).Warn("ingest: replication lag too high, pausing ingestion")
+			paused = true
+		}
+
+		time.Sleep(interval)
+	}
+}
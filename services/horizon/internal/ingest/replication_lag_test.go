@@ -0,0 +1,41 @@
+package ingest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/test"
+)
+
+func TestReplicationLagMonitor(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	m := &ReplicationLagMonitor{
+		DB:     tt.HorizonSession(),
+		MaxLag: time.Minute,
+		Query:  "SELECT 1.5",
+	}
+
+	lag, err := m.Lag()
+	tt.Require.NoError(err)
+	tt.Assert.Equal(1500*time.Millisecond, lag)
+
+	// lag is reported as high until the third measurement
+	_, err = tt.HorizonSession().ExecRaw("CREATE SEQUENCE replication_lag_test")
+	tt.Require.NoError(err)
+	defer tt.HorizonSession().ExecRaw("DROP SEQUENCE replication_lag_test")
+
+	m.Query = "SELECT CASE WHEN nextval('replication_lag_test') < 3 THEN 120 ELSE 0 END"
+	m.PollInterval = time.Millisecond
+	m.Wait()
+
+	var calls int
+	err = tt.HorizonSession().GetRaw(&calls, "SELECT last_value FROM replication_lag_test")
+	tt.Require.NoError(err)
+	tt.Assert.Equal(3, calls)
+
+	// a failing query does not pause ingestion
+	m.Query = "SELECT * FROM missing_table"
+	m.Wait()
+}
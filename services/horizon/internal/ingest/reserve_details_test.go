@@ -0,0 +1,114 @@
+package ingest
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stellar/go/amount"
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
+)
+
+func TestIngest_ReserveDetails(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	sys := sys(tt)
+
+	// every ledger is given its own base reserve, so that the reserve of each
+	// effect identifies the ledger it was computed from
+	load := func() []LedgerBundle {
+		var bundles []LedgerBundle
+		for seq := int32(1); seq <= ledger.CurrentState().CoreLatest; seq++ {
+			bundle := LedgerBundle{Sequence: seq}
+			tt.Require.NoError(bundle.Load(tt.CoreSession()))
+			bundle.Header.Data.BaseReserve = xdr.Uint32(1000000 * seq)
+			bundles = append(bundles, bundle)
+		}
+		return bundles
+	}
+
+	type reserveRow struct {
+		Type          int    `db:"type"`
+		ReserveChange string `db:"reserve_change"`
+		BaseReserve   int64  `db:"base_reserve"`
+	}
+	reserveRows := func() (rows []reserveRow) {
+		tt.Require.NoError(tt.HorizonSession().SelectRaw(&rows, `
+			SELECT e.type, e.details->>'reserve_change' AS reserve_change, hl.base_reserve
+			FROM history_effects e
+			JOIN history_ledgers hl ON hl.sequence = e.history_operation_id >> 32
+			WHERE e.details->>'reserve_change' IS NOT NULL
+		`))
+		return
+	}
+
+	// absent by default
+	s := NewSession(sys)
+	tt.Require.NoError(s.IngestBundles(load()))
+	tt.Assert.Empty(reserveRows())
+
+	sys.ReserveDetails = true
+	s = NewSession(sys)
+	s.ClearExisting = true
+	tt.Require.NoError(s.IngestBundles(load()))
+
+	rows := reserveRows()
+	tt.Require.NotEmpty(rows)
+
+	reserves := map[int64]bool{}
+	for _, row := range rows {
+		reserves[row.BaseReserve] = true
+		tt.Assert.Equal(
+			amount.StringFromInt64(row.BaseReserve),
+			strings.TrimPrefix(row.ReserveChange, "-"),
+			"effect of type %d", row.Type,
+		)
+	}
+	tt.Assert.True(len(reserves) > 1, "reserves of a single ledger: %v", reserves)
+}
+
+func TestSession_SignerReserveDetails(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	master := "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+	signer := "GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2"
+
+	var source xdr.AccountId
+	tt.Require.NoError(source.SetAddress(master))
+	var key xdr.SignerKey
+	tt.Require.NoError(key.SetAddress(signer))
+
+	ingestion := &Ingestion{DB: tt.HorizonSession()}
+	tt.Require.NoError(ingestion.Start())
+	is := &Session{Ingestion: ingestion, ReserveDetails: true}
+
+	// the master key is removed and another signer added
+	effects := ingestion.Effects(10)
+	effects.BaseReserve = 5000000
+	is.signerEffects(effects, source,
+		xdr.AccountEntry{AccountId: source, Thresholds: xdr.Thresholds{1, 0, 0, 0}},
+		xdr.AccountEntry{AccountId: source, Signers: []xdr.Signer{{Key: key, Weight: 1}}},
+	)
+	tt.Require.NoError(effects.Finish())
+	tt.Require.NoError(ingestion.Close())
+
+	var stored []string
+	tt.Require.NoError(tt.HorizonSession().SelectRaw(&stored, `
+		SELECT details FROM history_effects WHERE history_operation_id = ? ORDER BY "order"
+	`, 10))
+	tt.Require.Len(stored, 2)
+
+	details := map[string]map[string]interface{}{}
+	for _, raw := range stored {
+		var d map[string]interface{}
+		tt.Require.NoError(json.Unmarshal([]byte(raw), &d))
+		details[d["public_key"].(string)] = d
+	}
+
+	// the master key is not a subentry of the account
+	tt.Assert.NotContains(details[master], "reserve_change")
+	tt.Assert.Equal("0.5000000", details[signer]["reserve_change"])
+}
@@ -0,0 +1,113 @@
+package ingest
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/support/errors"
+)
+
+// Row is a row about to be inserted into a history table, as handed to the
+// ingestion's RowTransformers.  Values holds the value of each of Columns, in
+// order.
+type Row struct {
+	Table   string
+	Columns []string
+	Values  []interface{}
+}
+
+// RowTransformer inspects, and may modify, a row about to be inserted.  The
+// row returned is inserted in its place, unless keep is false, in which case
+// the row is dropped.
+type RowTransformer func(row Row) (result Row, keep bool)
+
+// Get returns the value of `column`, or nil if the row has no such column.
+func (r Row) Get(column string) interface{} {
+	for i, c := range r.Columns {
+		if c == column {
+			return r.Values[i]
+		}
+	}
+	return nil
+}
+
+// Set sets the value of `column` to `value`, adding the column to the row if
+// it has no such column.
+func (r *Row) Set(column string, value interface{}) {
+	for i, c := range r.Columns {
+		if c == column {
+			r.Values[i] = value
+			return
+		}
+	}
+
+	r.Columns = append(r.Columns, column)
+	r.Values = append(r.Values, value)
+}
+
+// appendRow adds a row of `values` to `b`, the insert into `table`, once
+// passed through the ingestion's RowTransformers.  added is false when the
+// row wasn't added to `b`: either it was dropped, or a transformer changed
+// its columns, in which case it is inserted on its own.
+func (ingest *Ingestion) appendRow(
+	b sq.InsertBuilder,
+	table string,
+	values ...interface{},
+) (_ sq.InsertBuilder, added bool, err error) {
+	if len(ingest.RowTransformers) == 0 {
+		return b.Values(values...), true, nil
+	}
+
+	columns := ingest.insertColumns[table]
+	row := Row{
+		Table:   table,
+		Columns: append([]string(nil), columns...),
+		Values:  values,
+	}
+
+	for _, transform := range ingest.RowTransformers {
+		var keep bool
+		row, keep = transform(row)
+		if !keep {
+			return b, false, nil
+		}
+	}
+
+	if len(row.Values) != len(row.Columns) {
+		return b, false, errors.Errorf(
+			"transformed row of %s has %d values for %d columns",
+			table, len(row.Values), len(row.Columns),
+		)
+	}
+
+	if !sameColumns(row.Columns, columns) {
+		return b, false, ingest.exec(ingest.insert(table).Columns(row.Columns...).Values(row.Values...))
+	}
+
+	return b.Values(row.Values...), true, nil
+}
+
+// insertRow inserts a row of `values` into `table`, using `b`, unless the row
+// is dropped by the ingestion's RowTransformers.  See appendRow.
+func (ingest *Ingestion) insertRow(b sq.InsertBuilder, table string, values ...interface{}) error {
+	b, added, err := ingest.appendRow(b, table, values...)
+	if !added || err != nil {
+		return err
+	}
+
+	return ingest.exec(b)
+}
+
+// sameColumns returns true if `a` and `b` hold the same columns, in the same
+// order.
+func sameColumns(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
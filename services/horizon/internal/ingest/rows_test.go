@@ -0,0 +1,102 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRow(t *testing.T) {
+	row := Row{
+		Table:   "history_effects",
+		Columns: []string{"type", "details"},
+		Values:  []interface{}{history.EffectTrade, "{}"},
+	}
+
+	assert.Equal(t, history.EffectTrade, row.Get("type"))
+	assert.Nil(t, row.Get("missing"))
+
+	row.Set("details", nil)
+	assert.Equal(t, []string{"type", "details"}, row.Columns)
+	assert.Nil(t, row.Get("details"))
+
+	row.Set("computed", 1)
+	assert.Equal(t, []string{"type", "details", "computed"}, row.Columns)
+	assert.Equal(t, []interface{}{history.EffectTrade, nil, 1}, row.Values)
+}
+
+func TestAppendRow(t *testing.T) {
+	ingestion := Ingestion{IngestionOptions: IngestionOptions{
+		RowTransformers: []RowTransformer{
+			func(row Row) (Row, bool) {
+				return row, row.Get("history_operation_id") != int64(2)
+			},
+		},
+	}}
+	ingestion.createInsertBuilders()
+
+	sql, added, err := ingestion.appendRow(ingestion.operation_participants, "history_operation_participants", int64(1), int64(10), nil)
+	assert.NoError(t, err)
+	assert.True(t, added)
+
+	// dropped
+	sql, added, err = ingestion.appendRow(sql, "history_operation_participants", int64(2), int64(10), nil)
+	assert.NoError(t, err)
+	assert.False(t, added)
+
+	_, args, err := sql.ToSql()
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{int64(1), int64(10), nil}, args)
+	}
+
+	// rows must keep a value per column
+	ingestion.RowTransformers = []RowTransformer{
+		func(row Row) (Row, bool) {
+			row.Values = row.Values[1:]
+			return row, true
+		},
+	}
+	_, added, err = ingestion.appendRow(sql, "history_operation_participants", int64(3), int64(10), nil)
+	assert.Error(t, err)
+	assert.False(t, added)
+}
+
+func TestIngest_RowTransformers(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	sys := sys(tt)
+	sys.RowTransformers = []RowTransformer{
+		// drop trade effects
+		func(row Row) (Row, bool) {
+			return row, row.Table != "history_effects" || row.Get("type") != history.EffectTrade
+		},
+		// redact memos
+		func(row Row) (Row, bool) {
+			if row.Table == "history_transactions" {
+				row.Set("memo", nil)
+			}
+			return row, true
+		},
+	}
+
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	count := func(query string, args ...interface{}) (n int) {
+		tt.Require.NoError(tt.HorizonSession().GetRaw(&n, query, args...))
+		return
+	}
+
+	tt.Assert.Zero(count(`SELECT COUNT(*) FROM history_effects WHERE type = ?`, history.EffectTrade))
+	tt.Assert.NotZero(count(`SELECT COUNT(*) FROM history_effects`))
+	tt.Assert.NotZero(count(`SELECT COUNT(*) FROM history_trades`))
+
+	tt.Assert.Zero(count(`SELECT COUNT(*) FROM history_transactions WHERE memo IS NOT NULL`))
+	tt.Assert.NotZero(count(`SELECT COUNT(*) FROM history_transactions WHERE memo_type <> 'none'`))
+}
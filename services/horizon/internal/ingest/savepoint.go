@@ -0,0 +1,41 @@
+package ingest
+
+// begin opens the ingestion's transaction on DB or, when WithinTransaction is
+// set, the savepoint standing in for it.
+func (ingest *Ingestion) begin() error {
+	if !ingest.WithinTransaction {
+		return ingest.DB.Begin()
+	}
+
+	_, err := ingest.DB.ExecRaw(`SAVEPOINT ingestion`)
+	return err
+}
+
+// commitDB commits the ingestion's transaction on DB or, when
+// WithinTransaction is set, releases its savepoint, leaving the rows ingested
+// to the outer transaction.
+func (ingest *Ingestion) commitDB() error {
+	if !ingest.WithinTransaction {
+		return ingest.DB.Commit()
+	}
+
+	_, err := ingest.DB.ExecRaw(`RELEASE SAVEPOINT ingestion`)
+	return err
+}
+
+// rollbackDB rolls back the ingestion's transaction on DB or, when
+// WithinTransaction is set, rolls back to its savepoint, which is released.
+// The outer transaction is left open either way.
+func (ingest *Ingestion) rollbackDB() error {
+	if !ingest.WithinTransaction {
+		return ingest.DB.Rollback()
+	}
+
+	_, err := ingest.DB.ExecRaw(`ROLLBACK TO SAVEPOINT ingestion`)
+	if err != nil {
+		return err
+	}
+
+	_, err = ingest.DB.ExecRaw(`RELEASE SAVEPOINT ingestion`)
+	return err
+}
@@ -0,0 +1,66 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/xdr"
+)
+
+func TestWithinTransaction(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	s, rollback := rolledBackIngest(tt)
+	tt.Require.NoError(s.Err)
+	tt.Assert.NotZero(s.Ingested)
+
+	count := func(sess *db.Session, table string) (n int) {
+		tt.Require.NoError(sess.GetRaw(&n, "SELECT COUNT(*) FROM "+table))
+		return
+	}
+
+	// the rows are visible to the outer transaction alone
+	tt.Assert.Equal(s.Ingested, count(s.Ingestion.DB, "history_ledgers"))
+	tt.Assert.NotZero(count(s.Ingestion.DB, "history_operations"))
+	tt.Assert.Zero(count(tt.HorizonSession(), "history_ledgers"))
+
+	rollback()
+	tt.Assert.Zero(count(tt.HorizonSession(), "history_ledgers"))
+	tt.Assert.Zero(count(tt.HorizonSession(), "history_operations"))
+	tt.Assert.Zero(count(tt.HorizonSession(), "history_accounts"))
+}
+
+func TestWithinTransaction_Rollback(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	sess := tt.HorizonSession()
+	tt.Require.NoError(sess.Begin())
+	defer sess.Rollback()
+
+	ingestion := &Ingestion{DB: sess, WithinTransaction: true}
+
+	var aid xdr.AccountId
+	tt.Require.NoError(aid.SetAddress("GCFZWN3AOVFQM2BZTZX7P47WSI4QMGJC62LILPKODTNDLVKZZNA5BQJ3"))
+
+	// an ingestion rolled back leaves the outer transaction usable
+	tt.Require.NoError(ingestion.Start())
+	_, err := ingestion.getCreateAccountID(aid)
+	tt.Require.NoError(err)
+	tt.Require.NoError(ingestion.Rollback())
+
+	var n int
+	tt.Require.NoError(sess.GetRaw(&n, `SELECT COUNT(*) FROM history_accounts WHERE address = ?`, aid.Address()))
+	tt.Assert.Zero(n)
+
+	// while one committed is kept by it
+	tt.Require.NoError(ingestion.Start())
+	_, err = ingestion.getCreateAccountID(aid)
+	tt.Require.NoError(err)
+	tt.Require.NoError(ingestion.Close())
+
+	tt.Require.NoError(sess.GetRaw(&n, `SELECT COUNT(*) FROM history_accounts WHERE address = ?`, aid.Address()))
+	tt.Assert.Equal(1, n)
+}
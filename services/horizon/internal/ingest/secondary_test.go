@@ -0,0 +1,149 @@
+package ingest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/db2/schema"
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/services/horizon/internal/toid"
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/db/dbtest"
+)
+
+// secondaryDB returns a session to a new, empty horizon database along with a
+// function that closes and drops it.
+func secondaryDB(tt *test.T) (*db.Session, func()) {
+	tdb := dbtest.Postgres(tt.T)
+	session := &db.Session{DB: tdb.Open()}
+	tt.Require.NoError(schema.Init(session))
+
+	return session, func() {
+		session.DB.Close()
+		tdb.Close()
+	}
+}
+
+// failLedger makes the insertion of ledger `seq` fail in `session`.
+func failLedger(tt *test.T, session *db.Session, seq int32) {
+	_, err := session.ExecRaw(fmt.Sprintf(`
+		ALTER TABLE history_ledgers ADD CONSTRAINT fail_ledger CHECK (sequence <> %d)
+	`, seq))
+	tt.Require.NoError(err)
+}
+
+func countLedgers(tt *test.T, session *db.Session) int {
+	var n int
+	tt.Require.NoError(session.GetRaw(&n, `SELECT COUNT(*) FROM history_ledgers`))
+	return n
+}
+
+func TestIngest_Secondary(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	secondary, closeSecondary := secondaryDB(tt)
+	defer closeSecondary()
+
+	sys := sys(tt)
+	sys.SecondaryHorizonDB = secondary
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	tt.Assert.Equal(historyDigest(tt), sessionDigest(tt, secondary))
+
+	// accounts and assets resolve to the same ids in both databases, so rows
+	// referencing them by id are identical too
+	for _, q := range []string{
+		`SELECT id, address FROM history_accounts ORDER BY id`,
+		`SELECT id, asset_type, asset_code, asset_issuer FROM history_assets ORDER BY id`,
+		`SELECT history_operation_id, "order", base_account_id, base_asset_id,
+			counter_account_id, counter_asset_id
+		FROM history_trades ORDER BY history_operation_id, "order"`,
+	} {
+		var want, got []string
+		tt.Require.NoError(tt.HorizonSession().SelectRaw(&want, `SELECT t::text FROM (`+q+`) t`))
+		tt.Require.NoError(secondary.SelectRaw(&got, `SELECT t::text FROM (`+q+`) t`))
+		tt.Require.NotEmpty(want, q)
+		tt.Assert.Equal(want, got, q)
+	}
+
+	// clearing a range removes it from both databases
+	ingestion := &Ingestion{
+		DB:          tt.HorizonSession(),
+		SecondaryDB: secondary.Clone(),
+	}
+	tt.Require.NoError(ingestion.Start())
+	tt.Require.NoError(ingestion.Clear(toid.New(3, 0, 0).ToInt64(), toid.New(5, 0, 0).ToInt64()))
+	tt.Require.NoError(ingestion.Close())
+
+	for _, session := range []*db.Session{tt.HorizonSession(), secondary} {
+		var n int
+		tt.Require.NoError(session.GetRaw(&n, `
+			SELECT COUNT(*) FROM history_ledgers WHERE sequence IN (3, 4)
+		`))
+		tt.Assert.Equal(0, n)
+		tt.Require.NoError(session.GetRaw(&n, `
+			SELECT COUNT(*) FROM history_operations WHERE id >= ? AND id < ?
+		`, toid.New(3, 0, 0).ToInt64(), toid.New(5, 0, 0).ToInt64()))
+		tt.Assert.Equal(0, n)
+	}
+	tt.Assert.Equal(historyDigest(tt), sessionDigest(tt, secondary))
+}
+
+func TestIngest_SecondaryStrict(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	secondary, closeSecondary := secondaryDB(tt)
+	defer closeSecondary()
+	failLedger(tt, secondary, 3)
+
+	sys := sys(tt)
+	sys.SecondaryHorizonDB = secondary
+	sys.SecondaryStrict = true
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.Run()
+
+	tt.Require.Error(s.Err)
+	tt.Assert.Contains(s.Err.Error(), "secondary db write failed")
+	tt.Assert.Equal(0, countLedgers(tt, tt.HorizonSession()))
+	tt.Assert.Equal(0, countLedgers(tt, secondary))
+}
+
+func TestIngest_SecondaryBestEffort(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	secondary, closeSecondary := secondaryDB(tt)
+	defer closeSecondary()
+	failLedger(tt, secondary, 3)
+	latest := ledger.CurrentState().CoreLatest
+
+	sys := sys(tt)
+	sys.SecondaryHorizonDB = secondary
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, latest, sys)
+	s.Run()
+
+	// the primary commits, while the secondary's transaction is abandoned at
+	// the failure and nothing after it is written
+	tt.Require.NoError(s.Err)
+	ingested := countLedgers(tt, tt.HorizonSession())
+	tt.Assert.True(ingested > 3)
+	tt.Assert.Equal(0, countLedgers(tt, secondary))
+
+	// the secondary is written to again from the next Start
+	_, err := secondary.ExecRaw(`ALTER TABLE history_ledgers DROP CONSTRAINT fail_ledger`)
+	tt.Require.NoError(err)
+
+	s = NewSession(sys)
+	s.ClearExisting = true
+	s.Cursor = NewCursor(1, latest, sys)
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	tt.Assert.Equal(ingested, countLedgers(tt, secondary))
+	tt.Assert.Equal(historyDigest(tt), sessionDigest(tt, secondary))
+}
@@ -8,17 +8,184 @@ import (
 
 	"github.com/stellar/go/clients/stellarcore"
 
-	"github.com/stellar/go/amount"
 	"github.com/stellar/go/keypair"
 	"github.com/stellar/go/meta"
 	"github.com/stellar/go/services/horizon/internal/db2/core"
 	"github.com/stellar/go/services/horizon/internal/db2/history"
 	"github.com/stellar/go/services/horizon/internal/ingest/participants"
+	"github.com/stellar/go/services/horizon/internal/log"
 	"github.com/stellar/go/support/errors"
 	sTime "github.com/stellar/go/support/time"
 	"github.com/stellar/go/xdr"
 )
 
+// IngestPartialLedger ingests only the transactions of ledger `seq` whose
+// hashes are in `txHashes`, along with their operations, effects and
+// participants, using the stellar-core db of the session's cursor.  It allows a
+// transaction to be served as soon as stellar-core has applied it, without
+// waiting for the rest of its ledger to be ingested.
+//
+// The ledger is recorded with `partial` set, and is not considered by
+// history.Q.LatestLedger, so regular ingestion revisits it, replacing the
+// partial data with the full ledger.  Ingesting a partial ledger again, whether
+// partially or in full, likewise replaces it, so callers previewing a ledger
+// in several steps must pass every hash seen so far.  A ledger that has
+// already been ingested in full is left untouched, and stellar-core's cursor is
+// never advanced by a partial ingestion.
+func (is *Session) IngestPartialLedger(seq int32, txHashes []string) error {
+	if is.Cursor == nil {
+		return errors.New("no cursor set on session")
+	}
+
+	var exists bool
+	err := is.Ingestion.DB.GetRaw(&exists,
+		`SELECT EXISTS(SELECT 1 FROM history_ledgers WHERE sequence = ? AND NOT partial)`,
+		seq,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to load ledger")
+	}
+	if exists {
+		return nil
+	}
+
+	is.partial = map[string]bool{}
+	for _, hash := range txHashes {
+		is.partial[hash] = true
+	}
+
+	clearExisting, skipCursorUpdate := is.ClearExisting, is.SkipCursorUpdate
+	is.ClearExisting, is.SkipCursorUpdate = false, true
+	defer func() {
+		is.partial = nil
+		is.ClearExisting, is.SkipCursorUpdate = clearExisting, skipCursorUpdate
+	}()
+
+	is.Cursor = &Cursor{
+		FirstLedger:      seq,
+		LastLedger:       seq,
+		DB:               is.Cursor.DB,
+		IDScheme:         is.Cursor.IDScheme,
+		XDRErrorPolicy:   is.Cursor.XDRErrorPolicy,
+		LoadRetries:      is.Cursor.LoadRetries,
+		LoadRetryBackoff: is.Cursor.LoadRetryBackoff,
+		Metrics:          is.Metrics,
+		AssetsModified:   AssetsModified(make(map[string]xdr.Asset)),
+	}
+	is.Run()
+	return is.Err
+}
+
+// IngestBundles runs the ingestion pipeline over the provided, already loaded,
+// bundles in order, rather than loading ledgers from stellar-core through the
+// session's cursor.  Existing data is cleared first if ClearExisting is set.
+//
+// Asset stats are computed from the current state of stellar-core and are
+// therefore only updated when the session already has a cursor connected to
+// the stellar-core db.
+func (is *Session) IngestBundles(bundles []LedgerBundle) error {
+	if len(bundles) == 0 {
+		return nil
+	}
+
+	c := &Cursor{
+		FirstLedger:    bundles[0].Sequence,
+		LastLedger:     bundles[len(bundles)-1].Sequence,
+		Metrics:        is.Metrics,
+		AssetsModified: AssetsModified(make(map[string]xdr.Asset)),
+		bundles:        bundles,
+	}
+
+	if is.Cursor != nil {
+		c.DB = is.Cursor.DB
+		c.IDScheme = is.Cursor.IDScheme
+		c.XDRErrorPolicy = is.Cursor.XDRErrorPolicy
+		c.PrefetchDepth = is.Cursor.PrefetchDepth
+		c.LoadRetries = is.Cursor.LoadRetries
+		c.LoadRetryBackoff = is.Cursor.LoadRetryBackoff
+	}
+
+	is.Cursor = c
+	is.Run()
+	return is.Err
+}
+
+// ReingestTransaction replaces the rows derived from the transaction
+// identified by `hash` (the transaction itself along with its operations,
+// effects, trades and participants) with freshly ingested ones, leaving the
+// rest of the transaction's ledger untouched.  The existing rows are cleared
+// and the new rows written within a single database transaction.
+//
+// The session's cursor must be connected to the stellar-core db that the
+// transaction is loaded from.
+func (is *Session) ReingestTransaction(hash string) error {
+	if is.Cursor == nil || is.Cursor.DB == nil {
+		return errors.New("no stellar-core cursor set on session")
+	}
+
+	var tx core.Transaction
+	coreQ := &core.Q{Session: is.Cursor.DB}
+	err := coreQ.TransactionByHashAfterLedger(&tx, hash, 0)
+	if err != nil {
+		return errors.Wrap(err, "failed to load transaction")
+	}
+
+	c := &Cursor{
+		FirstLedger:      tx.LedgerSequence,
+		LastLedger:       tx.LedgerSequence,
+		DB:               is.Cursor.DB,
+		LoadRetries:      is.Cursor.LoadRetries,
+		LoadRetryBackoff: is.Cursor.LoadRetryBackoff,
+		IDScheme:         is.Cursor.IDScheme,
+		Metrics:          is.Metrics,
+		AssetsModified:   AssetsModified(make(map[string]xdr.Asset)),
+	}
+
+	if !c.NextLedger() {
+		if c.Err != nil {
+			return errors.Wrap(c.Err, "failed to load ledger")
+		}
+		return errors.Errorf("ledger %d not found", tx.LedgerSequence)
+	}
+
+	found := false
+	for c.NextTx() {
+		if c.Transaction().TransactionHash == tx.TransactionHash {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return errors.Errorf("transaction %s not found in ledger %d", hash, tx.LedgerSequence)
+	}
+
+	is.Cursor = c
+	is.Err = is.Ingestion.Start()
+	if is.Err != nil {
+		return is.Err
+	}
+
+	defer is.Ingestion.Rollback()
+
+	// the id range of the transaction covers all of its derived rows, but not
+	// its ledger or any other transaction within it.
+	start := c.TransactionID()
+	end := c.ids().ID(c.LedgerSequence(), int32(c.tx+2), 0)
+	is.Err = is.Ingestion.Clear(start, end)
+	if is.Err != nil {
+		return is.Err
+	}
+
+	is.ingestTransaction()
+	if is.Err != nil {
+		return is.Err
+	}
+
+	is.Err = is.Ingestion.Close()
+	return is.Err
+}
+
 // Run starts an attempt to ingest the range of ledgers specified in this
 // session.
 func (is *Session) Run() {
@@ -26,21 +193,33 @@ func (is *Session) Run() {
 		is.Err = errors.New("no cursor set on session")
 		return
 	}
+	defer is.Cursor.Close()
+	is.ledgerErrors = nil
+
+	is.resumeFromCheckpoint()
+	if is.Err != nil {
+		return
+	}
 
 	is.Err = is.Ingestion.Start()
 	if is.Err != nil {
+		is.spoolCursor()
 		return
 	}
 
 	defer is.Ingestion.Rollback()
 
 	for is.Cursor.NextLedger() {
+		is.Ingestion.debug.setLedger(is.Cursor.LedgerSequence())
+		is.spoolLedger()
+		is.awaitReplication()
 		is.validateLedger()
 		is.clearLedger()
 		is.ingestLedger()
+		is.checkpoint()
 		is.flush()
 
-		if is.Err != nil {
+		if is.Err != nil && !is.skipFailedLedger() {
 			break
 		}
 	}
@@ -61,17 +240,45 @@ func (is *Session) Run() {
 		return
 	}
 
+	is.trimSpool()
+	is.fetchTomls()
+
+	// stellar-core must keep the ledgers that failed, so that they can be
+	// ingested again
+	if len(is.ledgerErrors) > 0 {
+		is.Err = is.ledgerErrors
+		return
+	}
+
 	is.Err = is.reportCursorState()
 }
 
+// awaitReplication pauses the session while the horizon database's replicas
+// lag too far behind.
+func (is *Session) awaitReplication() {
+	if is.Err != nil || is.ReplicationLag == nil {
+		return
+	}
+
+	is.Ingestion.debug.setPaused(true)
+	is.ReplicationLag.Wait()
+	is.Ingestion.debug.setPaused(false)
+}
+
 func (is *Session) clearLedger() {
 	if is.Err != nil {
 		return
 	}
 
+	// partially ingested ledgers are always replaced
 	if !is.ClearExisting {
-		return
+		var partial bool
+		partial, is.Err = is.Ingestion.IsPartialLedger(is.Cursor.LedgerSequence())
+		if is.Err != nil || !partial {
+			return
+		}
 	}
+
 	start := time.Now()
 	is.Err = is.Ingestion.Clear(is.Cursor.LedgerRange())
 	if is.Metrics != nil {
@@ -95,6 +302,20 @@ func (is *Session) effectFlagDetails(flagDetails map[string]bool, flagPtr *xdr.U
 	}
 }
 
+// fetchTomls hands the stellar.toml urls of the assets whose stats were
+// updated to the session's toml fetcher.  It must only be called once the
+// session has been committed, so that the fetches never hold up ingestion.
+func (is *Session) fetchTomls() {
+	if is.TomlFetcher == nil {
+		return
+	}
+
+	for id, url := range is.tomlRequests {
+		is.TomlFetcher.Queue(id, url)
+	}
+	is.TomlFetcher.Start()
+}
+
 func (is *Session) flush() {
 	if is.Err != nil {
 		return
@@ -107,44 +328,56 @@ func (is *Session) ingestEffects() {
 		return
 	}
 
-	effects := &EffectIngestion{
-		Dest:        is.Ingestion,
-		OperationID: is.Cursor.OperationID(),
-		parent:      is.Ingestion,
-	}
+	effects := is.Ingestion.Effects(is.Cursor.OperationID())
+	effects.BaseReserve = is.Cursor.Ledger().Data.BaseReserve
 	source := is.Cursor.OperationSourceAccount()
 	opbody := is.Cursor.Operation().Body
 
+	if is.EffectsFromMeta {
+		is.ingestEffectsFromMeta(effects)
+		if is.Err == nil {
+			is.Err = effects.Finish()
+		}
+		return
+	}
+
 	switch is.Cursor.OperationType() {
 	case xdr.OperationTypeCreateAccount:
 		op := opbody.MustCreateAccountOp()
 
 		effects.Add(op.Destination, history.EffectAccountCreated,
 			map[string]interface{}{
-				"starting_balance": amount.String(op.StartingBalance),
+				"starting_balance": is.formatAmount(op.StartingBalance),
 			},
 		)
 
 		effects.Add(source, history.EffectAccountDebited,
 			map[string]interface{}{
 				"asset_type": "native",
-				"amount":     amount.String(op.StartingBalance),
+				"amount":     is.formatAmount(op.StartingBalance),
 			},
 		)
 
 		effects.Add(op.Destination, history.EffectSignerCreated,
 			map[string]interface{}{
-				"public_key": op.Destination.Address(),
+				"public_key": is.Ingestion.address(op.Destination),
 				"weight":     keypair.DefaultSignerWeight,
 			},
 		)
 
 	case xdr.OperationTypePayment:
 		op := opbody.MustPaymentOp()
-		dets := map[string]interface{}{"amount": amount.String(op.Amount)}
+		dets := map[string]interface{}{"amount": is.formatAmount(op.Amount)}
 		is.assetDetails(dets, op.Asset, "")
-		effects.Add(op.Destination, history.EffectAccountCredited, dets)
-		effects.Add(source, history.EffectAccountDebited, dets)
+
+		// the credit and debit share their details, so marshal them only once
+		var raw []byte
+		raw, is.Err = marshalDetails(dets)
+		if is.Err != nil {
+			return
+		}
+		effects.AddRaw(op.Destination, history.EffectAccountCredited, raw)
+		effects.AddRaw(source, history.EffectAccountDebited, raw)
 	case xdr.OperationTypePathPayment:
 		result := is.Cursor.OperationResult().MustPathPaymentResult().MustSuccess()
 		is.ingestTradeEffects(effects, source, result.Offers)
@@ -168,11 +401,7 @@ func (is *Session) ingestEffects() {
 		op := opbody.MustSetOptionsOp()
 
 		if op.HomeDomain != nil {
-			effects.Add(source, history.EffectAccountHomeDomainUpdated,
-				map[string]interface{}{
-					"home_domain": string(*op.HomeDomain),
-				},
-			)
+			is.ingestHomeDomainEffect(effects, *op.HomeDomain)
 		}
 
 		thresholdDetails := map[string]interface{}{}
@@ -205,7 +434,7 @@ func (is *Session) ingestEffects() {
 
 	case xdr.OperationTypeChangeTrust:
 		op := opbody.MustChangeTrustOp()
-		dets := map[string]interface{}{"limit": amount.String(op.Limit)}
+		dets := map[string]interface{}{"limit": is.formatAmount(op.Limit)}
 		key := xdr.LedgerKey{}
 		effect := history.EffectType(0)
 
@@ -230,8 +459,10 @@ func (is *Session) ingestEffects() {
 		switch {
 		case before == nil && after != nil:
 			effect = history.EffectTrustlineCreated
+			is.reserveDetails(effects, dets, 1)
 		case before != nil && after == nil:
 			effect = history.EffectTrustlineRemoved
+			is.reserveDetails(effects, dets, -1)
 		case before != nil && after != nil:
 			effect = history.EffectTrustlineUpdated
 		default:
@@ -243,7 +474,7 @@ func (is *Session) ingestEffects() {
 		op := opbody.MustAllowTrustOp()
 		asset := op.Asset.ToAsset(source)
 		dets := map[string]interface{}{
-			"trustor": op.Trustor.Address(),
+			"trustor": is.Ingestion.address(op.Trustor),
 		}
 		is.assetDetails(dets, asset, "")
 
@@ -257,7 +488,7 @@ func (is *Session) ingestEffects() {
 		dest := opbody.MustDestination()
 		result := is.Cursor.OperationResult().MustAccountMergeResult()
 		dets := map[string]interface{}{
-			"amount":     amount.String(result.MustSourceAccountBalance()),
+			"amount":     is.formatAmount(result.MustSourceAccountBalance()),
 			"asset_type": "native",
 		}
 		effects.Add(source, history.EffectAccountDebited, dets)
@@ -268,7 +499,7 @@ func (is *Session) ingestEffects() {
 		for _, payout := range payouts {
 			effects.Add(payout.Destination, history.EffectAccountCredited,
 				map[string]interface{}{
-					"amount":     amount.String(payout.Amount),
+					"amount":     is.formatAmount(payout.Amount),
 					"asset_type": "native",
 				},
 			)
@@ -295,8 +526,10 @@ func (is *Session) ingestEffects() {
 		switch {
 		case before == nil && after != nil:
 			effect = history.EffectDataCreated
+			is.reserveDetails(effects, dets, 1)
 		case before != nil && after == nil:
 			effect = history.EffectDataRemoved
+			is.reserveDetails(effects, dets, -1)
 		case before != nil && after != nil:
 			effect = history.EffectDataUpdated
 		default:
@@ -313,14 +546,58 @@ func (is *Session) ingestEffects() {
 	is.Err = effects.Finish()
 }
 
+// ingestGenesis records the creation of the network's root account when the
+// current ledger is the genesis ledger.  The root account is created by the
+// genesis ledger itself rather than by an operation, so its effects are
+// recorded against the ledger's id.
+func (is *Session) ingestGenesis() {
+	if is.Err != nil || !is.IngestGenesis || is.Cursor.LedgerSequence() != 1 {
+		return
+	}
+
+	var root xdr.AccountId
+	is.Err = root.SetAddress(keypair.Master(is.Network).Address())
+	if is.Err != nil {
+		return
+	}
+
+	effects := is.Ingestion.Effects(is.Cursor.LedgerID())
+	effects.BaseReserve = is.Cursor.Ledger().Data.BaseReserve
+
+	effects.Add(root, history.EffectAccountCreated,
+		map[string]interface{}{
+			"starting_balance": is.formatAmount(is.Cursor.Ledger().Data.TotalCoins),
+		},
+	)
+
+	effects.Add(root, history.EffectSignerCreated,
+		map[string]interface{}{
+			"public_key": is.Ingestion.address(root),
+			"weight":     keypair.DefaultSignerWeight,
+		},
+	)
+
+	is.Err = effects.Finish()
+}
+
 // ingestLedger ingests the current ledger
 func (is *Session) ingestLedger() {
 	if is.Err != nil {
 		return
 	}
 
+	is.verifyTxSetSize()
+	if is.Err != nil {
+		return
+	}
+
 	start := time.Now()
-	is.Err = is.Ingestion.Ledger(
+	record := is.Ingestion.Ledger
+	if is.partial != nil {
+		record = is.Ingestion.PartialLedger
+	}
+
+	is.Err = record(
 		is.Cursor.LedgerID(),
 		is.Cursor.Ledger(),
 		is.Cursor.SuccessfulTransactionCount(),
@@ -331,10 +608,37 @@ func (is *Session) ingestLedger() {
 		return
 	}
 
+	if is.Ingestion.CountTrustlineChanges {
+		is.Err = is.Ingestion.LedgerTrustlinesChanged(
+			is.Cursor.LedgerSequence(),
+			is.Cursor.TrustlinesChanged(),
+		)
+		if is.Err != nil {
+			return
+		}
+	}
+
+	if is.Ingestion.IngestLedgerStats {
+		is.Err = is.Ingestion.LedgerStats(is.Cursor.LedgerStats())
+		if is.Err != nil {
+			return
+		}
+	}
+
+	if is.Ingestion.IngestUpgradeProposals {
+		is.Err = is.Ingestion.UpgradeProposals(is.Cursor.LedgerID(), is.Cursor.Ledger())
+		if is.Err != nil {
+			return
+		}
+	}
+
+	is.ingestGenesis()
+
 	for is.Cursor.NextTx() {
 		is.ingestTransaction()
 	}
 
+	is.Skipped = append(is.Skipped, is.Cursor.SkippedTransactions()...)
 	is.Ingested++
 	if is.Metrics != nil {
 		is.Metrics.IngestLedgerTimer.Update(time.Since(start))
@@ -348,6 +652,20 @@ func (is *Session) ingestOperation() {
 		return
 	}
 
+	// Find the participants
+	var p []participants.Participant
+	p, is.Err = participants.ForOperationWithRoles(
+		&is.Cursor.Transaction().Envelope.Tx,
+		is.Cursor.Operation(),
+	)
+	if is.Err != nil {
+		return
+	}
+
+	if !is.Ingestion.whitelisted(participants.Accounts(p)) {
+		return
+	}
+
 	is.Err = is.Ingestion.Operation(
 		is.Cursor.OperationID(),
 		is.Cursor.TransactionID(),
@@ -355,41 +673,173 @@ func (is *Session) ingestOperation() {
 		is.Cursor.OperationSourceAccount(),
 		is.Cursor.OperationType(),
 		is.operationDetails(),
+		is.Cursor.OperationSuccessful(),
+		is.Cursor.OperationResultCode(),
 	)
 	if is.Err != nil {
 		return
 	}
 
-	is.ingestOperationParticipants()
+	is.Err = is.Ingestion.OperationParticipants(is.Cursor.OperationID(), p)
+	if is.Err != nil {
+		return
+	}
+
+	// the operations of a failed transaction change nothing, so they have no
+	// effects, trades or ledger changes to record
+	if !is.Cursor.Transaction().IsSuccessful() {
+		return
+	}
+
+	is.ingestLedgerChanges()
+	is.ingestAccountFlags()
 	is.ingestEffects()
 	is.ingestTrades()
 	is.Err = is.Cursor.AssetsModified.IngestOperation(
 		is.Err,
 		is.Cursor.Operation(),
 		&is.Cursor.Transaction().Envelope.Tx.SourceAccount,
-		&core.Q{Session: is.Ingestion.DB},
+		&core.Q{Session: is.Cursor.DB},
 	)
 }
 
-func (is *Session) ingestOperationParticipants() {
-	if is.Err != nil {
+func (is *Session) ingestAccountFlags() {
+	if is.Err != nil || !is.Ingestion.IngestAccountFlags {
 		return
 	}
 
-	// Find the participants
-	var p []xdr.AccountId
-	p, is.Err = participants.ForOperation(
-		&is.Cursor.Transaction().Envelope.Tx,
-		is.Cursor.Operation(),
+	if is.Cursor.OperationType() != xdr.OperationTypeSetOptions {
+		return
+	}
+
+	op := is.Cursor.Operation().Body.MustSetOptionsOp()
+	if op.SetFlags == nil && op.ClearFlags == nil {
+		return
+	}
+
+	source := is.Cursor.OperationSourceAccount()
+	be, ae, err := is.Cursor.BeforeAndAfter(source.LedgerKey())
+	if err != nil {
+		is.Err = err
+		return
+	}
+
+	// see ingestSignerEffects
+	if be == nil || ae == nil {
+		return
+	}
+
+	before := be.Data.MustAccount().Flags
+	after := ae.Data.MustAccount().Flags
+	if before == after {
+		return
+	}
+
+	is.Err = is.Ingestion.AccountFlags(
+		is.Cursor.OperationID(),
+		is.Cursor.LedgerSequence(),
+		source,
+		before,
+		after,
 	)
-	if is.Err != nil {
+}
+
+// spoolLedger appends the current ledger to the spool, when one is configured.
+// Ledgers that are themselves being replayed from memory are not spooled.
+func (is *Session) spoolLedger() {
+	if is.Err != nil || is.Ingestion.SpoolDir == "" || is.Cursor.bundles != nil {
 		return
 	}
 
-	is.Err = is.Ingestion.OperationParticipants(is.Cursor.OperationID(), p)
-	if is.Err != nil {
+	is.Err = newSpool(is.Ingestion.SpoolDir).Append(is.Cursor.data)
+}
+
+// spoolCursor spools every ledger of the session's cursor without ingesting
+// them, used when the horizon db is unavailable.
+func (is *Session) spoolCursor() {
+	if is.Ingestion.SpoolDir == "" || is.Cursor.bundles != nil {
+		return
+	}
+
+	sp := newSpool(is.Ingestion.SpoolDir)
+	spooled := 0
+	for is.Cursor.NextLedger() {
+		err := sp.Append(is.Cursor.data)
+		if err != nil {
+			log.WithField("err", err).Warn("ingest: failed to spool ledger")
+			return
+		}
+		spooled++
+	}
+
+	if is.Cursor.Err != nil {
+		log.WithField("err", is.Cursor.Err).Warn("ingest: failed to load ledger for spooling")
+	}
+
+	log.WithField("ledgers", spooled).Info("ingest: horizon db unavailable, spooled ledgers")
+}
+
+// trimSpool removes the ledgers committed by the session from the spool.
+func (is *Session) trimSpool() {
+	if is.Err != nil || is.Ingestion.SpoolDir == "" {
+		return
+	}
+
+	first, last := is.Cursor.FirstLedger, is.Cursor.LastLedger
+	if is.Cursor.Descending() {
+		first, last = last, first
+	}
+
+	is.Err = newSpool(is.Ingestion.SpoolDir).Trim(first, last)
+}
+
+// verifyTxSetSize fails the session when the current ledger contains more
+// transactions than its header's max tx set size allows.
+func (is *Session) verifyTxSetSize() {
+	if is.Err != nil || !is.VerifyTxSetSize {
+		return
+	}
+
+	count := is.Cursor.TransactionCount()
+	max := is.Cursor.Ledger().Data.MaxTxSetSize
+	if uint64(count) > uint64(max) {
+		is.Err = errors.Errorf(
+			"ledger %d contains %d transactions, exceeding its max tx set size of %d",
+			is.Cursor.LedgerSequence(), count, max,
+		)
+	}
+}
+
+func (is *Session) ingestLedgerChanges() {
+	if is.Err != nil || !is.Ingestion.IngestLedgerChanges {
 		return
 	}
+
+	is.Err = is.Ingestion.LedgerChanges(
+		is.Cursor.OperationID(),
+		is.Cursor.OperationChanges(),
+	)
+}
+
+// ingestHomeDomainEffect adds the home_domain_updated effect of a set options
+// operation setting the source account's home domain to `domain`, including
+// the domain it replaced when the account's prior state is available.
+func (is *Session) ingestHomeDomainEffect(effects *EffectIngestion, domain xdr.String32) {
+	source := is.Cursor.OperationSourceAccount()
+	dets := map[string]interface{}{
+		"home_domain": string(domain),
+	}
+
+	be, _, err := is.Cursor.BeforeAndAfter(source.LedgerKey())
+	if err != nil {
+		is.Err = err
+		return
+	}
+	if be != nil {
+		dets["old_home_domain"] = string(be.Data.MustAccount().HomeDomain)
+	}
+
+	effects.Add(source, history.EffectAccountHomeDomainUpdated, dets)
 }
 
 func (is *Session) ingestSignerEffects(effects *EffectIngestion, op xdr.SetOptionsOp) {
@@ -407,22 +857,35 @@ func (is *Session) ingestSignerEffects(effects *EffectIngestion, op xdr.SetOptio
 		return
 	}
 
-	beforeAccount := be.Data.MustAccount()
-	afterAccount := ae.Data.MustAccount()
+	is.signerEffects(effects, source, be.Data.MustAccount(), ae.Data.MustAccount())
+}
 
+// signerEffects adds the effects for the differences between the signers of
+// the `source` account before and after the current operation.
+func (is *Session) signerEffects(
+	effects *EffectIngestion,
+	source xdr.AccountId,
+	beforeAccount xdr.AccountEntry,
+	afterAccount xdr.AccountEntry,
+) {
 	before := beforeAccount.SignerSummary()
 	after := afterAccount.SignerSummary()
 
 	for addy := range before {
 		weight, ok := after[addy]
 		if !ok {
-			effects.Add(source, history.EffectSignerRemoved, map[string]interface{}{
-				"public_key": addy,
-			})
+			dets := map[string]interface{}{
+				"public_key": is.Ingestion.rewriteAddress(addy),
+			}
+			// the master key is not a subentry of the account
+			if addy != source.Address() {
+				is.reserveDetails(effects, dets, -1)
+			}
+			effects.Add(source, history.EffectSignerRemoved, dets)
 			continue
 		}
 		effects.Add(source, history.EffectSignerUpdated, map[string]interface{}{
-			"public_key": addy,
+			"public_key": is.Ingestion.rewriteAddress(addy),
 			"weight":     weight,
 		})
 	}
@@ -434,10 +897,14 @@ func (is *Session) ingestSignerEffects(effects *EffectIngestion, op xdr.SetOptio
 			continue
 		}
 
-		effects.Add(source, history.EffectSignerCreated, map[string]interface{}{
-			"public_key": addy,
+		dets := map[string]interface{}{
+			"public_key": is.Ingestion.rewriteAddress(addy),
 			"weight":     weight,
-		})
+		}
+		if addy != source.Address() {
+			is.reserveDetails(effects, dets, 1)
+		}
+		effects.Add(source, history.EffectSignerCreated, dets)
 	}
 
 }
@@ -448,28 +915,7 @@ func (is *Session) ingestTrades() {
 	}
 
 	buyer := is.Cursor.OperationSourceAccount()
-	trades := []xdr.ClaimOfferAtom{}
-
-	switch is.Cursor.OperationType() {
-	case xdr.OperationTypePathPayment:
-		trades = is.Cursor.OperationResult().
-			MustPathPaymentResult().
-			MustSuccess().
-			Offers
-
-	case xdr.OperationTypeManageOffer:
-		trades = is.Cursor.OperationResult().MustManageOfferResult().MustSuccess().OffersClaimed
-	case xdr.OperationTypeCreatePassiveOffer:
-		result := is.Cursor.OperationResult()
-
-		// KNOWN ISSUE:  stellar-core creates results for CreatePassiveOffer operations
-		// with the wrong result arm set.
-		if result.Type == xdr.OperationTypeManageOffer {
-			trades = result.MustManageOfferResult().MustSuccess().OffersClaimed
-		} else {
-			trades = result.MustCreatePassiveOfferResult().MustSuccess().OffersClaimed
-		}
-	}
+	trades := is.operationClaims()
 
 	q := history.Q{Session: is.Ingestion.DB}
 	for i, trade := range trades {
@@ -483,30 +929,138 @@ func (is *Session) ingestTrades() {
 			continue
 		}
 
+		// the trade's assets are validated here, rather than only by
+		// getCreateAssetID, so that the trade is mirrored and handed to the
+		// sink with its validated assets
+		trade.AssetSold, is.Err = is.Ingestion.validateAsset(trade.AssetSold)
+		if is.Err != nil {
+			return
+		}
+
+		trade.AssetBought, is.Err = is.Ingestion.validateAsset(trade.AssetBought)
+		if is.Err != nil {
+			return
+		}
+
+		var soldAssetID, boughtAssetID int64
+		soldAssetID, is.Err = is.Ingestion.getCreateAssetID(trade.AssetSold)
+		if is.Err != nil {
+			return
+		}
+
+		boughtAssetID, is.Err = is.Ingestion.getCreateAssetID(trade.AssetBought)
+		if is.Err != nil {
+			return
+		}
+
+		// the accounts are resolved by the ingestion, rather than by
+		// history.Q, so that their addresses are rewritten consistently with
+		// those of the other rows.  See AddressRewriter.
+		var sellerAccountID, buyerAccountID int64
+		sellerAccountID, is.Err = is.Ingestion.getCreateAccountID(trade.SellerId)
+		if is.Err != nil {
+			return
+		}
+
+		buyerAccountID, is.Err = is.Ingestion.getCreateAccountID(buyer)
+		if is.Err != nil {
+			return
+		}
+
 		//extract original offer price
 		key := xdr.LedgerKey{}
 		key.SetOffer(trade.SellerId, uint64(trade.OfferId))
-		before, _, err := is.Cursor.BeforeAndAfter(key)
+		before, after, err := is.Cursor.BeforeAndAfter(key)
 		if err != nil {
 			is.Err = err
 			return
 		}
 		offerPrice := before.Data.Offer.Price
 
-		is.Err = q.InsertTrade(
+		// the offer is removed from the ledger once it has been fully filled
+		var remaining *xdr.Int64
+		if is.Ingestion.IngestOfferRemaining {
+			amount := xdr.Int64(0)
+			if after != nil {
+				amount = after.Data.MustOffer().Amount
+			}
+			remaining = &amount
+		}
+
+		is.Err = q.InsertTradeWithIDs(
+			is.Cursor.OperationID(),
+			int32(i),
+			sellerAccountID,
+			buyerAccountID,
+			trade,
+			soldAssetID,
+			boughtAssetID,
+			offerPrice,
+			remaining,
+			sTime.MillisFromSeconds(is.Cursor.Ledger().CloseTime),
+		)
+		if is.Err != nil {
+			return
+		}
+
+		is.Err = is.Ingestion.mirrorTrade(
 			is.Cursor.OperationID(),
 			int32(i),
 			buyer,
 			trade,
 			offerPrice,
+			remaining,
 			sTime.MillisFromSeconds(is.Cursor.Ledger().CloseTime),
 		)
 		if is.Err != nil {
 			return
 		}
+
+		is.Err = is.Ingestion.sinkTrade(
+			is.Cursor.OperationID(),
+			int32(i),
+			buyer,
+			trade,
+			is.Cursor.Ledger().CloseTime,
+		)
+		if is.Err != nil {
+			return
+		}
+
+		is.Err = is.Ingestion.addTradePairStat(trade)
+		if is.Err != nil {
+			return
+		}
 	}
 }
 
+// operationClaims returns the offers claimed by the current operation, if it
+// is one that can cross offers.
+func (is *Session) operationClaims() (claims []xdr.ClaimOfferAtom) {
+	switch is.Cursor.OperationType() {
+	case xdr.OperationTypePathPayment:
+		claims = is.Cursor.OperationResult().
+			MustPathPaymentResult().
+			MustSuccess().
+			Offers
+
+	case xdr.OperationTypeManageOffer:
+		claims = is.Cursor.OperationResult().MustManageOfferResult().MustSuccess().OffersClaimed
+	case xdr.OperationTypeCreatePassiveOffer:
+		result := is.Cursor.OperationResult()
+
+		// KNOWN ISSUE:  stellar-core creates results for CreatePassiveOffer operations
+		// with the wrong result arm set.
+		if result.Type == xdr.OperationTypeManageOffer {
+			claims = result.MustManageOfferResult().MustSuccess().OffersClaimed
+		} else {
+			claims = result.MustCreatePassiveOfferResult().MustSuccess().OffersClaimed
+		}
+	}
+
+	return
+}
+
 func (is *Session) ingestTradeEffects(effects *EffectIngestion, buyer xdr.AccountId, claims []xdr.ClaimOfferAtom) {
 	if is.Err != nil {
 		return
@@ -523,18 +1077,18 @@ func (is *Session) ingestTradeEffects(effects *EffectIngestion, buyer xdr.Accoun
 func (is *Session) tradeDetails(buyer, seller xdr.AccountId, claim xdr.ClaimOfferAtom) (bd map[string]interface{}, sd map[string]interface{}) {
 	bd = map[string]interface{}{
 		"offer_id":      claim.OfferId,
-		"seller":        seller.Address(),
-		"bought_amount": amount.String(claim.AmountSold),
-		"sold_amount":   amount.String(claim.AmountBought),
+		"seller":        is.Ingestion.address(seller),
+		"bought_amount": is.formatAmount(claim.AmountSold),
+		"sold_amount":   is.formatAmount(claim.AmountBought),
 	}
 	is.assetDetails(bd, claim.AssetSold, "bought_")
 	is.assetDetails(bd, claim.AssetBought, "sold_")
 
 	sd = map[string]interface{}{
 		"offer_id":      claim.OfferId,
-		"seller":        buyer.Address(),
-		"bought_amount": amount.String(claim.AmountBought),
-		"sold_amount":   amount.String(claim.AmountSold),
+		"seller":        is.Ingestion.address(buyer),
+		"bought_amount": is.formatAmount(claim.AmountBought),
+		"sold_amount":   is.formatAmount(claim.AmountSold),
 	}
 	is.assetDetails(sd, claim.AssetBought, "bought_")
 	is.assetDetails(sd, claim.AssetSold, "sold_")
@@ -547,14 +1101,37 @@ func (is *Session) ingestTransaction() {
 		return
 	}
 
-	// skip ingesting failed transactions
-	if !is.Cursor.Transaction().IsSuccessful() {
+	if !is.Cursor.Transaction().IsSuccessful() && !is.Ingestion.IngestFailedTransactions {
+		return
+	}
+
+	if is.partial != nil && !is.partial[is.Cursor.Transaction().TransactionHash] {
 		return
 	}
+
+	skip, err := is.Ingestion.DuplicateTransaction(is.Cursor.Transaction())
+	if err != nil {
+		is.Err = err
+		return
+	}
+	if skip {
+		return
+	}
+
+	ok, err := is.transactionWhitelisted()
+	if err != nil {
+		is.Err = err
+		return
+	}
+	if !ok {
+		return
+	}
+
 	is.Err = is.Ingestion.Transaction(
 		is.Cursor.TransactionID(),
 		is.Cursor.Transaction(),
 		is.Cursor.TransactionFee(),
+		is.Ingestion.closeTime(is.Cursor.Ledger().CloseTime),
 	)
 	if is.Err != nil {
 		return
@@ -592,23 +1169,18 @@ func (is *Session) ingestTransactionParticipants() {
 
 // assetDetails sets the details for `a` on `result` using keys with `prefix`
 func (is *Session) assetDetails(result map[string]interface{}, a xdr.Asset, prefix string) error {
-	var (
-		t    string
-		code string
-		i    string
-	)
-	err := a.Extract(&t, &code, &i)
+	f, err := is.assetFields(a)
 	if err != nil {
 		return err
 	}
-	result[prefix+"asset_type"] = t
+	result[prefix+"asset_type"] = f.Type
 
 	if a.Type == xdr.AssetTypeAssetTypeNative {
 		return nil
 	}
 
-	result[prefix+"asset_code"] = code
-	result[prefix+"asset_issuer"] = i
+	result[prefix+"asset_code"] = f.Code
+	result[prefix+"asset_issuer"] = f.Issuer
 	return nil
 }
 
@@ -622,25 +1194,31 @@ func (is *Session) operationDetails() map[string]interface{} {
 	switch c.OperationType() {
 	case xdr.OperationTypeCreateAccount:
 		op := c.Operation().Body.MustCreateAccountOp()
-		details["funder"] = source.Address()
-		details["account"] = op.Destination.Address()
-		details["starting_balance"] = amount.String(op.StartingBalance)
+		details["funder"] = is.Ingestion.address(source)
+		details["account"] = is.Ingestion.address(op.Destination)
+		details["starting_balance"] = is.formatAmount(op.StartingBalance)
 	case xdr.OperationTypePayment:
 		op := c.Operation().Body.MustPaymentOp()
-		details["from"] = source.Address()
-		details["to"] = op.Destination.Address()
-		details["amount"] = amount.String(op.Amount)
+		details["from"] = is.Ingestion.address(source)
+		details["to"] = is.Ingestion.address(op.Destination)
+		details["amount"] = is.formatAmount(op.Amount)
 		is.assetDetails(details, op.Asset, "")
 	case xdr.OperationTypePathPayment:
 		op := c.Operation().Body.MustPathPaymentOp()
-		details["from"] = source.Address()
-		details["to"] = op.Destination.Address()
-
-		result := c.OperationResult().MustPathPaymentResult()
-
-		details["amount"] = amount.String(op.DestAmount)
-		details["source_amount"] = amount.String(result.SendAmount())
-		details["source_max"] = amount.String(op.SendMax)
+		details["from"] = is.Ingestion.address(source)
+		details["to"] = is.Ingestion.address(op.Destination)
+
+		details["amount"] = is.formatAmount(op.DestAmount)
+		// the amounts sent and received are only known for payments that were
+		// applied
+		if c.OperationSuccessful() {
+			result := c.OperationResult().MustPathPaymentResult()
+			success := result.MustSuccess()
+			details["source_amount"] = is.formatAmount(result.SendAmount())
+			details["destination_amount_actual"] = is.formatAmount(success.Last.Amount)
+			details["offers_claimed"] = len(success.Offers)
+		}
+		details["source_max"] = is.formatAmount(op.SendMax)
 		is.assetDetails(details, op.DestAsset, "")
 		is.assetDetails(details, op.SendAsset, "source_")
 
@@ -653,7 +1231,7 @@ func (is *Session) operationDetails() map[string]interface{} {
 	case xdr.OperationTypeManageOffer:
 		op := c.Operation().Body.MustManageOfferOp()
 		details["offer_id"] = op.OfferId
-		details["amount"] = amount.String(op.Amount)
+		details["amount"] = is.formatAmount(op.Amount)
 		details["price"] = op.Price.String()
 		details["price_r"] = map[string]interface{}{
 			"n": op.Price.N,
@@ -664,7 +1242,10 @@ func (is *Session) operationDetails() map[string]interface{} {
 
 	case xdr.OperationTypeCreatePassiveOffer:
 		op := c.Operation().Body.MustCreatePassiveOfferOp()
-		details["amount"] = amount.String(op.Amount)
+		// passive offers do not cross offers of an equal price, which matters
+		// when reconstructing the order book from operations
+		details["passive"] = true
+		details["amount"] = is.formatAmount(op.Amount)
 		details["price"] = op.Price.String()
 		details["price_r"] = map[string]interface{}{
 			"n": op.Price.N,
@@ -676,7 +1257,7 @@ func (is *Session) operationDetails() map[string]interface{} {
 		op := c.Operation().Body.MustSetOptionsOp()
 
 		if op.InflationDest != nil {
-			details["inflation_dest"] = op.InflationDest.Address()
+			details["inflation_dest"] = is.Ingestion.address(*op.InflationDest)
 		}
 
 		if op.SetFlags != nil && *op.SetFlags > 0 {
@@ -708,25 +1289,25 @@ func (is *Session) operationDetails() map[string]interface{} {
 		}
 
 		if op.Signer != nil {
-			details["signer_key"] = op.Signer.Key.Address()
+			details["signer_key"] = is.Ingestion.rewriteAddress(op.Signer.Key.Address())
 			details["signer_weight"] = op.Signer.Weight
 		}
 	case xdr.OperationTypeChangeTrust:
 		op := c.Operation().Body.MustChangeTrustOp()
 		is.assetDetails(details, op.Line, "")
-		details["trustor"] = source.Address()
+		details["trustor"] = is.Ingestion.address(source)
 		details["trustee"] = details["asset_issuer"]
-		details["limit"] = amount.String(op.Limit)
+		details["limit"] = is.formatAmount(op.Limit)
 	case xdr.OperationTypeAllowTrust:
 		op := c.Operation().Body.MustAllowTrustOp()
 		is.assetDetails(details, op.Asset.ToAsset(source), "")
-		details["trustee"] = source.Address()
-		details["trustor"] = op.Trustor.Address()
+		details["trustee"] = is.Ingestion.address(source)
+		details["trustor"] = is.Ingestion.address(op.Trustor)
 		details["authorize"] = op.Authorize
 	case xdr.OperationTypeAccountMerge:
 		aid := c.Operation().Body.MustDestination()
-		details["account"] = source.Address()
-		details["into"] = aid.Address()
+		details["account"] = is.Ingestion.address(source)
+		details["into"] = is.Ingestion.address(aid)
 	case xdr.OperationTypeInflation:
 		// no inflation details, presently
 	case xdr.OperationTypeManageData:
@@ -770,6 +1351,18 @@ func (is *Session) operationFlagDetails(result map[string]interface{}, f int32,
 	result[prefix+"_flags_s"] = s
 }
 
+// reserveDetails sets the `reserve_change` detail on `result`, representing a
+// change of `subentries` in the number of subentries of an account, when the
+// session is configured to include reserve details.
+func (is *Session) reserveDetails(effects *EffectIngestion, result map[string]interface{}, subentries int) {
+	if !is.ReserveDetails {
+		return
+	}
+
+	change := xdr.Int64(subentries) * xdr.Int64(effects.BaseReserve)
+	result["reserve_change"] = is.formatAmount(change)
+}
+
 // reportCursorState makes an http request to the configured stellar-core server
 // to report that it has finished processing the data being ingested.  This
 // allows stellar-core to free that storage when next it runs its own
@@ -783,9 +1376,15 @@ func (is *Session) reportCursorState() error {
 		return nil
 	}
 
+	// a descending session ends on its oldest ledger
+	newest := is.Cursor.LastLedger
+	if is.Cursor.Descending() {
+		newest = is.Cursor.FirstLedger
+	}
+
 	core := &stellarcore.Client{URL: is.StellarCoreURL}
 
-	err := core.SetCursor(context.Background(), "HORIZON", is.Cursor.LastLedger)
+	err := core.SetCursor(context.Background(), "HORIZON", newest)
 
 	if err != nil {
 		return errors.Wrap(err, "SetCursor failed")
@@ -0,0 +1,344 @@
+package ingest
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/log"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// Sink receives a copy of the rows written by an ingestion, allowing the
+// ingested history to be exported to an analytics store without loading the
+// horizon database.  Rows are added as they are written to the horizon
+// database.  Commit is called once the horizon database transaction has been
+// committed, and Rollback when it is aborted, in which case the rows added
+// since the last commit must be discarded.
+//
+// A sink shared between concurrently running sessions must be safe for
+// concurrent use.
+type Sink interface {
+	Ledger(row SinkLedger) error
+	Transaction(row SinkTransaction) error
+	Operation(row SinkOperation) error
+	Effect(row SinkEffect) error
+	Trade(row SinkTrade) error
+
+	Commit() error
+	Rollback() error
+}
+
+// SinkLedger is the row written to a Sink for every ingested ledger.
+type SinkLedger struct {
+	ID               int64
+	Sequence         int32
+	Hash             string
+	PrevHash         string
+	ClosedAt         time.Time
+	TransactionCount int
+	OperationCount   int
+	ProtocolVersion  int32
+}
+
+// SinkTransaction is the row written to a Sink for every ingested
+// transaction.
+type SinkTransaction struct {
+	ID               int64
+	Hash             string
+	LedgerSequence   int32
+	ApplicationOrder int32
+	Account          string
+	FeePaid          int32
+	OperationCount   int
+	Successful       bool
+}
+
+// SinkOperation is the row written to a Sink for every ingested operation.
+type SinkOperation struct {
+	ID               int64
+	TransactionID    int64
+	ApplicationOrder int32
+	Type             xdr.OperationType
+	SourceAccount    string
+	Details          json.RawMessage
+	Successful       bool
+}
+
+// SinkEffect is the row written to a Sink for every ingested effect.
+// AccountID is the history id of the account the effect belongs to.
+type SinkEffect struct {
+	AccountID   int64
+	OperationID int64
+	Order       int
+	Type        history.EffectType
+	Details     json.RawMessage
+}
+
+// SinkTrade is the row written to a Sink for every ingested trade, from the
+// point of view of the buyer: the account whose operation crossed the
+// seller's offer.
+type SinkTrade struct {
+	OperationID  int64
+	Order        int32
+	ClosedAt     time.Time
+	OfferID      int64
+	Seller       string
+	Buyer        string
+	SoldAsset    string
+	SoldAmount   int64
+	BoughtAsset  string
+	BoughtAmount int64
+}
+
+// The names of the files, within its directory, a FileSink writes rows to.
+const (
+	SinkLedgersFile      = "ledgers.csv"
+	SinkTransactionsFile = "transactions.csv"
+	SinkOperationsFile   = "operations.csv"
+	SinkEffectsFile      = "effects.csv"
+	SinkTradesFile       = "trades.csv"
+)
+
+// sinkHeaders are the header rows of the files written by a FileSink.
+var sinkHeaders = map[string][]string{
+	SinkLedgersFile: {
+		"id", "sequence", "hash", "prev_hash", "closed_at",
+		"transaction_count", "operation_count", "protocol_version",
+	},
+	SinkTransactionsFile: {
+		"id", "hash", "ledger_sequence", "application_order", "account",
+		"fee_paid", "operation_count", "successful",
+	},
+	SinkOperationsFile: {
+		"id", "transaction_id", "application_order", "type", "source_account",
+		"details", "successful",
+	},
+	SinkEffectsFile: {
+		"history_account_id", "history_operation_id", "order", "type", "details",
+	},
+	SinkTradesFile: {
+		"history_operation_id", "order", "ledger_closed_at", "offer_id", "seller",
+		"buyer", "sold_asset", "sold_amount", "bought_asset", "bought_amount",
+	},
+}
+
+// FileSink is a Sink that appends rows to one csv file per row type in Dir.
+// Each file starts with a header row.  Rows are buffered in memory and only
+// written on Commit.  Times are written in RFC 3339 format, in UTC, and
+// details as json.
+type FileSink struct {
+	Dir string
+
+	lock    sync.Mutex
+	pending map[string][][]string
+}
+
+// NewFileSink returns a FileSink writing to `dir`, which is created if needed.
+func NewFileSink(dir string) (*FileSink, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create sink dir")
+	}
+
+	return &FileSink{Dir: dir}, nil
+}
+
+// Ledger implements Sink.
+func (s *FileSink) Ledger(row SinkLedger) error {
+	s.add(SinkLedgersFile,
+		formatInt(row.ID),
+		formatInt(int64(row.Sequence)),
+		row.Hash,
+		row.PrevHash,
+		formatTime(row.ClosedAt),
+		formatInt(int64(row.TransactionCount)),
+		formatInt(int64(row.OperationCount)),
+		formatInt(int64(row.ProtocolVersion)),
+	)
+	return nil
+}
+
+// Transaction implements Sink.
+func (s *FileSink) Transaction(row SinkTransaction) error {
+	s.add(SinkTransactionsFile,
+		formatInt(row.ID),
+		row.Hash,
+		formatInt(int64(row.LedgerSequence)),
+		formatInt(int64(row.ApplicationOrder)),
+		row.Account,
+		formatInt(int64(row.FeePaid)),
+		formatInt(int64(row.OperationCount)),
+		strconv.FormatBool(row.Successful),
+	)
+	return nil
+}
+
+// Operation implements Sink.
+func (s *FileSink) Operation(row SinkOperation) error {
+	s.add(SinkOperationsFile,
+		formatInt(row.ID),
+		formatInt(row.TransactionID),
+		formatInt(int64(row.ApplicationOrder)),
+		formatInt(int64(row.Type)),
+		row.SourceAccount,
+		string(row.Details),
+		strconv.FormatBool(row.Successful),
+	)
+	return nil
+}
+
+// Effect implements Sink.
+func (s *FileSink) Effect(row SinkEffect) error {
+	s.add(SinkEffectsFile,
+		formatInt(row.AccountID),
+		formatInt(row.OperationID),
+		formatInt(int64(row.Order)),
+		formatInt(int64(row.Type)),
+		string(row.Details),
+	)
+	return nil
+}
+
+// Trade implements Sink.
+func (s *FileSink) Trade(row SinkTrade) error {
+	s.add(SinkTradesFile,
+		formatInt(row.OperationID),
+		formatInt(int64(row.Order)),
+		formatTime(row.ClosedAt),
+		formatInt(row.OfferID),
+		row.Seller,
+		row.Buyer,
+		row.SoldAsset,
+		formatInt(row.SoldAmount),
+		row.BoughtAsset,
+		formatInt(row.BoughtAmount),
+	)
+	return nil
+}
+
+// Commit implements Sink, appending the buffered rows to their files.
+func (s *FileSink) Commit() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for name, rows := range s.pending {
+		err := s.write(name, rows)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write %s", name)
+		}
+		delete(s.pending, name)
+	}
+
+	return nil
+}
+
+// Rollback implements Sink, discarding the buffered rows.
+func (s *FileSink) Rollback() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.pending = nil
+	return nil
+}
+
+func (s *FileSink) add(name string, row ...string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.pending == nil {
+		s.pending = map[string][][]string{}
+	}
+	s.pending[name] = append(s.pending[name], row)
+}
+
+// write appends `rows` to the file `name`, first writing its header if the
+// file is new.
+func (s *FileSink) write(name string, rows [][]string) error {
+	f, err := os.OpenFile(filepath.Join(s.Dir, name), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	w := csv.NewWriter(f)
+	if info.Size() == 0 {
+		w.Write(sinkHeaders[name])
+	}
+	w.WriteAll(rows)
+
+	err = w.Error()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func formatInt(i int64) string {
+	return strconv.FormatInt(i, 10)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// toSink runs `fn` against the ingestion's sink, provided one is configured
+// and it has not failed during the current transaction.
+func (ingest *Ingestion) toSink(fn func(Sink) error) error {
+	if ingest.Sink == nil || ingest.sinkErr != nil {
+		return nil
+	}
+
+	err := fn(ingest.Sink)
+	if err == nil {
+		return nil
+	}
+
+	err = errors.Wrap(err, "sink write failed")
+	if ingest.SinkStrict {
+		return err
+	}
+
+	log.WithField("err", err).Warn("ingest: suspending writes to sink")
+	ingest.sinkErr = err
+	ingest.Sink.Rollback()
+	return nil
+}
+
+// sinkTrade writes a trade inserted into the horizon db by
+// `history.Q.InsertTrade` to the sink, if one is configured.
+func (ingest *Ingestion) sinkTrade(
+	opid int64,
+	order int32,
+	buyer xdr.AccountId,
+	trade xdr.ClaimOfferAtom,
+	ledgerClosedAt int64,
+) error {
+	return ingest.toSink(func(s Sink) error {
+		return s.Trade(SinkTrade{
+			OperationID:  opid,
+			Order:        order,
+			ClosedAt:     ingest.closeTime(ledgerClosedAt),
+			OfferID:      int64(trade.OfferId),
+			Seller:       ingest.address(trade.SellerId),
+			Buyer:        ingest.address(buyer),
+			SoldAsset:    ingest.assetString(trade.AssetSold),
+			SoldAmount:   int64(trade.AmountSold),
+			BoughtAsset:  ingest.assetString(trade.AssetBought),
+			BoughtAmount: int64(trade.AmountBought),
+		})
+	})
+}
@@ -0,0 +1,136 @@
+package ingest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ingest-sink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	sink, err := NewFileSink(dir)
+	require.NoError(t, err)
+
+	closedAt := time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, sink.Ledger(SinkLedger{
+		ID:               8589934592,
+		Sequence:         2,
+		Hash:             "aa",
+		PrevHash:         "bb",
+		ClosedAt:         closedAt,
+		TransactionCount: 3,
+		OperationCount:   4,
+		ProtocolVersion:  9,
+	}))
+	require.NoError(t, sink.Effect(SinkEffect{
+		AccountID:   1,
+		OperationID: 8589938689,
+		Order:       1,
+		Type:        history.EffectAccountCredited,
+		Details:     json.RawMessage(`{"amount":"10.0000000","asset_type":"native"}`),
+	}))
+	require.NoError(t, sink.Commit())
+
+	// rolled back rows are never written
+	require.NoError(t, sink.Operation(SinkOperation{ID: 1, Type: xdr.OperationTypePayment}))
+	require.NoError(t, sink.Rollback())
+	require.NoError(t, sink.Commit())
+	_, err = os.Stat(filepath.Join(dir, SinkOperationsFile))
+	assert.True(t, os.IsNotExist(err))
+
+	// committing again appends without repeating the header
+	require.NoError(t, sink.Ledger(SinkLedger{ID: 12884901888, Sequence: 3, ClosedAt: closedAt}))
+	require.NoError(t, sink.Commit())
+
+	ledgers, err := ioutil.ReadFile(filepath.Join(dir, SinkLedgersFile))
+	require.NoError(t, err)
+	assert.Equal(t,
+		"id,sequence,hash,prev_hash,closed_at,transaction_count,operation_count,protocol_version\n"+
+			"8589934592,2,aa,bb,2018-01-02T03:04:05Z,3,4,9\n"+
+			"12884901888,3,,,2018-01-02T03:04:05Z,0,0,0\n",
+		string(ledgers),
+	)
+
+	effects, err := ioutil.ReadFile(filepath.Join(dir, SinkEffectsFile))
+	require.NoError(t, err)
+	assert.Equal(t,
+		"history_account_id,history_operation_id,order,type,details\n"+
+			`1,8589938689,1,2,"{""amount"":""10.0000000"",""asset_type"":""native""}"`+"\n",
+		string(effects),
+	)
+}
+
+type failingSink struct {
+	FileSink
+}
+
+func (s *failingSink) Ledger(row SinkLedger) error {
+	return errors.New("sink unavailable")
+}
+
+func TestIngestionToSink(t *testing.T) {
+	write := func(ingest *Ingestion) error {
+		return ingest.toSink(func(s Sink) error {
+			return s.Ledger(SinkLedger{})
+		})
+	}
+
+	// by default a failing sink is suspended rather than failing the ingestion
+	ingestion := &Ingestion{IngestionOptions: IngestionOptions{Sink: &failingSink{}}}
+	assert.NoError(t, write(ingestion))
+	assert.Error(t, ingestion.sinkErr)
+
+	ingestion = &Ingestion{IngestionOptions: IngestionOptions{Sink: &failingSink{}, SinkStrict: true}}
+	assert.Error(t, write(ingestion))
+	assert.NoError(t, ingestion.sinkErr)
+}
+
+func TestIngest_Sink(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("trades")
+	defer tt.Finish()
+
+	dir, err := ioutil.TempDir("", "ingest-sink")
+	tt.Require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	sys := sys(tt)
+	sys.Sink, err = NewFileSink(dir)
+	tt.Require.NoError(err)
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	// every row committed to the horizon db is written to the sink
+	for file, table := range map[string]string{
+		SinkLedgersFile:      "history_ledgers",
+		SinkTransactionsFile: "history_transactions",
+		SinkOperationsFile:   "history_operations",
+		SinkEffectsFile:      "history_effects",
+		SinkTradesFile:       "history_trades",
+	} {
+		var count int
+		err = tt.HorizonSession().GetRaw(&count, "SELECT COUNT(*) FROM "+table)
+		tt.Require.NoError(err)
+
+		raw, err := ioutil.ReadFile(filepath.Join(dir, file))
+		tt.Require.NoError(err)
+		lines := strings.Count(string(raw), "\n")
+		tt.Assert.Equal(count, lines-1, file)
+	}
+}
@@ -0,0 +1,267 @@
+package ingest
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/stellar/go/services/horizon/internal/db2/core"
+	"github.com/stellar/go/services/horizon/internal/log"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// SpoolFile is the name of the file, within the spool directory, that ledgers
+// are spooled to.
+const SpoolFile = "ledgers.spool"
+
+// spooledLedger is the representation of a LedgerBundle written to the spool.
+// Each is written as a single line of json, with the xdr encoded as it is
+// stored by stellar-core.
+type spooledLedger struct {
+	Sequence       int32                `json:"sequence"`
+	LedgerHash     string               `json:"ledger_hash"`
+	PrevHash       string               `json:"prev_hash"`
+	BucketListHash string               `json:"bucket_list_hash"`
+	CloseTime      int64                `json:"close_time"`
+	Header         string               `json:"header"`
+	Transactions   []spooledTransaction `json:"transactions"`
+}
+
+type spooledTransaction struct {
+	Hash     string `json:"hash"`
+	Index    int32  `json:"index"`
+	Envelope string `json:"envelope"`
+	Result   string `json:"result"`
+	Meta     string `json:"meta"`
+	FeeMeta  string `json:"fee_meta"`
+}
+
+// spool is an append-only file of ledger bundles loaded from stellar-core but
+// not yet committed to the horizon db.
+type spool struct {
+	path string
+}
+
+func newSpool(dir string) *spool {
+	return &spool{path: filepath.Join(dir, SpoolFile)}
+}
+
+// Append writes `bundle` to the end of the spool.  Ledgers with transactions
+// skipped under XDRErrorSkipTransaction are not spooled, as the placeholders of
+// the skipped transactions cannot be told apart from real transactions once
+// reloaded.
+func (s *spool) Append(bundle *LedgerBundle) error {
+	if len(bundle.Skipped) > 0 {
+		log.WithField("ledger", bundle.Sequence).Warn("ingest: not spooling ledger with skipped transactions")
+		return nil
+	}
+
+	sl, err := spoolLedger(bundle)
+	if err != nil {
+		return err
+	}
+
+	line, err := json.Marshal(sl)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode ledger")
+	}
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed to open spool")
+	}
+
+	_, err = f.Write(append(line, '\n'))
+	if err != nil {
+		f.Close()
+		return errors.Wrap(err, "failed to write spool")
+	}
+
+	err = f.Sync()
+	if err != nil {
+		f.Close()
+		return errors.Wrap(err, "failed to sync spool")
+	}
+
+	return f.Close()
+}
+
+// Load reads the spooled ledgers, ordered by sequence.  Should a ledger have
+// been spooled more than once, its last copy is returned.
+func (s *spool) Load() ([]LedgerBundle, error) {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open spool")
+	}
+	defer f.Close()
+
+	bySeq := map[int32]LedgerBundle{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<30)
+	for scanner.Scan() {
+		var sl spooledLedger
+		err = json.Unmarshal(scanner.Bytes(), &sl)
+		if err != nil {
+			// a crash while appending leaves a truncated last line
+			log.WithField("err", err).Warn("ingest: ignoring undecodable spooled ledger")
+			continue
+		}
+
+		bundle, err := sl.bundle()
+		if err != nil {
+			return nil, err
+		}
+		bySeq[bundle.Sequence] = bundle
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read spool")
+	}
+
+	seqs := make([]int, 0, len(bySeq))
+	for seq := range bySeq {
+		seqs = append(seqs, int(seq))
+	}
+	sort.Ints(seqs)
+
+	bundles := make([]LedgerBundle, len(seqs))
+	for i, seq := range seqs {
+		bundles[i] = bySeq[int32(seq)]
+	}
+
+	return bundles, nil
+}
+
+// Trim removes the ledgers `first` through `last` from the spool, removing the
+// spool file entirely once it is empty.
+func (s *spool) Trim(first, last int32) error {
+	bundles, err := s.Load()
+	if err != nil {
+		return err
+	}
+
+	var lines []byte
+	for i := range bundles {
+		if bundles[i].Sequence >= first && bundles[i].Sequence <= last {
+			continue
+		}
+
+		sl, err := spoolLedger(&bundles[i])
+		if err != nil {
+			return err
+		}
+
+		line, err := json.Marshal(sl)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode ledger")
+		}
+		lines = append(lines, line...)
+		lines = append(lines, '\n')
+	}
+
+	if len(lines) == 0 {
+		err = os.Remove(s.path)
+		if err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "failed to remove spool")
+		}
+		return nil
+	}
+
+	tmp := s.path + ".tmp"
+	err = ioutil.WriteFile(tmp, lines, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed to write spool")
+	}
+
+	return errors.Wrap(os.Rename(tmp, s.path), "failed to replace spool")
+}
+
+func spoolLedger(bundle *LedgerBundle) (spooledLedger, error) {
+	sl := spooledLedger{
+		Sequence:       bundle.Sequence,
+		LedgerHash:     bundle.Header.LedgerHash,
+		PrevHash:       bundle.Header.PrevHash,
+		BucketListHash: bundle.Header.BucketListHash,
+		CloseTime:      bundle.Header.CloseTime,
+		Header:         bundle.Header.DataXDR(),
+		Transactions:   make([]spooledTransaction, len(bundle.Transactions)),
+	}
+
+	for i := range bundle.Transactions {
+		tx := &bundle.Transactions[i]
+
+		// stellar-core stores the result along with the transaction's hash
+		result, err := xdr.MarshalBase64(tx.Result)
+		if err != nil {
+			return sl, errors.Wrapf(err, "failed to encode result of transaction %s", tx.TransactionHash)
+		}
+
+		sl.Transactions[i] = spooledTransaction{
+			Hash:     tx.TransactionHash,
+			Index:    tx.Index,
+			Envelope: tx.EnvelopeXDR(),
+			Result:   result,
+			Meta:     tx.ResultMetaXDR(),
+			FeeMeta:  bundle.TransactionFees[i].ChangesXDR(),
+		}
+	}
+
+	return sl, nil
+}
+
+func (sl *spooledLedger) bundle() (LedgerBundle, error) {
+	bundle := LedgerBundle{
+		Sequence: sl.Sequence,
+		Header: core.LedgerHeader{
+			LedgerHash:     sl.LedgerHash,
+			PrevHash:       sl.PrevHash,
+			BucketListHash: sl.BucketListHash,
+			CloseTime:      sl.CloseTime,
+			Sequence:       uint32(sl.Sequence),
+		},
+		Transactions:    make([]core.Transaction, len(sl.Transactions)),
+		TransactionFees: make([]core.TransactionFee, len(sl.Transactions)),
+	}
+
+	err := xdr.SafeUnmarshalBase64(sl.Header, &bundle.Header.Data)
+	if err != nil {
+		return bundle, errors.Wrapf(err, "invalid header for spooled ledger %d", sl.Sequence)
+	}
+
+	for i, stx := range sl.Transactions {
+		tx := &bundle.Transactions[i]
+		tx.TransactionHash = stx.Hash
+		tx.LedgerSequence = sl.Sequence
+		tx.Index = stx.Index
+
+		fee := &bundle.TransactionFees[i]
+		fee.TransactionHash = stx.Hash
+		fee.LedgerSequence = sl.Sequence
+		fee.Index = stx.Index
+
+		for _, field := range []struct {
+			b64  string
+			dest interface{}
+		}{
+			{stx.Envelope, &tx.Envelope},
+			{stx.Result, &tx.Result},
+			{stx.Meta, &tx.ResultMeta},
+			{stx.FeeMeta, &fee.Changes},
+		} {
+			err = xdr.SafeUnmarshalBase64(field.b64, field.dest)
+			if err != nil {
+				return bundle, errors.Wrapf(err, "invalid transaction %s in spooled ledger %d", stx.Hash, sl.Sequence)
+			}
+		}
+	}
+
+	return bundle, nil
+}
@@ -0,0 +1,123 @@
+package ingest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+)
+
+func TestSpool(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	dir, err := ioutil.TempDir("", "spool")
+	tt.Require.NoError(err)
+	defer os.RemoveAll(dir)
+	sp := newSpool(dir)
+
+	loaded, err := sp.Load()
+	tt.Require.NoError(err)
+	tt.Assert.Empty(loaded)
+
+	var bundles []LedgerBundle
+	for seq := int32(1); seq <= ledger.CurrentState().CoreLatest; seq++ {
+		bundle := LedgerBundle{Sequence: seq}
+		tt.Require.NoError(bundle.Load(tt.CoreSession()))
+		tt.Require.NoError(sp.Append(&bundle))
+		bundles = append(bundles, bundle)
+	}
+
+	// a ledger spooled twice is loaded once, and a partially written line is
+	// ignored
+	tt.Require.NoError(sp.Append(&bundles[1]))
+	f, err := os.OpenFile(filepath.Join(dir, SpoolFile), os.O_APPEND|os.O_WRONLY, 0600)
+	tt.Require.NoError(err)
+	_, err = f.WriteString(`{"sequence": 9`)
+	tt.Require.NoError(err)
+	tt.Require.NoError(f.Close())
+
+	loaded, err = sp.Load()
+	tt.Require.NoError(err)
+	tt.Require.Len(loaded, len(bundles))
+
+	for i := range bundles {
+		expected, actual := bundles[i], loaded[i]
+		tt.Assert.Equal(expected.Sequence, actual.Sequence)
+		tt.Assert.Equal(expected.Header, actual.Header)
+		tt.Require.Len(actual.Transactions, len(expected.Transactions))
+		for j := range expected.Transactions {
+			tt.Assert.Equal(expected.Transactions[j], actual.Transactions[j])
+			tt.Assert.Equal(expected.TransactionFees[j].ChangesXDR(), actual.TransactionFees[j].ChangesXDR())
+		}
+	}
+
+	tt.Require.NoError(sp.Trim(1, 2))
+	loaded, err = sp.Load()
+	tt.Require.NoError(err)
+	tt.Assert.Len(loaded, len(bundles)-2)
+
+	tt.Require.NoError(sp.Trim(1, ledger.CurrentState().CoreLatest))
+	_, err = os.Stat(filepath.Join(dir, SpoolFile))
+	tt.Assert.True(os.IsNotExist(err))
+}
+
+func TestReplaySpool(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	dir, err := ioutil.TempDir("", "spool")
+	tt.Require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	sys := sys(tt)
+	sys.SpoolDir = dir
+	sp := newSpool(dir)
+	latest := ledger.CurrentState().CoreLatest
+
+	spoolAll := func() {
+		for seq := int32(1); seq <= latest; seq++ {
+			bundle := LedgerBundle{Sequence: seq}
+			tt.Require.NoError(bundle.Load(tt.CoreSession()))
+			tt.Require.NoError(sp.Append(&bundle))
+		}
+	}
+
+	ledgers := func() (found int) {
+		err := tt.HorizonSession().GetRaw(&found, `SELECT COUNT(*) FROM history_ledgers`)
+		tt.Require.NoError(err)
+		return
+	}
+
+	spoolAll()
+	n, err := sys.ReplaySpool()
+	tt.Require.NoError(err)
+	tt.Assert.Equal(int(latest), n)
+	tt.Assert.Equal(int(latest), ledgers())
+
+	_, err = os.Stat(filepath.Join(dir, SpoolFile))
+	tt.Assert.True(os.IsNotExist(err))
+
+	// replaying ledgers that have already been ingested is a no-op
+	spoolAll()
+	n, err = sys.ReplaySpool()
+	tt.Require.NoError(err)
+	tt.Assert.Equal(0, n)
+	tt.Assert.Equal(int(latest), ledgers())
+
+	loaded, err := sp.Load()
+	tt.Require.NoError(err)
+	tt.Assert.Empty(loaded)
+
+	// sessions remove the ledgers they commit from the spool
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, latest, sys)
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+	_, err = os.Stat(filepath.Join(dir, SpoolFile))
+	tt.Assert.True(os.IsNotExist(err))
+}
@@ -0,0 +1,241 @@
+package ingest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/errors"
+)
+
+// SweepCheck identifies one of the checks run by Session.IntegritySweep.
+// Checks are combined into a set using bitwise or.
+type SweepCheck int
+
+const (
+	// SweepGaps reports the ledgers of the range missing from
+	// history_ledgers.
+	SweepGaps SweepCheck = 1 << iota
+
+	// SweepHashChain reports the ledgers whose previous ledger hash does not
+	// match the hash of the ledger before them, when that ledger is present.
+	SweepHashChain
+
+	// SweepCounts reports the ledgers whose transaction or operation count
+	// does not match the successful transactions and operations ingested for
+	// them.  Partially ingested ledgers are not checked.
+	SweepCounts
+
+	// SweepTradeParticipants reports the trades whose buyer, the account
+	// whose operation crossed the offer, is not a participant of the
+	// operation, or whose seller has no history account.  Operation
+	// participants are derived from the operation itself, so sellers are not
+	// participants of the operations crossing their offers.
+	SweepTradeParticipants
+
+	// SweepOperationParticipants reports the operations whose source account
+	// is not one of their participants.
+	SweepOperationParticipants
+
+	// SweepAll is the set of every check.
+	SweepAll = SweepGaps | SweepHashChain | SweepCounts | SweepTradeParticipants | SweepOperationParticipants
+)
+
+// String returns the name of the check.
+func (c SweepCheck) String() string {
+	switch c {
+	case SweepGaps:
+		return "gaps"
+	case SweepHashChain:
+		return "hash_chain"
+	case SweepCounts:
+		return "counts"
+	case SweepTradeParticipants:
+		return "trade_participants"
+	case SweepOperationParticipants:
+		return "operation_participants"
+	default:
+		return fmt.Sprintf("SweepCheck(%d)", int(c))
+	}
+}
+
+// SweepReport is the result of Session.IntegritySweep.
+type SweepReport struct {
+	First  int32
+	Last   int32
+	Checks SweepCheck
+
+	// Violations are the problems found, grouped by check.
+	Violations []SweepViolation
+}
+
+// OK returns true if the sweep found no violations.
+func (r *SweepReport) OK() bool {
+	return len(r.Violations) == 0
+}
+
+// SweepViolation is a problem found by Session.IntegritySweep.  ID is the id
+// of the offending operation for the participant checks, and zero otherwise.
+type SweepViolation struct {
+	Check   SweepCheck
+	Ledger  int32
+	ID      int64
+	Message string
+}
+
+// sweepRow is a row returned by the queries of the sweep checks.
+type sweepRow struct {
+	Ledger  int32  `db:"ledger"`
+	ID      int64  `db:"id"`
+	Message string `db:"message"`
+}
+
+// sweepQueries are the queries of the sweep checks.  Each is run with the
+// first and last ledger of the range and returns the violations found.
+var sweepQueries = []struct {
+	Check SweepCheck
+	SQL   string
+}{
+	{SweepGaps, `
+		SELECT s.seq AS ledger, 0 AS id, 'ledger missing' AS message
+		FROM generate_series(?::integer, ?::integer) s(seq)
+		LEFT JOIN history_ledgers hl ON hl.sequence = s.seq
+		WHERE hl.sequence IS NULL
+		ORDER BY s.seq`,
+	},
+	{SweepHashChain, `
+		SELECT cur.sequence AS ledger, 0 AS id,
+			'previous hash ' || COALESCE(cur.previous_ledger_hash, 'null') ||
+			' does not match ' || prev.ledger_hash AS message
+		FROM history_ledgers cur
+		JOIN history_ledgers prev ON prev.sequence = cur.sequence - 1
+		WHERE cur.sequence BETWEEN ? AND ?
+		AND cur.previous_ledger_hash IS DISTINCT FROM prev.ledger_hash
+		ORDER BY cur.sequence`,
+	},
+	{SweepCounts, `
+		SELECT hl.sequence AS ledger, 0 AS id,
+			'header counts ' || hl.transaction_count || ' transactions and ' ||
+			hl.operation_count || ' operations, found ' ||
+			COALESCE(c.txs, 0) || ' and ' || COALESCE(c.ops, 0) AS message
+		FROM history_ledgers hl
+		LEFT JOIN (
+			SELECT ht.ledger_sequence,
+				COUNT(DISTINCT ht.id) AS txs,
+				COUNT(ho.id) AS ops
+			FROM history_transactions ht
+			LEFT JOIN history_operations ho ON ho.transaction_id = ht.id
+			WHERE ht.ledger_sequence BETWEEN ? AND ?
+			AND ht.successful IS NOT FALSE
+			GROUP BY ht.ledger_sequence
+		) c ON c.ledger_sequence = hl.sequence
+		WHERE hl.sequence BETWEEN ? AND ?
+		AND NOT hl.partial
+		AND (hl.transaction_count <> COALESCE(c.txs, 0)
+			OR hl.operation_count <> COALESCE(c.ops, 0))
+		ORDER BY hl.sequence`,
+	},
+	{SweepTradeParticipants, `
+		SELECT ht.ledger_sequence AS ledger, tr.history_operation_id AS id,
+			CASE WHEN seller.id IS NULL
+				THEN 'trade ' || tr."order" || ' seller has no history account'
+				ELSE 'trade ' || tr."order" || ' buyer is not a participant'
+			END AS message
+		FROM history_trades tr
+		JOIN history_operations ho ON ho.id = tr.history_operation_id
+		JOIN history_transactions ht ON ht.id = ho.transaction_id
+		LEFT JOIN history_accounts seller ON seller.id = CASE WHEN tr.base_is_seller
+			THEN tr.base_account_id ELSE tr.counter_account_id END
+		WHERE ht.ledger_sequence BETWEEN ? AND ?
+		AND (seller.id IS NULL OR NOT EXISTS (
+			SELECT 1 FROM history_operation_participants hop
+			WHERE hop.history_operation_id = tr.history_operation_id
+			AND hop.history_account_id = CASE WHEN tr.base_is_seller
+				THEN tr.counter_account_id ELSE tr.base_account_id END
+		))
+		ORDER BY tr.history_operation_id, tr."order"`,
+	},
+	{SweepOperationParticipants, `
+		SELECT ht.ledger_sequence AS ledger, ho.id AS id,
+			'source ' || ho.source_account || ' is not a participant' AS message
+		FROM history_operations ho
+		JOIN history_transactions ht ON ht.id = ho.transaction_id
+		WHERE ht.ledger_sequence BETWEEN ? AND ?
+		AND NOT EXISTS (
+			SELECT 1 FROM history_operation_participants hop
+			JOIN history_accounts ha ON ha.id = hop.history_account_id
+			WHERE hop.history_operation_id = ho.id
+			AND ha.address = ho.source_account
+		)
+		ORDER BY ho.id`,
+	},
+}
+
+// IntegritySweep audits the history of the ledgers `first` through `last`,
+// typically once a backfill has completed, running the checks in
+// SweepChecks and returning every violation found.  The sweep runs in a read
+// only transaction on a new connection to the horizon db, so it may run
+// alongside ingestion and never modifies the history.  An error is only
+// returned if the checks could not be run.
+func (is *Session) IntegritySweep(first, last int32) (SweepReport, error) {
+	report := SweepReport{First: first, Last: last, Checks: is.SweepChecks}
+	if report.Checks == 0 {
+		report.Checks = SweepAll
+	}
+
+	if first < 1 || last < first {
+		return report, errors.Errorf("invalid ledger range %d-%d", first, last)
+	}
+
+	hdb := is.Ingestion.DB.Clone()
+	err := hdb.Begin()
+	if err != nil {
+		return report, errors.Wrap(err, "failed to begin sweep")
+	}
+	defer hdb.Rollback()
+
+	_, err = hdb.ExecRaw("SET TRANSACTION READ ONLY")
+	if err != nil {
+		return report, errors.Wrap(err, "failed to begin sweep")
+	}
+
+	for _, q := range sweepQueries {
+		if report.Checks&q.Check == 0 {
+			continue
+		}
+
+		err = sweepCheck(hdb, &report, q.Check, q.SQL)
+		if err != nil {
+			return report, errors.Wrapf(err, "%s check failed", q.Check)
+		}
+	}
+
+	return report, nil
+}
+
+// sweepCheck runs the query `sql` of `check` over the range of `report`,
+// adding the violations it finds to the report.
+func sweepCheck(hdb *db.Session, report *SweepReport, check SweepCheck, sql string) error {
+	// every query takes the range once per pair of placeholders
+	var args []interface{}
+	for i := 0; i < strings.Count(sql, "?"); i += 2 {
+		args = append(args, report.First, report.Last)
+	}
+
+	var rows []sweepRow
+	err := hdb.SelectRaw(&rows, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		report.Violations = append(report.Violations, SweepViolation{
+			Check:   check,
+			Ledger:  row.Ledger,
+			ID:      row.ID,
+			Message: row.Message,
+		})
+	}
+
+	return nil
+}
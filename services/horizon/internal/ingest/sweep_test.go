@@ -0,0 +1,66 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+)
+
+func TestIntegritySweep(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+	last := ledger.CurrentState().CoreLatest
+
+	report, err := s.IntegritySweep(1, last)
+	tt.Require.NoError(err)
+	tt.Assert.True(report.OK(), "unexpected violations: %v", report.Violations)
+	tt.Assert.Equal(SweepAll, report.Checks)
+
+	_, err = s.Ingestion.DB.ExecRaw(`DELETE FROM history_ledgers WHERE sequence = 4`)
+	tt.Require.NoError(err)
+	_, err = s.Ingestion.DB.ExecRaw(`UPDATE history_ledgers SET previous_ledger_hash = 'bad' WHERE sequence = 6`)
+	tt.Require.NoError(err)
+	_, err = s.Ingestion.DB.ExecRaw(`UPDATE history_ledgers SET transaction_count = transaction_count + 1 WHERE sequence = 7`)
+	tt.Require.NoError(err)
+
+	var opid int64
+	err = s.Ingestion.DB.GetRaw(&opid, `SELECT MIN(history_operation_id) FROM history_trades`)
+	tt.Require.NoError(err)
+	_, err = s.Ingestion.DB.ExecRaw(`DELETE FROM history_operation_participants WHERE history_operation_id = ?`, opid)
+	tt.Require.NoError(err)
+
+	report, err = s.IntegritySweep(1, last)
+	tt.Require.NoError(err)
+
+	found := map[SweepCheck][]SweepViolation{}
+	for _, v := range report.Violations {
+		found[v.Check] = append(found[v.Check], v)
+	}
+
+	if tt.Assert.Len(found[SweepGaps], 1) {
+		tt.Assert.Equal(int32(4), found[SweepGaps][0].Ledger)
+	}
+	if tt.Assert.Len(found[SweepHashChain], 1) {
+		tt.Assert.Equal(int32(6), found[SweepHashChain][0].Ledger)
+	}
+	if tt.Assert.Len(found[SweepCounts], 1) {
+		tt.Assert.Equal(int32(7), found[SweepCounts][0].Ledger)
+	}
+	tt.Assert.NotEmpty(found[SweepTradeParticipants])
+	if tt.Assert.Len(found[SweepOperationParticipants], 1) {
+		tt.Assert.Equal(opid, found[SweepOperationParticipants][0].ID)
+	}
+
+	// checks can be run individually
+	s.SweepChecks = SweepGaps | SweepCounts
+	report, err = s.IntegritySweep(1, last)
+	tt.Require.NoError(err)
+	tt.Assert.Len(report.Violations, 2)
+
+	_, err = s.IntegritySweep(5, 4)
+	tt.Assert.Error(err)
+}
@@ -1,13 +1,16 @@
 package ingest
 
 import (
+	sq "github.com/Masterminds/squirrel"
 	"github.com/stellar/go/services/horizon/internal/db2/core"
 	"github.com/stellar/go/services/horizon/internal/db2/history"
 	herr "github.com/stellar/go/services/horizon/internal/errors"
+	"github.com/stellar/go/services/horizon/internal/ingest/participants"
 	"github.com/stellar/go/services/horizon/internal/ledger"
 	"github.com/stellar/go/services/horizon/internal/log"
-	"github.com/stellar/go/services/horizon/internal/toid"
+	"github.com/stellar/go/support/db"
 	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
 )
 
 // Backfill ingests history in reverse chronological order, from the current
@@ -33,8 +36,7 @@ func (i *System) Backfill(n uint) error {
 // database.
 func (i *System) ClearAll() error {
 
-	hdb := i.HorizonDB.Clone()
-	ingestion := &Ingestion{DB: hdb}
+	ingestion := i.newIngestion()
 
 	err := ingestion.Start()
 	if err != nil {
@@ -73,6 +75,43 @@ func (i *System) RebaseHistory(sequence int32) error {
 	return nil
 }
 
+// RebuildParticipants recomputes the transaction and operation participants
+// of the already ingested ledgers in the range [first, last], replacing the
+// existing participant rows.  Participants are derived from the envelope and
+// meta stored in the history database, so stellar-core is not consulted and no
+// other history table is modified.  Each ledger is rebuilt within its own
+// database transaction.  Ledgers ingested with MetaStoreNone cannot be rebuilt.
+func (i *System) RebuildParticipants(first, last int32) error {
+	if first > last {
+		return errors.Errorf("invalid range: %d > %d", first, last)
+	}
+
+	ingestion := i.newIngestion()
+	err := ingestion.Start()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin ingestion")
+	}
+	defer ingestion.Rollback()
+
+	for seq := first; seq <= last; seq++ {
+		err = rebuildLedgerParticipants(ingestion, i.ids(), seq)
+		if err != nil {
+			return errors.Wrapf(err, "failed to rebuild participants for ledger %d", seq)
+		}
+
+		err = ingestion.Flush()
+		if err != nil {
+			return errors.Wrap(err, "failed to flush ingestion")
+		}
+	}
+
+	log.WithField("first", first).
+		WithField("last", last).
+		Info("ingest: rebuilt participants")
+
+	return nil
+}
+
 // ReingestAll re-ingests all ledgers
 func (i *System) ReingestAll() (int, error) {
 
@@ -103,8 +142,63 @@ func (i *System) ReingestAll() (int, error) {
 	return i.ReingestRange(coreElder, coreLatest)
 }
 
-// ReingestOutdated finds old ledgers and reimports them.
+// ReingestHistoricalRange reingests the ledgers from `first` to `last`,
+// inclusive, while live ingestion continues to run at the head of the history
+// db, allowing old ranges to be backfilled without downtime.
+//
+// The two never write the same ledgers: the range must lie entirely behind
+// the latest ledger fully ingested into the history db, which is read while
+// holding the lock that guards the live session.  Live sessions only ingest
+// ledgers newer than the history db's latest ledger, so once the range has
+// been checked the live head can only move away from it.  The reingest does
+// not report its cursor to stellar-core, which is left to the live sessions.
+//
+// Both sessions may still encounter the same accounts for the first time.
+// Accounts are created in a savepoint, so whichever session commits an
+// account second reuses the id assigned by the first rather than failing; see
+// Ingestion.getCreateAccountID.
+func (i *System) ReingestHistoricalRange(first, last int32) (int, error) {
+	if first < 1 || first > last {
+		return 0, errors.Errorf("invalid ledger range: %d to %d", first, last)
+	}
+
+	q := history.Q{Session: i.HorizonDB}
+
+	i.lock.Lock()
+	var head int32
+	err := q.LatestLedger(&head)
+	i.lock.Unlock()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to load latest ledger")
+	}
+
+	if last > head {
+		return 0, errors.Errorf(
+			"ledger %d is not behind the live head of ledger %d", last, head,
+		)
+	}
+
+	is := NewSession(i)
+	is.Cursor = NewCursor(first, last, i)
+	is.ClearExisting = true
+	is.SkipCursorUpdate = true
+
+	is.Run()
+	log.WithField("start", first).
+		WithField("end", last).
+		WithField("err", is.Err).
+		WithField("ingested", is.Ingested).
+		Info("ingest: historical range complete")
+	return is.Ingested, is.Err
+}
+
+// ReingestOutdated finds old ledgers and reimports them.  The history tables
+// are analyzed once every outdated ledger has been reingested when
+// AnalyzeAfterReingest is set.
 func (i *System) ReingestOutdated() (n int, err error) {
+	defer func() {
+		err = i.analyzeAfterReingest(n, err)
+	}()
 
 	q := history.Q{Session: i.HorizonDB}
 
@@ -133,7 +227,7 @@ func (i *System) ReingestOutdated() (n int, err error) {
 
 		var start, end int32
 		flush := func() error {
-			ingested, ferr := i.ReingestRange(start, end)
+			ingested, ferr := i.reingestRange(start, end)
 
 			if ferr != nil {
 				return ferr
@@ -173,7 +267,15 @@ func (i *System) ReingestOutdated() (n int, err error) {
 }
 
 // ReingestRange reingests a range of ledgers, from `start` to `end`, inclusive.
+// The range is reingested newest first when `start` is greater than `end`.
+// The history tables are analyzed once done when AnalyzeAfterReingest is set.
 func (i *System) ReingestRange(start, end int32) (int, error) {
+	ingested, err := i.reingestRange(start, end)
+	return ingested, i.analyzeAfterReingest(ingested, err)
+}
+
+// reingestRange is ReingestRange, without analyzing the history tables.
+func (i *System) reingestRange(start, end int32) (int, error) {
 	is := NewSession(i)
 	is.Cursor = NewCursor(start, end, i)
 	is.ClearExisting = true
@@ -187,12 +289,102 @@ func (i *System) ReingestRange(start, end int32) (int, error) {
 	return is.Ingested, is.Err
 }
 
-// ReingestSingle re-ingests a single ledger
+// ReingestRangeDescending reingests the ledgers `first` through `last`,
+// inclusive, from the newest to the oldest, so that the most recent history
+// is available first.  Ids are derived from the ledgers themselves and are
+// the same as those of an ascending reingest.  Every ledger is cleared as it
+// is reingested, so the whole range is cleared regardless of the direction.
+func (i *System) ReingestRangeDescending(first, last int32) (int, error) {
+	if first < 1 || first > last {
+		return 0, errors.Errorf("invalid ledger range: %d to %d", first, last)
+	}
+
+	return i.ReingestRange(last, first)
+}
+
+// ReingestSingle re-ingests a single ledger.  The history tables are not
+// analyzed afterwards, even when AnalyzeAfterReingest is set, as single ledgers
+// are usually reingested one after another.
 func (i *System) ReingestSingle(sequence int32) error {
-	_, err := i.ReingestRange(sequence, sequence)
+	_, err := i.reingestRange(sequence, sequence)
 	return err
 }
 
+// ReplaySpool ingests the ledgers found in the spool that have not already been
+// ingested in full, removing them from the spool once committed, and returns
+// the number of ledgers ingested.  It does nothing when no SpoolDir is set.
+func (i *System) ReplaySpool() (int, error) {
+	if i.SpoolDir == "" {
+		return 0, nil
+	}
+
+	sp := newSpool(i.SpoolDir)
+	bundles, err := sp.Load()
+	if err != nil || len(bundles) == 0 {
+		return 0, err
+	}
+
+	q := history.Q{Session: i.HorizonDB}
+	var pending []LedgerBundle
+	for _, bundle := range bundles {
+		var ingested bool
+		err = q.GetRaw(&ingested,
+			`SELECT EXISTS(SELECT 1 FROM history_ledgers WHERE sequence = ? AND NOT partial)`,
+			bundle.Sequence,
+		)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to load ledger")
+		}
+
+		if !ingested {
+			pending = append(pending, bundle)
+		}
+	}
+
+	if len(pending) > 0 {
+		is := NewSession(i)
+		is.Cursor = NewCursor(0, 0, i)
+		err = is.IngestBundles(pending)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = sp.Trim(bundles[0].Sequence, bundles[len(bundles)-1].Sequence)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(pending), nil
+}
+
+// CurrentSession returns the session currently ingesting new ledgers, if
+// any.
+func (i *System) CurrentSession() *Session {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	return i.current
+}
+
+// SetCurrentSession sets the session currently ingesting new ledgers.  Tick
+// does not start a session while one is set.
+func (i *System) SetCurrentSession(is *Session) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.current = is
+}
+
+// WithCurrentSession calls `fn` with the session currently ingesting new
+// ledgers, possibly nil, while holding the lock that guards it, so that the
+// session is not replaced, nor a new one started, until `fn` returns.  `fn`
+// must not call the other methods of the system that take the lock, such as
+// CurrentSession or DebugState.
+func (i *System) WithCurrentSession(fn func(*Session)) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	fn(i.current)
+}
+
 // Tick triggers the ingestion system to ingest any new ledger data, provided
 // that there currently is not an import session in progress.
 func (i *System) Tick() *Session {
@@ -211,6 +403,85 @@ func (i *System) Tick() *Session {
 	return is
 }
 
+// sessionPool returns the connection to use in place of `session`, a clone of
+// a horizon database connection, for a session created with NewSession: a
+// clone of the session pool opened from `url` with the system's session
+// connection limits, or `session` itself, which may be nil, when no limits
+// are set.  See SessionMaxOpenConns.
+func (i *System) sessionPool(session *db.Session, url string) *db.Session {
+	if session == nil || (i.SessionMaxOpenConns <= 0 && i.SessionMaxIdleConns <= 0) {
+		return session
+	}
+
+	if url == "" {
+		log.Warn("ingest: session connection limits require a database url, ignoring")
+		return session
+	}
+
+	i.poolLock.Lock()
+	defer i.poolLock.Unlock()
+
+	pool, ok := i.sessionPools[url]
+	if !ok {
+		var err error
+		pool, err = db.Open("postgres", url)
+		if err != nil {
+			log.WithField("err", err).Error("ingest: failed to open session connection pool")
+			return session
+		}
+
+		if i.SessionMaxOpenConns > 0 {
+			pool.DB.SetMaxOpenConns(i.SessionMaxOpenConns)
+		}
+
+		if i.SessionMaxIdleConns > 0 {
+			pool.DB.SetMaxIdleConns(i.SessionMaxIdleConns)
+		}
+
+		if i.sessionPools == nil {
+			i.sessionPools = map[string]*db.Session{}
+		}
+		i.sessionPools[url] = pool
+	}
+
+	return &db.Session{DB: pool.DB, Ctx: session.Ctx}
+}
+
+// ids returns the id scheme used by the system.
+func (i *System) ids() IDScheme {
+	return idSchemeOrDefault(i.IDScheme)
+}
+
+// newIngestion returns a new ingestion that writes to clones of the system's
+// horizon database connections.
+func (i *System) newIngestion() *Ingestion {
+	ingestion := &Ingestion{
+		IngestionOptions: i.IngestionOptions,
+		DB:               i.HorizonDB.Clone(),
+		Metrics:          &i.Metrics,
+	}
+
+	if i.SecondaryHorizonDB != nil {
+		ingestion.SecondaryDB = i.SecondaryHorizonDB.Clone()
+	}
+
+	return ingestion
+}
+
+// replicationLagMonitor returns the monitor sessions use to pause while
+// replication lags, or nil when MaxReplicationLag is not set.
+func (i *System) replicationLagMonitor() *ReplicationLagMonitor {
+	if i.MaxReplicationLag <= 0 {
+		return nil
+	}
+
+	return &ReplicationLagMonitor{
+		DB:     i.HorizonDB.Clone(),
+		MaxLag: i.MaxReplicationLag,
+		Query:  i.ReplicationLagQuery,
+	}
+}
+
 // run causes the importer to check stellar-core to see if we can import new
 // data.
 func (i *System) runOnce() {
@@ -229,12 +500,18 @@ func (i *System) runOnce() {
 	// 3. import until none available
 
 	// 1.
-	i.lock.Lock()
-	is := i.current
-	i.lock.Unlock()
+	is := i.CurrentSession()
 
 	defer func() {
 		i.lock.Lock()
+		if is != nil {
+			var state IngestDebugState
+			is.Ingestion.debug.snapshot(&state)
+			if state.LastCommit.After(i.lastCommit) {
+				i.lastCommit = state.LastCommit
+			}
+			i.lastErr = is.Err
+		}
 		i.current = nil
 		i.lock.Unlock()
 	}()
@@ -249,6 +526,17 @@ func (i *System) runOnce() {
 		return
 	}
 
+	// ledgers spooled while the horizon db was unavailable are ingested first.
+	// The ledger state is refreshed before the next run.
+	replayed, err := i.ReplaySpool()
+	if err != nil {
+		log.Errorf("import spool replay failed: %s", err)
+		return
+	}
+	if replayed > 0 {
+		return
+	}
+
 	if ls.HistoryLatest == ls.CoreLatest {
 		log.Debug("ingest: no new ledgers")
 		return
@@ -275,6 +563,93 @@ func (i *System) runOnce() {
 	return
 }
 
+// rebuildLedgerParticipants replaces the participants of every transaction and
+// operation in ledger `seq`.
+func rebuildLedgerParticipants(ingestion *Ingestion, ids IDScheme, seq int32) error {
+	start, end := ledgerIDRange(ids, seq, seq)
+
+	err := ingestion.deleteRange(start, end, "history_operation_participants", "history_operation_id")
+	if err != nil {
+		return err
+	}
+	err = ingestion.deleteRange(start, end, "history_transaction_participants", "history_transaction_id")
+	if err != nil {
+		return err
+	}
+
+	var txs []history.Transaction
+	sql := sq.Select(
+		"ht.id",
+		"ht.tx_envelope",
+		"COALESCE(htx.tx_meta, ht.tx_meta) AS tx_meta",
+		"COALESCE(htx.tx_fee_meta, ht.tx_fee_meta) AS tx_fee_meta",
+	).
+		From("history_transactions ht").
+		LeftJoin("history_transaction_xdr htx ON htx.history_transaction_id = ht.id").
+		Where("ht.id >= ? AND ht.id < ?", start, end).
+		OrderBy("ht.id ASC")
+	err = ingestion.DB.Select(&txs, sql)
+	if err != nil {
+		return errors.Wrap(err, "failed to load transactions")
+	}
+
+	for _, tx := range txs {
+		var (
+			envelope xdr.TransactionEnvelope
+			meta     xdr.TransactionMeta
+			fee      xdr.LedgerEntryChanges
+		)
+
+		err = unmarshalStoredXDR(tx.TxEnvelope, &envelope)
+		if err != nil {
+			return errors.Wrap(err, "failed to decode envelope")
+		}
+		if tx.TxMeta == "" {
+			return errors.Errorf("meta of transaction %d was not stored", tx.ID)
+		}
+
+		err = unmarshalStoredXDR(tx.TxMeta, &meta)
+		if err != nil {
+			return errors.Wrap(err, "failed to decode meta")
+		}
+		err = unmarshalStoredXDR(tx.TxFeeMeta, &fee)
+		if err != nil {
+			return errors.Wrap(err, "failed to decode fee meta")
+		}
+
+		p, err := participants.ForTransaction(&envelope.Tx, &meta, &fee)
+		if err != nil {
+			return err
+		}
+
+		err = ingestion.TransactionParticipants(tx.ID, p)
+		if err != nil {
+			return err
+		}
+
+		ledger, order, _ := ids.Parse(tx.ID)
+		for index := range envelope.Tx.Operations {
+			p, err := participants.ForOperationWithRoles(&envelope.Tx, &envelope.Tx.Operations[index])
+			if err != nil {
+				return err
+			}
+
+			// operations without a whitelisted participant were not ingested
+			if !ingestion.whitelisted(participants.Accounts(p)) {
+				continue
+			}
+
+			opid := ids.ID(ledger, order, int32(index+1))
+			err = ingestion.OperationParticipants(opid, p)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // trimAbandondedLedgers deletes all "abandonded" ledgers from the history
 // database. An abandonded ledger, in this context, means a ledger known to
 // horizon but is no longer present in the stellar-core database source.  The
@@ -289,17 +664,16 @@ func (i *System) trimAbandondedLedgers() error {
 		return errors.Wrap(err, "load core elder ledger failed")
 	}
 
-	hdb := i.HorizonDB.Clone()
-	ingestion := &Ingestion{DB: hdb}
+	ingestion := i.newIngestion()
 
 	err = ingestion.Start()
 	if err != nil {
 		return errors.Wrap(err, "failed to begin ingestion")
 	}
 
-	end := toid.New(coreElder, 0, 0)
+	end := i.ids().ID(coreElder, 0, 0)
 
-	err = ingestion.Clear(0, end.ToInt64())
+	err = ingestion.Clear(0, end)
 	if err != nil {
 		return errors.Wrap(err, "failed to clear ingestion")
 	}
@@ -1,10 +1,19 @@
 package ingest
 
 import (
+	"context"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stellar/go/network"
+	"github.com/stellar/go/services/horizon/internal/ledger"
 	"github.com/stellar/go/services/horizon/internal/test"
+	testDB "github.com/stellar/go/services/horizon/internal/test/db"
+	"github.com/stellar/go/services/horizon/internal/toid"
+	"github.com/stellar/go/support/log"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestBackfill(t *testing.T) {
@@ -47,6 +56,50 @@ func TestClearAll(t *testing.T) {
 	tt.Assert.Equal(0, found)
 }
 
+func TestRebuildParticipants(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	hq := tt.HorizonSession()
+	count := func(table string) (found int) {
+		err := hq.GetRaw(&found, "SELECT COUNT(*) FROM "+table)
+		tt.Require.NoError(err)
+		return
+	}
+
+	txps := count("history_transaction_participants")
+	ops := count("history_operation_participants")
+	effects := count("history_effects")
+
+	// simulate a participant derivation bug in ledgers 3 through 10
+	_, err := hq.ExecRaw(
+		`DELETE FROM history_transaction_participants WHERE history_transaction_id >= ? AND history_transaction_id < ?`,
+		toid.New(3, 0, 0).ToInt64(),
+		toid.New(11, 0, 0).ToInt64(),
+	)
+	tt.Require.NoError(err)
+	_, err = hq.ExecRaw(`DELETE FROM history_operation_participants WHERE history_operation_id >= ?`,
+		toid.New(3, 0, 0).ToInt64(),
+	)
+	tt.Require.NoError(err)
+	tt.Require.True(count("history_transaction_participants") < txps)
+
+	err = sys(tt).RebuildParticipants(3, 10)
+	tt.Require.NoError(err)
+
+	// participants from ledgers after the rebuilt range remain missing
+	tt.Assert.Equal(txps, count("history_transaction_participants"))
+	tt.Assert.True(count("history_operation_participants") < ops)
+	tt.Assert.Equal(effects, count("history_effects"))
+
+	err = sys(tt).RebuildParticipants(11, ledger.CurrentState().CoreLatest)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(ops, count("history_operation_participants"))
+}
+
 func TestValidation(t *testing.T) {
 	tt := test.Start(t).Scenario("kahuna")
 	defer tt.Finish()
@@ -104,3 +157,300 @@ func TestValidation(t *testing.T) {
 		tt.Assert.Contains(err.Error(), "cur and prev ledger hashes don't match")
 	}
 }
+
+func TestReingestHistoricalRange(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	is := sys(tt)
+
+	// nothing is behind the head of an empty history db
+	_, err := is.ReingestHistoricalRange(2, 5)
+	tt.Assert.Error(err)
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+	latest := ledger.CurrentState().CoreLatest
+
+	_, err = is.ReingestHistoricalRange(5, 2)
+	tt.Assert.Error(err)
+
+	_, err = is.ReingestHistoricalRange(latest-1, latest+1)
+	tt.Assert.Error(err)
+
+	var before int
+	err = tt.HorizonSession().GetRaw(&before, "SELECT COUNT(*) FROM history_effects")
+	tt.Require.NoError(err)
+
+	ingested, err := is.ReingestHistoricalRange(2, latest)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(int(latest-1), ingested)
+
+	var after int
+	err = tt.HorizonSession().GetRaw(&after, "SELECT COUNT(*) FROM history_effects")
+	tt.Require.NoError(err)
+	tt.Assert.Equal(before, after)
+}
+
+func TestCreateAccountID_Concurrent(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	var aid xdr.AccountId
+	tt.Require.NoError(aid.SetAddress("GAXMF43TGZHW3QN3REOUA2U5PW5BTARXGGYJ3JIFHW3YT6QRKRL3CPPU"))
+
+	live := &Ingestion{DB: tt.HorizonSession().Clone()}
+	historical := &Ingestion{DB: tt.HorizonSession().Clone()}
+	tt.Require.NoError(live.Start())
+	tt.Require.NoError(historical.Start())
+	defer historical.Rollback()
+
+	liveID, err := live.getCreateAccountID(aid)
+	tt.Require.NoError(err)
+
+	// the historical session blocks on the live session's uncommitted account
+	// and, once it is committed, reuses its id without aborting
+	done := make(chan int64)
+	go func() {
+		id, err := historical.getCreateAccountID(aid)
+		tt.Assert.NoError(err)
+		done <- id
+	}()
+
+	tt.Require.NoError(live.Close())
+	tt.Assert.Equal(liveID, <-done)
+
+	_, err = historical.DB.ExecRaw(`SELECT 1`)
+	tt.Assert.NoError(err, "transaction should remain usable")
+}
+
+func TestReingestRangeDescending(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	is := sys(tt)
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+	latest := ledger.CurrentState().CoreLatest
+
+	_, err := is.ReingestRangeDescending(5, 2)
+	tt.Assert.Error(err)
+
+	before := historyDigest(tt)
+
+	ingested, err := is.ReingestRangeDescending(1, latest)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(int(latest), ingested)
+	tt.Assert.Equal(before, historyDigest(tt))
+}
+
+func TestBulkReingest(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	is := sys(tt)
+	is.BulkReingestDropIndexes = true
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+	latest := ledger.CurrentState().CoreLatest
+
+	type index struct {
+		Name       string `db:"indexname"`
+		Definition string `db:"indexdef"`
+	}
+	indexes := func() (ret []index) {
+		err := tt.HorizonSession().SelectRaw(&ret, `
+			SELECT indexname, indexdef FROM pg_indexes
+			WHERE schemaname = current_schema()
+			ORDER BY indexname`)
+		tt.Require.NoError(err)
+		return
+	}
+	count := func(table string) (n int) {
+		tt.Require.NoError(tt.HorizonSession().GetRaw(&n, "SELECT COUNT(*) FROM "+table))
+		return
+	}
+
+	before := indexes()
+	effects := count("history_effects")
+	participants := count("history_operation_participants")
+
+	// kahuna loads tables whose indexes are dropped
+	dropped, err := is.bulkIndexes()
+	tt.Require.NoError(err)
+	tt.Require.NotEmpty(dropped)
+
+	ingested, err := is.BulkReingest(2, latest)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(int(latest-1), ingested)
+
+	tt.Assert.Equal(before, indexes())
+	tt.Assert.Equal(effects, count("history_effects"))
+	tt.Assert.Equal(participants, count("history_operation_participants"))
+}
+
+func TestBulkReingest_Panic(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	is := sys(tt)
+	is.BulkReingestDropIndexes = true
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+	latest := ledger.CurrentState().CoreLatest
+
+	indexes := func() (ret []string) {
+		err := tt.HorizonSession().SelectRaw(&ret, `
+			SELECT indexdef FROM pg_indexes
+			WHERE schemaname = current_schema()
+			ORDER BY indexname`)
+		tt.Require.NoError(err)
+		return
+	}
+	before := indexes()
+
+	is.RowTransformers = []RowTransformer{
+		func(row Row) (Row, bool) {
+			if row.Table == "history_effects" {
+				panic("transformer panicked")
+			}
+			return row, true
+		},
+	}
+
+	tt.Assert.Panics(func() {
+		is.BulkReingest(2, latest)
+	})
+	tt.Assert.Equal(before, indexes())
+}
+
+func TestReingestRange_AnalyzeAfterReingest(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	is := sys(tt)
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+	latest := ledger.CurrentState().CoreLatest
+
+	// analyzed runs `fn`, returning the number of times each table was
+	// analyzed, as logged by the horizon db's session.
+	analyzed := func(fn func() error) map[string]int {
+		logger := log.New()
+		done := logger.StartTest(log.DebugLevel)
+		ctx := is.HorizonDB.Ctx
+		is.HorizonDB.Ctx = log.Set(context.Background(), logger)
+		defer func() { is.HorizonDB.Ctx = ctx }()
+
+		tt.Require.NoError(fn())
+
+		counts := map[string]int{}
+		for _, entry := range done() {
+			sql, ok := entry.Data["sql"].(string)
+			if ok && strings.HasPrefix(sql, "ANALYZE ") {
+				counts[strings.TrimPrefix(sql, "ANALYZE ")]++
+			}
+		}
+		return counts
+	}
+
+	reingest := func() error {
+		_, err := is.ReingestRange(1, latest)
+		return err
+	}
+
+	tt.Assert.Empty(analyzed(reingest))
+
+	is.AnalyzeAfterReingest = true
+	counts := analyzed(reingest)
+	tt.Assert.Len(counts, len(analyzeTables))
+	for _, table := range analyzeTables {
+		tt.Assert.Equal(1, counts[table], table)
+	}
+
+	// single ledgers are not followed by an analyze
+	tt.Assert.Empty(analyzed(func() error {
+		return is.ReingestSingle(latest)
+	}))
+}
+
+// TestCurrentSession is meaningful when run with the race detector.
+func TestCurrentSession(t *testing.T) {
+	sys := &System{}
+	assert.Nil(t, sys.CurrentSession())
+
+	sessions := []*Session{
+		{Ingestion: &Ingestion{}},
+		{Ingestion: &Ingestion{}},
+	}
+
+	var wg sync.WaitGroup
+	for n := 0; n < 8; n++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				switch (n + j) % 4 {
+				case 0:
+					sys.SetCurrentSession(sessions[j%2])
+				case 1:
+					sys.CurrentSession()
+				case 2:
+					sys.WithCurrentSession(func(is *Session) {
+						if is != nil {
+							is.Ingested++
+						}
+					})
+				case 3:
+					sys.DebugState()
+				}
+			}
+		}(n)
+	}
+	wg.Wait()
+
+	sys.SetCurrentSession(sessions[0])
+	sys.WithCurrentSession(func(is *Session) {
+		assert.Equal(t, sessions[0], is)
+	})
+	assert.True(t, sys.DebugState().Running)
+
+	sys.SetCurrentSession(nil)
+	assert.Nil(t, sys.CurrentSession())
+}
+
+func TestNewSession_PoolLimits(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+	sys := sys(tt)
+	sys.HorizonDBURL = testDB.HorizonURL()
+
+	// without limits, sessions use the pool of HorizonDB
+	s := NewSession(sys)
+	tt.Assert.True(s.Ingestion.DB.DB == sys.HorizonDB.DB)
+
+	sys.SessionMaxOpenConns = 2
+	sys.SessionMaxIdleConns = 1
+	a := NewSession(sys)
+	b := NewSession(sys)
+	pool := a.Ingestion.DB.DB
+	defer pool.Close()
+
+	// the limits apply to a pool of the sessions' own, shared between them,
+	// never to the pool of HorizonDB
+	tt.Assert.False(pool == sys.HorizonDB.DB)
+	tt.Assert.True(pool == b.Ingestion.DB.DB)
+
+	a.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	a.Run()
+	tt.Require.NoError(a.Err)
+	tt.Assert.True(pool.Stats().OpenConnections <= 2)
+
+	var ledgers int
+	tt.Require.NoError(tt.HorizonSession().GetRaw(&ledgers, `SELECT COUNT(*) FROM history_ledgers`))
+	tt.Assert.Equal(int(ledger.CurrentState().CoreLatest), ledgers)
+
+	// without a url, the limits are not applied
+	sys.HorizonDBURL = ""
+	s = NewSession(sys)
+	tt.Assert.True(s.Ingestion.DB.DB == sys.HorizonDB.DB)
+}
@@ -0,0 +1,33 @@
+package ingest
+
+import (
+	"time"
+)
+
+// now returns the current time, from the ingestion's Clock if set, in the
+// ingestion's TimeLocation.
+func (ingest *Ingestion) now() time.Time {
+	now := time.Now
+	if ingest.Clock != nil {
+		now = ingest.Clock
+	}
+
+	return ingest.timestamp(now())
+}
+
+// closeTime returns the time represented by `unix`, a close time in seconds
+// since the epoch as recorded by stellar-core, in the ingestion's
+// TimeLocation.
+func (ingest *Ingestion) closeTime(unix int64) time.Time {
+	return ingest.timestamp(time.Unix(unix, 0))
+}
+
+// timestamp returns `t` in the ingestion's TimeLocation, or in UTC if none is
+// set.
+func (ingest *Ingestion) timestamp(t time.Time) time.Time {
+	if ingest.TimeLocation == nil {
+		return t.UTC()
+	}
+
+	return t.In(ingest.TimeLocation)
+}
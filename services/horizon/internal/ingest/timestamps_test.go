@@ -0,0 +1,53 @@
+package ingest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/test"
+)
+
+func TestIngest_TimeLocation(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	loc := time.FixedZone("UTC+5", 5*60*60)
+	now := time.Date(2018, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	sys := sys(tt)
+	sys.Clock = func() time.Time { return now }
+	sys.TimeLocation = loc
+	s := NewSession(sys)
+	s.Cursor = NewCursor(1, ledger.CurrentState().CoreLatest, sys)
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	// timestamps are stored without a time zone, so are read back as the wall
+	// clock time of the location they were written in
+	wall := func(t time.Time) time.Time {
+		t = t.In(loc)
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+	}
+
+	var closeTime int64
+	tt.Require.NoError(tt.CoreSession().GetRaw(&closeTime, `
+		SELECT closetime FROM ledgerheaders WHERE ledgerseq = 3
+	`))
+
+	var ledgerRow struct {
+		ClosedAt  time.Time `db:"closed_at"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	tt.Require.NoError(tt.HorizonSession().GetRaw(&ledgerRow, `
+		SELECT closed_at, created_at FROM history_ledgers WHERE sequence = 3
+	`))
+	tt.Assert.True(wall(time.Unix(closeTime, 0)).Equal(ledgerRow.ClosedAt), "closed_at: %s", ledgerRow.ClosedAt)
+	tt.Assert.True(wall(now).Equal(ledgerRow.CreatedAt), "created_at: %s", ledgerRow.CreatedAt)
+
+	var txCreatedAt time.Time
+	tt.Require.NoError(tt.HorizonSession().GetRaw(&txCreatedAt, `
+		SELECT created_at FROM history_transactions WHERE ledger_sequence = 3 LIMIT 1
+	`))
+	tt.Assert.True(wall(now).Equal(txCreatedAt), "transaction created_at: %s", txCreatedAt)
+}
@@ -0,0 +1,201 @@
+package ingest
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/clients/stellartoml"
+	"github.com/stellar/go/services/horizon/internal/log"
+	"github.com/stellar/go/support/errors"
+)
+
+const (
+	// DefaultTomlTimeout is the default hard limit on the time taken to fetch a
+	// single stellar.toml file.
+	DefaultTomlTimeout = 10 * time.Second
+
+	// DefaultTomlRetryDelay is the default delay before a failed fetch is
+	// retried under the TomlFailureRetry policy.
+	DefaultTomlRetryDelay = 5 * time.Minute
+)
+
+// deferredToml is a failed fetch waiting to be retried.
+type deferredToml struct {
+	url     string
+	retryAt time.Time
+}
+
+// Queue schedules the stellar.toml file at `url` to be fetched for the asset
+// identified by `id`.  Queue does not fetch anything itself; see Start.
+func (f *TomlFetcher) Queue(id int64, url string) {
+	if url == "" {
+		return
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.pending == nil {
+		f.pending = map[int64]string{}
+	}
+
+	f.pending[id] = url
+	delete(f.deferred, id)
+}
+
+// Start begins fetching the queued toml files, along with any deferred fetches
+// that are due for a retry, in the background.  Start never blocks: if a
+// previous batch is still being fetched, the queued files are left for a later
+// call to pick up.
+func (f *TomlFetcher) Start() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.running {
+		return
+	}
+
+	now := time.Now()
+	for id, d := range f.deferred {
+		if now.Before(d.retryAt) {
+			continue
+		}
+
+		if f.pending == nil {
+			f.pending = map[int64]string{}
+		}
+		f.pending[id] = d.url
+		delete(f.deferred, id)
+	}
+
+	if len(f.pending) == 0 {
+		return
+	}
+
+	batch := f.pending
+	f.pending = nil
+	f.running = true
+
+	go f.run(batch)
+}
+
+// fetch loads the toml file at `url`, failing if the fetch does not complete
+// within the fetcher's timeout.
+func (f *TomlFetcher) fetch(url string) (string, error) {
+	timeout := f.Timeout
+	if timeout == 0 {
+		timeout = DefaultTomlTimeout
+	}
+
+	client := f.HTTP
+	if client == nil {
+		client = &http.Client{Timeout: timeout}
+	}
+
+	type result struct {
+		body string
+		err  error
+	}
+
+	// buffered so that a fetch that outlives the timeout does not leak its
+	// goroutine forever
+	done := make(chan result, 1)
+
+	go func() {
+		resp, err := client.Get(url)
+		if err != nil {
+			done <- result{err: errors.Wrap(err, "http request errored")}
+			return
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			done <- result{err: errors.Errorf("http request failed with non-200 status code: %d", resp.StatusCode)}
+			return
+		}
+
+		body, err := ioutil.ReadAll(io.LimitReader(resp.Body, stellartoml.StellarTomlMaxSize+1))
+		if err != nil {
+			done <- result{err: errors.Wrap(err, "failed to read response body")}
+			return
+		}
+
+		if len(body) > stellartoml.StellarTomlMaxSize {
+			done <- result{err: errors.Errorf("stellar.toml is larger than %d bytes", stellartoml.StellarTomlMaxSize)}
+			return
+		}
+
+		done <- result{body: string(body)}
+	}()
+
+	select {
+	case r := <-done:
+		return r.body, r.err
+	case <-time.After(timeout):
+		return "", errors.Errorf("timed out after %s", timeout)
+	}
+}
+
+// resolve fetches the toml file for an asset and returns the statement that
+// records the outcome, or nil when the outcome is a deferred retry.
+func (f *TomlFetcher) resolve(id int64, url string) sq.Sqlizer {
+	update := sq.Update("asset_stats").Where(sq.Eq{"id": id})
+
+	body, err := f.fetch(url)
+	if err == nil {
+		return update.Set("toml_content", body).Set("toml_error", false)
+	}
+
+	log.WithField("url", url).WithField("err", err).Warn("ingest: failed to fetch stellar.toml")
+
+	switch f.FailurePolicy {
+	case TomlFailureRetry:
+		delay := f.RetryDelay
+		if delay == 0 {
+			delay = DefaultTomlRetryDelay
+		}
+
+		f.lock.Lock()
+		defer f.lock.Unlock()
+
+		// a newer request for the asset supersedes the retry
+		if _, ok := f.pending[id]; ok {
+			return nil
+		}
+
+		if f.deferred == nil {
+			f.deferred = map[int64]deferredToml{}
+		}
+		f.deferred[id] = deferredToml{url: url, retryAt: time.Now().Add(delay)}
+		return nil
+	case TomlFailureFlag:
+		return update.Set("toml_content", nil).Set("toml_error", true)
+	default:
+		return update.Set("toml_content", nil).Set("toml_error", false)
+	}
+}
+
+// run fetches a batch of toml files, recording each outcome directly (outside
+// of any transaction) into the horizon db.
+func (f *TomlFetcher) run(batch map[int64]string) {
+	defer func() {
+		f.lock.Lock()
+		f.running = false
+		f.lock.Unlock()
+	}()
+
+	for id, url := range batch {
+		stmt := f.resolve(id, url)
+		if stmt == nil {
+			continue
+		}
+
+		_, err := f.DB.Exec(stmt)
+		if err != nil {
+			log.WithField("asset_id", id).WithField("err", err).Warn("ingest: failed to store stellar.toml")
+		}
+	}
+}
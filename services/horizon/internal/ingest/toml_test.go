@@ -0,0 +1,100 @@
+package ingest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stellar/go/clients/stellartoml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingHTTP is an http client whose requests never complete.
+type blockingHTTP struct{}
+
+func (blockingHTTP) Get(url string) (*http.Response, error) {
+	select {}
+}
+
+const testTomlURL = "https://example.com/.well-known/stellar.toml"
+
+func TestTomlFetcher(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `FEDERATION_SERVER="https://example.com/federation"`)
+	}))
+	defer server.Close()
+
+	f := &TomlFetcher{}
+	stmt := f.resolve(1, server.URL)
+	require.NotNil(t, stmt)
+
+	sql, args, err := stmt.ToSql()
+	require.NoError(t, err)
+	assert.Equal(t, "UPDATE asset_stats SET toml_content = ?, toml_error = ? WHERE id = ?", sql)
+	assert.Equal(t, []interface{}{`FEDERATION_SERVER="https://example.com/federation"`, false, int64(1)}, args)
+}
+
+func TestTomlFetcher_Failures(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	cases := []struct {
+		name   string
+		url    string
+		http   stellartoml.HTTP
+		policy TomlFailurePolicy
+		args   []interface{}
+	}{
+		{"not found, store null", server.URL, nil, TomlFailureStoreNull, []interface{}{nil, false, int64(1)}},
+		{"not found, flag", server.URL, nil, TomlFailureFlag, []interface{}{nil, true, int64(1)}},
+		{"timeout, store null", testTomlURL, blockingHTTP{}, TomlFailureStoreNull, []interface{}{nil, false, int64(1)}},
+		{"timeout, flag", testTomlURL, blockingHTTP{}, TomlFailureFlag, []interface{}{nil, true, int64(1)}},
+	}
+
+	for _, kase := range cases {
+		f := &TomlFetcher{
+			HTTP:          kase.http,
+			Timeout:       10 * time.Millisecond,
+			FailurePolicy: kase.policy,
+		}
+
+		start := time.Now()
+		stmt := f.resolve(1, kase.url)
+		assert.True(t, time.Since(start) < time.Second, "%s: fetch was not cut short", kase.name)
+
+		if !assert.NotNil(t, stmt, kase.name) {
+			continue
+		}
+
+		_, args, err := stmt.ToSql()
+		require.NoError(t, err)
+		assert.Equal(t, kase.args, args, kase.name)
+	}
+}
+
+func TestTomlFetcher_Retry(t *testing.T) {
+	f := &TomlFetcher{
+		HTTP:          blockingHTTP{},
+		Timeout:       10 * time.Millisecond,
+		FailurePolicy: TomlFailureRetry,
+		RetryDelay:    time.Hour,
+	}
+
+	stmt := f.resolve(1, testTomlURL)
+	assert.Nil(t, stmt)
+	require.Contains(t, f.deferred, int64(1))
+	assert.Equal(t, testTomlURL, f.deferred[1].url)
+
+	// retries that are not yet due are left deferred
+	f.Start()
+	assert.False(t, f.running)
+	assert.Contains(t, f.deferred, int64(1))
+
+	// a newer request replaces the deferred retry
+	f.Queue(1, testTomlURL)
+	assert.NotContains(t, f.deferred, int64(1))
+	assert.Equal(t, testTomlURL, f.pending[1])
+}
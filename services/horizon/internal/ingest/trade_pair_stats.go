@@ -0,0 +1,176 @@
+package ingest
+
+import (
+	"math/big"
+	"sort"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// tradePair identifies an asset pair by the history ids of its assets, in the
+// canonical order used by history_trades.
+type tradePair struct {
+	Base    int64
+	Counter int64
+}
+
+// tradePairStat is the trade count and volume of an asset pair accumulated by
+// the current transaction.  Volumes are kept as big ints, as the sum of many
+// trades may overflow an int64.
+type tradePairStat struct {
+	Count         int64
+	BaseVolume    big.Int
+	CounterVolume big.Int
+}
+
+// addTradePairStat accumulates `trade` into the stats of its asset pair,
+// provided TrackTradePairStats is set.  The stats are written when the
+// ingestion's transaction is committed.
+func (ingest *Ingestion) addTradePairStat(trade xdr.ClaimOfferAtom) error {
+	if !ingest.TrackTradePairStats {
+		return nil
+	}
+
+	soldAssetID, err := ingest.getCreateAssetID(trade.AssetSold)
+	if err != nil {
+		return errors.Wrap(err, "failed to get sold asset id")
+	}
+
+	boughtAssetID, err := ingest.getCreateAssetID(trade.AssetBought)
+	if err != nil {
+		return errors.Wrap(err, "failed to get bought asset id")
+	}
+
+	pair := tradePair{Base: soldAssetID, Counter: boughtAssetID}
+	baseAmount, counterAmount := trade.AmountSold, trade.AmountBought
+	if soldAssetID > boughtAssetID {
+		pair = tradePair{Base: boughtAssetID, Counter: soldAssetID}
+		baseAmount, counterAmount = trade.AmountBought, trade.AmountSold
+	}
+
+	if ingest.tradePairStats == nil {
+		ingest.tradePairStats = map[tradePair]*tradePairStat{}
+	}
+
+	stat, ok := ingest.tradePairStats[pair]
+	if !ok {
+		stat = &tradePairStat{}
+		ingest.tradePairStats[pair] = stat
+	}
+
+	stat.Count++
+	stat.BaseVolume.Add(&stat.BaseVolume, big.NewInt(int64(baseAmount)))
+	stat.CounterVolume.Add(&stat.CounterVolume, big.NewInt(int64(counterAmount)))
+	return nil
+}
+
+// writeTradePairStats adds the stats accumulated by the current transaction
+// to history_trade_pair_stats, within the transaction, so that the stats are
+// committed along with the trades they count.  The table is locked against
+// concurrent writers for the remainder of the transaction, as the stats of a
+// pair may be created by several sessions at once.
+func (ingest *Ingestion) writeTradePairStats() error {
+	if len(ingest.tradePairStats) == 0 {
+		return nil
+	}
+
+	pairs := make([]tradePair, 0, len(ingest.tradePairStats))
+	for pair := range ingest.tradePairStats {
+		pairs = append(pairs, pair)
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Base != pairs[j].Base {
+			return pairs[i].Base < pairs[j].Base
+		}
+		return pairs[i].Counter < pairs[j].Counter
+	})
+
+	write := func(s *db.Session) error {
+		_, err := s.ExecRaw(`LOCK TABLE history_trade_pair_stats IN SHARE ROW EXCLUSIVE MODE`)
+		if err != nil {
+			return err
+		}
+
+		for _, pair := range pairs {
+			err = upsertTradePairStat(s, pair, ingest.tradePairStats[pair])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err := write(ingest.DB)
+	if err != nil {
+		return errors.Wrap(err, "failed to write trade pair stats")
+	}
+
+	err = ingest.secondary(write)
+	if err != nil {
+		return err
+	}
+
+	ingest.tradePairStats = nil
+	return nil
+}
+
+// upsertTradePairStat adds `stat` to the row of `pair`, creating it if needed.
+func upsertTradePairStat(s *db.Session, pair tradePair, stat *tradePairStat) error {
+	res, err := s.ExecRaw(`
+		UPDATE history_trade_pair_stats SET
+			trade_count = trade_count + ?,
+			base_volume = base_volume + ?::numeric,
+			counter_volume = counter_volume + ?::numeric
+		WHERE base_asset_id = ? AND counter_asset_id = ?`,
+		stat.Count, stat.BaseVolume.String(), stat.CounterVolume.String(),
+		pair.Base, pair.Counter,
+	)
+	if err != nil {
+		return err
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil || updated > 0 {
+		return err
+	}
+
+	_, err = s.ExecRaw(`
+		INSERT INTO history_trade_pair_stats
+			(base_asset_id, counter_asset_id, trade_count, base_volume, counter_volume)
+		VALUES (?, ?, ?, ?::numeric, ?::numeric)`,
+		pair.Base, pair.Counter,
+		stat.Count, stat.BaseVolume.String(), stat.CounterVolume.String(),
+	)
+	return err
+}
+
+// clearTradePairStats subtracts the trades of the operations with ids in
+// [start, end) from history_trade_pair_stats, ahead of the trades being
+// cleared, so that reingesting them does not count them twice.
+func (ingest *Ingestion) clearTradePairStats(start, end int64) error {
+	if !ingest.TrackTradePairStats {
+		return nil
+	}
+
+	return ingest.exec(sq.Expr(`
+		UPDATE history_trade_pair_stats s SET
+			trade_count = s.trade_count - t.trade_count,
+			base_volume = s.base_volume - t.base_volume,
+			counter_volume = s.counter_volume - t.counter_volume
+		FROM (
+			SELECT base_asset_id, counter_asset_id,
+				COUNT(*) AS trade_count,
+				SUM(base_amount) AS base_volume,
+				SUM(counter_amount) AS counter_volume
+			FROM history_trades
+			WHERE history_operation_id >= ? AND history_operation_id < ?
+			GROUP BY base_asset_id, counter_asset_id
+		) t
+		WHERE s.base_asset_id = t.base_asset_id
+		AND s.counter_asset_id = t.counter_asset_id`,
+		start, end,
+	))
+}
@@ -0,0 +1,76 @@
+package ingest
+
+import (
+	"encoding/base64"
+
+	"github.com/guregu/null"
+	"github.com/stellar/go/services/horizon/internal/db2/core"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/xdr"
+)
+
+// UpgradeProposals adds the upgrades carried by the scp value of `header`, the
+// header of the ledger identified by `id`, to the current ingestion.  See
+// IngestUpgradeProposals.
+func (ingest *Ingestion) UpgradeProposals(id int64, header *core.LedgerHeader) error {
+	for _, proposal := range upgradeProposals(id, header) {
+		err := ingest.insertRow(ingest.upgradeProposals, "history_upgrade_proposals",
+			proposal.ID,
+			proposal.LedgerSequence,
+			proposal.Order,
+			proposal.Type,
+			proposal.Value,
+			proposal.UpgradeXDR,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// upgradeProposals decodes the upgrades carried by the scp value of `header`,
+// in order.  Upgrades whose xdr cannot be decoded, such as those introduced by
+// a later protocol, have no type or value.
+func upgradeProposals(id int64, header *core.LedgerHeader) []history.UpgradeProposal {
+	upgrades := header.Data.ScpValue.Upgrades
+	proposals := make([]history.UpgradeProposal, 0, len(upgrades))
+
+	for i, raw := range upgrades {
+		proposal := history.UpgradeProposal{
+			TotalOrderID:   history.TotalOrderID{ID: id},
+			LedgerSequence: int32(header.Sequence),
+			Order:          int32(i),
+			UpgradeXDR:     base64.StdEncoding.EncodeToString(raw),
+		}
+
+		var upgrade xdr.LedgerUpgrade
+		if xdr.SafeUnmarshal(raw, &upgrade) == nil {
+			proposal.Type = null.IntFrom(int64(upgrade.Type))
+			proposal.Value = upgradeValue(upgrade)
+		}
+
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals
+}
+
+// upgradeValue returns the value `upgrade` sets.
+func upgradeValue(upgrade xdr.LedgerUpgrade) null.Int {
+	var value *xdr.Uint32
+	switch upgrade.Type {
+	case xdr.LedgerUpgradeTypeLedgerUpgradeVersion:
+		value = upgrade.NewLedgerVersion
+	case xdr.LedgerUpgradeTypeLedgerUpgradeBaseFee:
+		value = upgrade.NewBaseFee
+	case xdr.LedgerUpgradeTypeLedgerUpgradeMaxTxSetSize:
+		value = upgrade.NewMaxTxSetSize
+	}
+
+	if value == nil {
+		return null.Int{}
+	}
+	return null.IntFrom(int64(*value))
+}
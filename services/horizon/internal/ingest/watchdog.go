@@ -0,0 +1,86 @@
+package ingest
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/log"
+	"github.com/stellar/go/support/errors"
+)
+
+// ErrTransactionTimeout is returned, wrapped, when an ingestion's transaction
+// was aborted for remaining open longer than MaxTransactionDuration.  See
+// Ingestion.MaxTransactionDuration.
+var ErrTransactionTimeout = errors.New("ingest: transaction exceeded max duration")
+
+// txWatchdog cancels the statements of a transaction that has remained open
+// for too long.
+type txWatchdog struct {
+	timer *time.Timer
+	fired int32
+}
+
+// startWatchdog starts the watchdog for the transaction just opened on DB,
+// provided MaxTransactionDuration is set.  When it fires, the statement
+// running on the transaction's connection, if any, is cancelled from a new
+// connection: the version of lib/pq in use does not support cancellation
+// through a context.
+func (ingest *Ingestion) startWatchdog() error {
+	ingest.watchdog = nil
+	if ingest.MaxTransactionDuration <= 0 {
+		return nil
+	}
+
+	var pid int64
+	err := ingest.DB.GetRaw(&pid, "SELECT pg_backend_pid()")
+	if err != nil {
+		return errors.Wrap(err, "failed to load backend pid")
+	}
+
+	wd := &txWatchdog{}
+	conn := ingest.DB.Clone()
+	wd.timer = time.AfterFunc(ingest.MaxTransactionDuration, func() {
+		atomic.StoreInt32(&wd.fired, 1)
+
+		log.
+			WithField("pid", pid).
+			WithField("max", ingest.MaxTransactionDuration.String()).
+			Warn("ingest: transaction exceeded max duration, cancelling")
+
+		_, err := conn.ExecRaw("SELECT pg_cancel_backend(?)", pid)
+		if err != nil {
+			log.WithField("err", err).Error("ingest: failed to cancel transaction")
+		}
+	})
+
+	ingest.watchdog = wd
+	return nil
+}
+
+// stopWatchdog stops the watchdog of the current transaction, if any.
+func (ingest *Ingestion) stopWatchdog() {
+	if ingest.watchdog != nil {
+		ingest.watchdog.timer.Stop()
+	}
+}
+
+// timedOut returns true if the watchdog of the current transaction has fired.
+func (ingest *Ingestion) timedOut() bool {
+	return ingest.watchdog != nil && atomic.LoadInt32(&ingest.watchdog.fired) == 1
+}
+
+// abortTimedOut rolls back the current transaction and returns
+// ErrTransactionTimeout if the watchdog has fired, wrapping `err`, the error
+// of the statement it cancelled, if any.  Otherwise `err` is returned as is.
+func (ingest *Ingestion) abortTimedOut(err error) error {
+	if !ingest.timedOut() {
+		return err
+	}
+
+	ingest.Rollback()
+
+	if err == nil {
+		return ErrTransactionTimeout
+	}
+	return errors.Wrap(ErrTransactionTimeout, err.Error())
+}
@@ -0,0 +1,46 @@
+package ingest
+
+import (
+	"testing"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/support/errors"
+)
+
+func TestMaxTransactionDuration(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	ingestion := &Ingestion{
+		DB:               tt.HorizonSession(),
+		IngestionOptions: IngestionOptions{MaxTransactionDuration: 100 * time.Millisecond},
+	}
+
+	// a slow statement is cancelled once the transaction is too old
+	tt.Require.NoError(ingestion.Start())
+	start := time.Now()
+	err := ingestion.exec(sq.Expr("SELECT pg_sleep(5)"))
+	tt.Assert.Equal(ErrTransactionTimeout, errors.Cause(err))
+	tt.Assert.True(time.Since(start) < 5*time.Second)
+
+	// a transaction stalled between statements fails on commit
+	tt.Require.NoError(ingestion.Start())
+	time.Sleep(200 * time.Millisecond)
+	err = ingestion.Close()
+	tt.Assert.Equal(ErrTransactionTimeout, errors.Cause(err))
+
+	// transactions completing in time are unaffected
+	tt.Require.NoError(ingestion.Start())
+	tt.Require.NoError(ingestion.exec(sq.Expr("SELECT 1")))
+	tt.Require.NoError(ingestion.Close())
+	time.Sleep(200 * time.Millisecond)
+	tt.Assert.False(ingestion.timedOut())
+
+	// zero disables the watchdog
+	ingestion.MaxTransactionDuration = 0
+	tt.Require.NoError(ingestion.Start())
+	tt.Assert.Nil(ingestion.watchdog)
+	tt.Require.NoError(ingestion.Rollback())
+}
@@ -0,0 +1,45 @@
+package ingest
+
+import (
+	"github.com/stellar/go/services/horizon/internal/ingest/participants"
+	"github.com/stellar/go/xdr"
+)
+
+// whitelisted returns true if at least one of `aids` is in the ingestion's
+// AccountWhitelist, or if no whitelist is configured.
+func (ingest *Ingestion) whitelisted(aids []xdr.AccountId) bool {
+	if len(ingest.AccountWhitelist) == 0 {
+		return true
+	}
+
+	for _, aid := range aids {
+		if ingest.AccountWhitelist[aid.Address()] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// transactionWhitelisted returns true if at least one operation of the
+// current transaction has a whitelisted participant.  See
+// Ingestion.AccountWhitelist.
+func (is *Session) transactionWhitelisted() (bool, error) {
+	if len(is.Ingestion.AccountWhitelist) == 0 {
+		return true, nil
+	}
+
+	tx := &is.Cursor.Transaction().Envelope.Tx
+	for i := range tx.Operations {
+		p, err := participants.ForOperation(tx, &tx.Operations[i])
+		if err != nil {
+			return false, err
+		}
+
+		if is.Ingestion.whitelisted(p) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,109 @@
+package ingest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io/ioutil"
+	"strings"
+
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// CompressedXDRPrefix marks a stored xdr column value as compressed.  The
+// prefix is followed by the base64 encoding of the gzip compressed xdr.  As
+// the prefix is not valid base64, compressed values can always be told apart
+// from plain base64 xdr.
+const CompressedXDRPrefix = "gzip:"
+
+// CompressXDR compresses the base64 encoded xdr `b64`, returning the value to
+// be stored in its place.  Empty values are returned unchanged.
+func CompressXDR(b64 string) (string, error) {
+	if b64 == "" {
+		return "", nil
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decode xdr")
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err = w.Write(raw)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to compress xdr")
+	}
+
+	err = w.Close()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to compress xdr")
+	}
+
+	return CompressedXDRPrefix + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// DecompressXDR returns the base64 encoded xdr for a stored xdr column value,
+// which may or may not have been compressed by CompressXDR.
+func DecompressXDR(stored string) (string, error) {
+	if !strings.HasPrefix(stored, CompressedXDRPrefix) {
+		return stored, nil
+	}
+
+	compressed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(stored, CompressedXDRPrefix))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decode compressed xdr")
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decompress xdr")
+	}
+	defer r.Close()
+
+	raw, err := ioutil.ReadAll(r)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decompress xdr")
+	}
+
+	return base64.StdEncoding.EncodeToString(raw), nil
+}
+
+// unmarshalStoredXDR decodes a stored xdr column value, compressed or not, into
+// `dest`.
+func unmarshalStoredXDR(stored string, dest interface{}) error {
+	b64, err := DecompressXDR(stored)
+	if err != nil {
+		return err
+	}
+
+	return xdr.SafeUnmarshalBase64(b64, dest)
+}
+
+// storedXDR returns the value to store for the base64 encoded xdr `b64`,
+// compressing it when CompressXDR is enabled.  A failure to compress is
+// returned rather than storing the xdr uncompressed, so that a column never
+// holds both formats.
+func (ingest *Ingestion) storedXDR(b64 string) (string, error) {
+	if !ingest.CompressXDR {
+		return b64, nil
+	}
+
+	return CompressXDR(b64)
+}
+
+// storedXDRs applies storedXDR to each of `b64s`, returning the values to
+// store in the same order.
+func (ingest *Ingestion) storedXDRs(b64s ...string) ([]string, error) {
+	stored := make([]string, len(b64s))
+	for i, b64 := range b64s {
+		var err error
+		stored[i], err = ingest.storedXDR(b64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stored, nil
+}
@@ -0,0 +1,57 @@
+package ingest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressXDR(t *testing.T) {
+	meta := "AAAAAAAAAAEAAAADAAAAAQAZphoAAAAAAAAAAMIK9djC7k75ziKOLJcvMAIBG7tnBuoeI34x+Pi6zqcZAAAAF0h255wAGaYWAAAAAQAAAAMAAAAAAAAAAAAAAAADBQUFAAAAAwAAAAAtkqVYLPLYhqNMmQLPc+T9eTWp8LIE8eFlR5K4wNJKTQAAAAMAAAAAynnCTTyw53VVRLOWX6XKTva63IM1LslPNW01YB0hz/8AAAADAAAAAuOwxEKY/BwUmvv0yJlvuSQnrkHkZJuTTKSVmRt4UrhVAAAAAwAAAAAAAAAAAAAAAwAZphYAAAAAAAAAAMp5wk08sOd1VUSzll+lyk72utyDNS7JTzVtNWAdIc//AAAAF0h26AAAGaYWAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAAAAAAAAAAQAZphoAAAAAAAAAAMp5wk08sOd1VUSzll+lyk72utyDNS7JTzVtNWAdIc//AAAAGZyCzAAAGaYWAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAAAAA"
+
+	compressed, err := CompressXDR(meta)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(compressed, CompressedXDRPrefix))
+	assert.True(t, len(compressed) < len(meta))
+
+	decompressed, err := DecompressXDR(compressed)
+	require.NoError(t, err)
+	assert.Equal(t, meta, decompressed)
+
+	var decoded xdr.TransactionMeta
+	require.NoError(t, unmarshalStoredXDR(compressed, &decoded))
+	assert.Len(t, decoded.MustOperations(), 1)
+
+	// uncompressed and empty values pass through unchanged
+	decompressed, err = DecompressXDR(meta)
+	require.NoError(t, err)
+	assert.Equal(t, meta, decompressed)
+
+	compressed, err = CompressXDR("")
+	require.NoError(t, err)
+	assert.Equal(t, "", compressed)
+
+	_, err = CompressXDR("not base64!")
+	assert.Error(t, err)
+	_, err = DecompressXDR(CompressedXDRPrefix + "AAAA")
+	assert.Error(t, err)
+
+	ingestion := &Ingestion{}
+	stored, err := ingestion.storedXDR(meta)
+	require.NoError(t, err)
+	assert.Equal(t, meta, stored)
+
+	ingestion.CompressXDR = true
+	stored, err = ingestion.storedXDR(meta)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(stored, CompressedXDRPrefix))
+
+	// xdr that fails to compress is not stored uncompressed
+	_, err = ingestion.storedXDR("not base64!")
+	assert.Error(t, err)
+	_, err = ingestion.storedXDRs(meta, "not base64!")
+	assert.Error(t, err)
+}
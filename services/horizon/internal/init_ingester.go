@@ -24,6 +24,7 @@ func initIngester(app *App) {
 
 	app.ingester.SkipCursorUpdate = app.config.SkipCursorUpdate
 	app.ingester.HistoryRetentionCount = app.config.HistoryRetentionCount
+	app.ingester.HorizonDBURL = app.config.DatabaseURL
 }
 
 func init() {
@@ -35,6 +35,14 @@ func initIngesterMetrics(app *App) {
 		app.ingester.Metrics.IngestLedgerTimer)
 	app.metrics.Register("ingester.clear_ledger",
 		app.ingester.Metrics.ClearLedgerTimer)
+	app.metrics.Register("ingester.details_size",
+		app.ingester.Metrics.DetailsSizeHistogram)
+	app.metrics.Register("ingester.large_details",
+		app.ingester.Metrics.LargeDetailsCounter)
+	app.metrics.Register("ingester.asset_cache_hits",
+		app.ingester.Metrics.AssetCacheHitCounter)
+	app.metrics.Register("ingester.asset_cache_misses",
+		app.ingester.Metrics.AssetCacheMissCounter)
 }
 
 func initLogMetrics(app *App) {
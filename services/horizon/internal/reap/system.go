@@ -73,6 +73,14 @@ func (r *System) clearBefore(seq int32) error {
 	if err != nil {
 		return err
 	}
+	err = clear(0, end, "history_account_flags", "history_operation_id")
+	if err != nil {
+		return err
+	}
+	err = clear(0, end, "history_ledger_changes", "history_operation_id")
+	if err != nil {
+		return err
+	}
 	err = clear(0, end, "history_operation_participants", "history_operation_id")
 	if err != nil {
 		return err
@@ -85,6 +93,14 @@ func (r *System) clearBefore(seq int32) error {
 	if err != nil {
 		return err
 	}
+	err = clear(0, end, "history_transaction_xdr", "history_transaction_id")
+	if err != nil {
+		return err
+	}
+	err = clear(0, end, "history_transaction_memos", "history_transaction_id")
+	if err != nil {
+		return err
+	}
 	err = clear(0, end, "history_transactions", "id")
 	if err != nil {
 		return err
@@ -93,6 +109,14 @@ func (r *System) clearBefore(seq int32) error {
 	if err != nil {
 		return err
 	}
+	err = clear(0, end, "history_ledger_stats", "id")
+	if err != nil {
+		return err
+	}
+	err = clear(0, end, "history_upgrade_proposals", "id")
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
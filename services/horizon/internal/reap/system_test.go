@@ -46,3 +46,36 @@ func TestDeleteUnretainedHistory(t *testing.T) {
 		tt.Assert.Equal(1, cur)
 	}
 }
+
+func TestDeleteUnretainedHistory_UpgradeProposals(t *testing.T) {
+	tt := test.Start(t).Scenario("kahuna")
+	defer tt.Finish()
+
+	db := tt.HorizonSession()
+
+	// a proposal for every ledger, keyed by the ledger's id as at ingestion
+	_, err := db.ExecRaw(`
+		INSERT INTO history_upgrade_proposals
+			(id, ledger_sequence, "order", upgrade_type, value, upgrade_xdr)
+		SELECT id, sequence, 0, 1, 10, 'AAAAAQAAAAo='
+		FROM history_ledgers
+	`)
+	tt.Require.NoError(err)
+
+	tt.UpdateLedgerState()
+	sys := New(10, db)
+	err = sys.DeleteUnretainedHistory()
+	tt.Require.NoError(err)
+
+	var proposals, stale int
+	err = db.GetRaw(&proposals, `SELECT COUNT(*) FROM history_upgrade_proposals`)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(10, proposals)
+
+	err = db.GetRaw(&stale, `
+		SELECT COUNT(*) FROM history_upgrade_proposals p
+		WHERE NOT EXISTS (SELECT 1 FROM history_ledgers l WHERE l.id = p.id)
+	`)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(0, stale)
+}
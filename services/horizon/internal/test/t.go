@@ -107,7 +107,7 @@ func (t *T) UpdateLedgerState() {
 	err = t.HorizonSession().GetRaw(&next, `
 			SELECT
 				COALESCE(MIN(sequence), 0) as history_elder,
-				COALESCE(MAX(sequence), 0) as history_latest
+				COALESCE(MAX(CASE WHEN partial THEN NULL ELSE sequence END), 0) as history_latest
 			FROM history_ledgers
 		`)
 